@@ -7,6 +7,7 @@ import (
 	"github.com/juju/errors"
 	"github.com/newm4n/grool/antlr/parser"
 	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
 	log "github.com/sirupsen/logrus"
 	"reflect"
 	"strconv"
@@ -39,7 +40,10 @@ func (s *GroolParserListener) AddError(e error) {
 func (s *GroolParserListener) VisitTerminal(node antlr.TerminalNode) {}
 
 // VisitErrorNode is called when an error node is visited.
-func (s *GroolParserListener) VisitErrorNode(node antlr.ErrorNode) {}
+func (s *GroolParserListener) VisitErrorNode(node antlr.ErrorNode) {
+	token := node.GetSymbol()
+	s.AddError(errors.Errorf("unexpected %s at line %d column %d", node.GetText(), token.GetLine(), token.GetColumn()+1))
+}
 
 // EnterEveryRule is called when any engine is entered.
 func (s *GroolParserListener) EnterEveryRule(ctx antlr.ParserRuleContext) {}
@@ -59,17 +63,21 @@ func (s *GroolParserListener) EnterRuleEntry(ctx *parser.RuleEntryContext) {
 	if len(s.ParseErrors) > 0 {
 		return
 	}
-	entry := &model.RuleEntry{}
+	entry := &model.RuleEntry{
+		Line:    ctx.GetStart().GetLine(),
+		Column:  ctx.GetStart().GetColumn() + 1,
+		EndLine: ctx.GetStop().GetLine(),
+	}
 	s.Stack.Push(entry)
 }
 
 // ExitRuleEntry is called when production ruleEntry is exited.
 func (s *GroolParserListener) ExitRuleEntry(ctx *parser.RuleEntryContext) {
-	entry := s.Stack.Pop().(*model.RuleEntry)
 	// return immediately when there's an error
 	if len(s.ParseErrors) > 0 {
 		return
 	}
+	entry := s.Stack.Pop().(*model.RuleEntry)
 	// check for duplicate engine.
 	if _, ok := s.KnowledgeBase.RuleEntries[entry.RuleName]; ok {
 		s.AddError(errors.Errorf("duplicate rule entry name '%s'", entry.RuleName))
@@ -127,11 +135,11 @@ func (s *GroolParserListener) EnterWhenScope(ctx *parser.WhenScopeContext) {
 
 // ExitWhenScope is called when production whenScope is exited.
 func (s *GroolParserListener) ExitWhenScope(ctx *parser.WhenScopeContext) {
-	whenScope := s.Stack.Pop().(*model.WhenScope)
 	// return immediately when there's an error
 	if len(s.ParseErrors) > 0 {
 		return
 	}
+	whenScope := s.Stack.Pop().(*model.WhenScope)
 	ruleEntry := s.Stack.Peek().(*model.RuleEntry)
 	ruleEntry.WhenScope = whenScope
 }
@@ -148,11 +156,11 @@ func (s *GroolParserListener) EnterThenScope(ctx *parser.ThenScopeContext) {
 
 // ExitThenScope is called when production thenScope is exited.
 func (s *GroolParserListener) ExitThenScope(ctx *parser.ThenScopeContext) {
-	thenScope := s.Stack.Pop().(*model.ThenScope)
 	// return immediately when there's an error
 	if len(s.ParseErrors) > 0 {
 		return
 	}
+	thenScope := s.Stack.Pop().(*model.ThenScope)
 	ruleEntry := s.Stack.Peek().(*model.RuleEntry)
 	ruleEntry.ThenScope = thenScope
 }
@@ -171,13 +179,16 @@ func (s *GroolParserListener) EnterAssignExpressions(ctx *parser.AssignExpressio
 
 // ExitAssignExpressions is called when production assignExpressions is exited.
 func (s *GroolParserListener) ExitAssignExpressions(ctx *parser.AssignExpressionsContext) {
-	assigns := s.Stack.Pop().(*model.AssignExpressions)
 	// return immediately when there's an error
 	if len(s.ParseErrors) > 0 {
 		return
 	}
-	thenScope := s.Stack.Peek().(*model.ThenScope)
-	thenScope.AssignExpressions = assigns
+	assigns := s.Stack.Pop().(*model.AssignExpressions)
+	holder := s.Stack.Peek().(model.AssignExpressionsHolder)
+	err := holder.AcceptAssignExpressions(assigns)
+	if err != nil {
+		s.AddError(err)
+	}
 }
 
 // EnterAssignExpression is called when production assignExpression is entered.
@@ -186,38 +197,105 @@ func (s *GroolParserListener) EnterAssignExpression(ctx *parser.AssignExpression
 	if len(s.ParseErrors) > 0 {
 		return
 	}
-	assign := &model.AssignExpression{}
+	assign := &model.AssignExpression{
+		Text:   ctx.GetText(),
+		Line:   ctx.GetStart().GetLine(),
+		Column: ctx.GetStart().GetColumn() + 1,
+	}
 	s.Stack.Push(assign)
 }
 
 // ExitAssignExpression is called when production assignExpression is exited.
 func (s *GroolParserListener) ExitAssignExpression(ctx *parser.AssignExpressionContext) {
-	assign := s.Stack.Pop().(*model.AssignExpression)
 	// return immediately when there's an error
 	if len(s.ParseErrors) > 0 {
 		return
 	}
+	assign := s.Stack.Pop().(*model.AssignExpression)
 	assigns := s.Stack.Peek().(*model.AssignExpressions)
 	assigns.ExpressionList = append(assigns.ExpressionList, assign)
 }
 
+// EnterIfStatement is called when production ifStatement is entered.
+func (s *GroolParserListener) EnterIfStatement(ctx *parser.IfStatementContext) {
+	// return immediately when there's an error
+	if len(s.ParseErrors) > 0 {
+		return
+	}
+	ifStmt := &model.IfStatement{}
+	s.Stack.Push(ifStmt)
+}
+
+// ExitIfStatement is called when production ifStatement is exited.
+func (s *GroolParserListener) ExitIfStatement(ctx *parser.IfStatementContext) {
+	// return immediately when there's an error
+	if len(s.ParseErrors) > 0 {
+		return
+	}
+	ifStmt := s.Stack.Pop().(*model.IfStatement)
+	holder := s.Stack.Peek().(model.IfStatementHolder)
+	err := holder.AcceptIfStatement(ifStmt)
+	if err != nil {
+		s.AddError(err)
+	}
+}
+
+// EnterElseStatement is called when production elseStatement is entered.
+func (s *GroolParserListener) EnterElseStatement(ctx *parser.ElseStatementContext) {
+	// the else block or else-if statement were set into the enclosing if statement
+}
+
+// ExitElseStatement is called when production elseStatement is exited.
+func (s *GroolParserListener) ExitElseStatement(ctx *parser.ElseStatementContext) {}
+
+// EnterForStatement is called when production forStatement is entered.
+func (s *GroolParserListener) EnterForStatement(ctx *parser.ForStatementContext) {
+	// return immediately when there's an error
+	if len(s.ParseErrors) > 0 {
+		return
+	}
+	forStmt := &model.ForStatement{
+		LoopVariable: ctx.SIMPLENAME().GetText(),
+		Line:         ctx.GetStart().GetLine(),
+		Column:       ctx.GetStart().GetColumn() + 1,
+	}
+	s.Stack.Push(forStmt)
+}
+
+// ExitForStatement is called when production forStatement is exited.
+func (s *GroolParserListener) ExitForStatement(ctx *parser.ForStatementContext) {
+	// return immediately when there's an error
+	if len(s.ParseErrors) > 0 {
+		return
+	}
+	forStmt := s.Stack.Pop().(*model.ForStatement)
+	holder := s.Stack.Peek().(model.ForStatementHolder)
+	err := holder.AcceptForStatement(forStmt)
+	if err != nil {
+		s.AddError(err)
+	}
+}
+
 // EnterAssignment is called when production assignment is entered.
 func (s *GroolParserListener) EnterAssignment(ctx *parser.AssignmentContext) {
 	// return immediately when there's an error
 	if len(s.ParseErrors) > 0 {
 		return
 	}
-	assignment := &model.Assignment{}
+	assignment := &model.Assignment{
+		Line:   ctx.GetStart().GetLine(),
+		Column: ctx.GetStart().GetColumn() + 1,
+	}
 	s.Stack.Push(assignment)
 }
 
 // ExitAssignment is called when production assignment is exited.
 func (s *GroolParserListener) ExitAssignment(ctx *parser.AssignmentContext) {
-	assignment := s.Stack.Pop().(*model.Assignment)
 	// return immediately when there's an error
 	if len(s.ParseErrors) > 0 {
 		return
 	}
+	assignment := s.Stack.Pop().(*model.Assignment)
 	assign := s.Stack.Peek().(*model.AssignExpression)
 	assign.Assignment = assignment
 }
@@ -228,17 +306,22 @@ func (s *GroolParserListener) EnterExpression(ctx *parser.ExpressionContext) {
 	if len(s.ParseErrors) > 0 {
 		return
 	}
-	expression := &model.Expression{}
+	expression := &model.Expression{
+		Text:        ctx.GetText(),
+		Conditional: ctx.QUESTION() != nil,
+		Line:        ctx.GetStart().GetLine(),
+		Column:      ctx.GetStart().GetColumn() + 1,
+	}
 	s.Stack.Push(expression)
 }
 
 // ExitExpression is called when production expression is exited.
 func (s *GroolParserListener) ExitExpression(ctx *parser.ExpressionContext) {
-	expr := s.Stack.Pop().(*model.Expression)
 	// return immediately when there's an error
 	if len(s.ParseErrors) > 0 {
 		return
 	}
+	expr := s.Stack.Pop().(*model.Expression)
 	holder := s.Stack.Peek().(model.ExpressionHolder)
 	err := holder.AcceptExpression(expr)
 	if err != nil {
@@ -252,17 +335,31 @@ func (s *GroolParserListener) EnterPredicate(ctx *parser.PredicateContext) {
 	if len(s.ParseErrors) > 0 {
 		return
 	}
-	predicate := &model.Predicate{}
+	predicate := &model.Predicate{
+		Line:   ctx.GetStart().GetLine(),
+		Column: ctx.GetStart().GetColumn() + 1,
+	}
+	if ctx.IN() != nil {
+		if ctx.NOT() != nil {
+			predicate.ComparisonOperator = model.ComparisonOperatorNotIn
+		} else {
+			predicate.ComparisonOperator = model.ComparisonOperatorIn
+		}
+	}
 	s.Stack.Push(predicate)
 }
 
 // ExitPredicate is called when production predicate is exited.
 func (s *GroolParserListener) ExitPredicate(ctx *parser.PredicateContext) {
-	predicate := s.Stack.Pop().(*model.Predicate)
 	// return immediately when there's an error
 	if len(s.ParseErrors) > 0 {
 		return
 	}
+	predicate := s.Stack.Pop().(*model.Predicate)
+	if err := predicate.CompilePattern(); err != nil {
+		s.AddError(err)
+		return
+	}
 	expr := s.Stack.Peek().(*model.Expression)
 	expr.Predicate = predicate
 }
@@ -274,7 +371,10 @@ func (s *GroolParserListener) EnterExpressionAtom(ctx *parser.ExpressionAtomCont
 		return
 	}
 	exprAtom := &model.ExpressionAtom{
-		Text: ctx.GetText(),
+		Text:           ctx.GetText(),
+		NullCoalescing: ctx.NULL_COALESCE() != nil,
+		Line:           ctx.GetStart().GetLine(),
+		Column:         ctx.GetStart().GetColumn() + 1,
 	}
 	s.Stack.Push(exprAtom)
 }
@@ -282,11 +382,11 @@ func (s *GroolParserListener) EnterExpressionAtom(ctx *parser.ExpressionAtomCont
 // ExitExpressionAtom is called when production expressionAtom is exited.
 func (s *GroolParserListener) ExitExpressionAtom(ctx *parser.ExpressionAtomContext) {
 	//fmt.Println(ctx.GetText())
-	exprAtom := s.Stack.Pop().(*model.ExpressionAtom)
 	// return immediately when there's an error
 	if len(s.ParseErrors) > 0 {
 		return
 	}
+	exprAtom := s.Stack.Pop().(*model.ExpressionAtom)
 	holder := s.Stack.Peek().(model.ExpressionAtomHolder)
 	err := holder.AcceptExpressionAtom(exprAtom)
 	if err != nil {
@@ -302,17 +402,19 @@ func (s *GroolParserListener) EnterMethodCall(ctx *parser.MethodCallContext) {
 	}
 	funcCall := &model.MethodCall{
 		MethodName: ctx.DOTTEDNAME().GetText(),
+		Line:       ctx.GetStart().GetLine(),
+		Column:     ctx.GetStart().GetColumn() + 1,
 	}
 	s.Stack.Push(funcCall)
 }
 
 // ExitMethodCall is called when production methodCall is exited.
 func (s *GroolParserListener) ExitMethodCall(ctx *parser.MethodCallContext) {
-	methodCall := s.Stack.Pop().(*model.MethodCall)
 	// return immediately when there's an error
 	if len(s.ParseErrors) > 0 {
 		return
 	}
+	methodCall := s.Stack.Pop().(*model.MethodCall)
 	holder := s.Stack.Peek().(model.MethodCallHolder)
 	err := holder.AcceptMethodCall(methodCall)
 	if err != nil {
@@ -321,6 +423,40 @@ func (s *GroolParserListener) ExitMethodCall(ctx *parser.MethodCallContext) {
 	}
 }
 
+// EnterSelector is called when production selector is entered.
+func (s *GroolParserListener) EnterSelector(ctx *parser.SelectorContext) {
+	// return immediately when there's an error
+	if len(s.ParseErrors) > 0 {
+		return
+	}
+	sel := &model.Selector{
+		NullSafe:   ctx.QUESTION() != nil,
+		MethodCall: ctx.LR_BRACKET() != nil,
+		Line:       ctx.GetStart().GetLine(),
+		Column:     ctx.GetStart().GetColumn() + 1,
+	}
+	if ctx.SIMPLENAME() != nil {
+		sel.Name = ctx.SIMPLENAME().GetText()
+	} else {
+		sel.Name = ctx.DOTTEDNAME().GetText()
+	}
+	s.Stack.Push(sel)
+}
+
+// ExitSelector is called when production selector is exited.
+func (s *GroolParserListener) ExitSelector(ctx *parser.SelectorContext) {
+	// return immediately when there's an error
+	if len(s.ParseErrors) > 0 {
+		return
+	}
+	sel := s.Stack.Pop().(*model.Selector)
+	holder := s.Stack.Peek().(model.SelectorHolder)
+	err := holder.AcceptSelector(sel)
+	if err != nil {
+		s.AddError(err)
+	}
+}
+
 // EnterFunctionCall is called when production functionCall is entered.
 func (s *GroolParserListener) EnterFunctionCall(ctx *parser.FunctionCallContext) {
 	// return immediately when there's an error
@@ -329,17 +465,19 @@ func (s *GroolParserListener) EnterFunctionCall(ctx *parser.FunctionCallContext)
 	}
 	funcCall := &model.FunctionCall{
 		FunctionName: ctx.SIMPLENAME().GetText(),
+		Line:         ctx.GetStart().GetLine(),
+		Column:       ctx.GetStart().GetColumn() + 1,
 	}
 	s.Stack.Push(funcCall)
 }
 
 // ExitFunctionCall is called when production functionCall is exited.
 func (s *GroolParserListener) ExitFunctionCall(ctx *parser.FunctionCallContext) {
-	funcCall := s.Stack.Pop().(*model.FunctionCall)
 	// return immediately when there's an error
 	if len(s.ParseErrors) > 0 {
 		return
 	}
+	funcCall := s.Stack.Pop().(*model.FunctionCall)
 	holder := s.Stack.Peek().(model.FunctionCallHolder)
 	err := holder.AcceptFunctionCall(funcCall)
 	if err != nil {
@@ -361,11 +499,11 @@ func (s *GroolParserListener) EnterFunctionArgs(ctx *parser.FunctionArgsContext)
 
 // ExitFunctionArgs is called when production functionArgs is exited.
 func (s *GroolParserListener) ExitFunctionArgs(ctx *parser.FunctionArgsContext) {
-	funcArgs := s.Stack.Pop().(*model.FunctionArgument)
 	// return immediately when there's an error
 	if len(s.ParseErrors) > 0 {
 		return
 	}
+	funcArgs := s.Stack.Pop().(*model.FunctionArgument)
 	argHolder := s.Stack.Peek().(model.FunctionArgumentHolder)
 	err := argHolder.AcceptFunctionArgument(funcArgs)
 	if err != nil {
@@ -434,6 +572,16 @@ func (s *GroolParserListener) ExitMathOperator(ctx *parser.MathOperatorContext)
 		expr.MathOperator = model.MathOperatorDiv
 	} else if ctx.GetText() == "*" {
 		expr.MathOperator = model.MathOperatorMul
+	} else if ctx.GetText() == "&" {
+		expr.MathOperator = model.MathOperatorBitAnd
+	} else if ctx.GetText() == "|" {
+		expr.MathOperator = model.MathOperatorBitOr
+	} else if ctx.GetText() == "^" {
+		expr.MathOperator = model.MathOperatorBitXor
+	} else if ctx.GetText() == "<<" {
+		expr.MathOperator = model.MathOperatorShiftLeft
+	} else if ctx.GetText() == ">>" {
+		expr.MathOperator = model.MathOperatorShiftRight
 	} else {
 		s.AddError(errors.Errorf("unknown mathematic operator %s", ctx.GetText()))
 	}
@@ -461,6 +609,10 @@ func (s *GroolParserListener) ExitComparisonOperator(ctx *parser.ComparisonOpera
 		predicate.ComparisonOperator = model.ComparisonOperatorGT
 	} else if ctx.GetText() == ">=" {
 		predicate.ComparisonOperator = model.ComparisonOperatorGTE
+	} else if strings.ToLower(ctx.GetText()) == "contains" {
+		predicate.ComparisonOperator = model.ComparisonOperatorContains
+	} else if strings.ToLower(ctx.GetText()) == "matches" {
+		predicate.ComparisonOperator = model.ComparisonOperatorMatches
 	} else {
 		s.AddError(errors.Errorf("unknown comparison operator %s", ctx.GetText()))
 	}
@@ -478,14 +630,16 @@ func (s *GroolParserListener) EnterConstant(ctx *parser.ConstantContext) {
 
 // ExitConstant is called when production constant is exited.
 func (s *GroolParserListener) ExitConstant(ctx *parser.ConstantContext) {
-	cons := s.Stack.Pop().(*model.Constant)
 	// return immediately when there's an error
 	if len(s.ParseErrors) > 0 {
 		return
 	}
+	cons := s.Stack.Pop().(*model.Constant)
+	cons.Text = ctx.GetText()
 	if ctx.NULL_LITERAL() != nil {
 		if ctx.NOT() != nil {
 			cons.ConstantValue = reflect.ValueOf("")
+			cons.Text = "not null"
 		} else {
 			cons.ConstantValue = reflect.ValueOf(nil)
 		}
@@ -498,6 +652,67 @@ func (s *GroolParserListener) ExitConstant(ctx *parser.ConstantContext) {
 	}
 }
 
+// EnterListLiteral is called when production listLiteral is entered.
+func (s *GroolParserListener) EnterListLiteral(ctx *parser.ListLiteralContext) {
+	// return immediately when there's an error
+	if len(s.ParseErrors) > 0 {
+		return
+	}
+	cons := s.Stack.Peek().(*model.Constant)
+	cons.ConstantKind = model.ConstantKindList
+}
+
+// ExitListLiteral is called when production listLiteral is exited.
+func (s *GroolParserListener) ExitListLiteral(ctx *parser.ListLiteralContext) {}
+
+// EnterMapLiteral is called when production mapLiteral is entered.
+func (s *GroolParserListener) EnterMapLiteral(ctx *parser.MapLiteralContext) {
+	// return immediately when there's an error
+	if len(s.ParseErrors) > 0 {
+		return
+	}
+	cons := s.Stack.Peek().(*model.Constant)
+	cons.ConstantKind = model.ConstantKindMap
+}
+
+// ExitMapLiteral is called when production mapLiteral is exited.
+func (s *GroolParserListener) ExitMapLiteral(ctx *parser.MapLiteralContext) {}
+
+// EnterMapEntry is called when production mapEntry is entered.
+func (s *GroolParserListener) EnterMapEntry(ctx *parser.MapEntryContext) {}
+
+// ExitMapEntry is called when production mapEntry is exited.
+func (s *GroolParserListener) ExitMapEntry(ctx *parser.MapEntryContext) {}
+
+// EnterStructLiteral is called when production structLiteral is entered.
+func (s *GroolParserListener) EnterStructLiteral(ctx *parser.StructLiteralContext) {
+	// return immediately when there's an error
+	if len(s.ParseErrors) > 0 {
+		return
+	}
+	cons := s.Stack.Peek().(*model.Constant)
+	cons.ConstantKind = model.ConstantKindStruct
+}
+
+// ExitStructLiteral is called when production structLiteral is exited.
+func (s *GroolParserListener) ExitStructLiteral(ctx *parser.StructLiteralContext) {}
+
+// EnterFieldEntry is called when production fieldEntry is entered.
+func (s *GroolParserListener) EnterFieldEntry(ctx *parser.FieldEntryContext) {
+	// return immediately when there's an error
+	if len(s.ParseErrors) > 0 {
+		return
+	}
+	cons := s.Stack.Peek().(*model.Constant)
+	err := cons.AcceptFieldName(ctx.SIMPLENAME().GetText())
+	if err != nil {
+		s.AddError(err)
+	}
+}
+
+// ExitFieldEntry is called when production fieldEntry is exited.
+func (s *GroolParserListener) ExitFieldEntry(ctx *parser.FieldEntryContext) {}
+
 // EnterDecimalLiteral is called when production decimalLiteral is entered.
 func (s *GroolParserListener) EnterDecimalLiteral(ctx *parser.DecimalLiteralContext) {}
 
@@ -517,6 +732,42 @@ func (s *GroolParserListener) ExitDecimalLiteral(ctx *parser.DecimalLiteralConte
 	}
 }
 
+// EnterTimeLiteral is called when production timeLiteral is entered.
+func (s *GroolParserListener) EnterTimeLiteral(ctx *parser.TimeLiteralContext) {}
+
+// ExitTimeLiteral is called when production timeLiteral is exited.
+func (s *GroolParserListener) ExitTimeLiteral(ctx *parser.TimeLiteralContext) {
+	// return immediately when there's an error
+	if len(s.ParseErrors) > 0 {
+		return
+	}
+	cons := s.Stack.Peek().(*model.Constant)
+	t, err := pkg.ParseTimeLiteral(ctx.GetText())
+	if err != nil {
+		s.AddError(err)
+	} else {
+		cons.ConstantValue = reflect.ValueOf(t)
+	}
+}
+
+// EnterDurationLiteral is called when production durationLiteral is entered.
+func (s *GroolParserListener) EnterDurationLiteral(ctx *parser.DurationLiteralContext) {}
+
+// ExitDurationLiteral is called when production durationLiteral is exited.
+func (s *GroolParserListener) ExitDurationLiteral(ctx *parser.DurationLiteralContext) {
+	// return immediately when there's an error
+	if len(s.ParseErrors) > 0 {
+		return
+	}
+	cons := s.Stack.Peek().(*model.Constant)
+	dur, err := pkg.ParseDurationLiteral(ctx.GetText())
+	if err != nil {
+		s.AddError(err)
+	} else {
+		cons.ConstantValue = reflect.ValueOf(dur)
+	}
+}
+
 // EnterStringLiteral is called when production stringLiteral is entered.
 func (s *GroolParserListener) EnterStringLiteral(ctx *parser.StringLiteralContext) {}
 
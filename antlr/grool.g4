@@ -38,7 +38,23 @@ assignExpressions
 assignExpression
     : assignment SEMICOLON
     | methodCall SEMICOLON
+    | expressionAtom selector SEMICOLON
     | functionCall SEMICOLON
+    | ifStatement
+    | forStatement
+    ;
+
+ifStatement
+    : IF LR_BRACKET expression RR_BRACKET LR_BRACE assignExpressions RR_BRACE elseStatement?
+    ;
+
+elseStatement
+    : ELSE ifStatement
+    | ELSE LR_BRACE assignExpressions RR_BRACE
+    ;
+
+forStatement
+    : FOR SIMPLENAME IN variable LR_BRACE assignExpressions RR_BRACE
     ;
 
 assignment
@@ -47,19 +63,23 @@ assignment
 
 expression
     : expression logicalOperator expression
+    | <assoc=right> expression QUESTION expression COLON expression
     | LR_BRACKET expression logicalOperator expression RR_BRACKET
     | predicate
     ;
 
 predicate
     : expressionAtom comparisonOperator expressionAtom
+    | expressionAtom NOT? IN LR_BRACKET expressionAtom ( ',' expressionAtom )* RR_BRACKET
     | expressionAtom
     ;
 
 expressionAtom
     : constant
     | variable
+    | expressionAtom selector
     | left=expressionAtom mathOperator right=expressionAtom
+    | left=expressionAtom NULL_COALESCE right=expressionAtom
     | LR_BRACKET left=expressionAtom mathOperator right=expressionAtom RR_BRACKET
     | functionCall
     | methodCall
@@ -69,6 +89,10 @@ methodCall
     : DOTTEDNAME '(' functionArgs? ')'
     ;
 
+selector
+    : QUESTION? DOT ( SIMPLENAME | DOTTEDNAME ) ( LR_BRACKET functionArgs? RR_BRACKET )?
+    ;
+
 functionCall
     : SIMPLENAME '(' functionArgs? ')'
     ;
@@ -90,11 +114,11 @@ variable
     ;
 
 mathOperator
-    : MUL | DIV | PLUS | MINUS
+    : MUL | DIV | PLUS | MINUS | BITAND | BITOR | BITXOR | SHL | SHR
     ;
 
 comparisonOperator
-    : GT | LT | GTE | LTE | EQUALS | NOTEQUALS
+    : GT | LT | GTE | LTE | EQUALS | NOTEQUALS | CONTAINS | MATCHES
     ;
 
 constant
@@ -104,6 +128,31 @@ constant
     | booleanLiteral
     | realLiteral
     | NOT? NULL_LITERAL
+    | timeLiteral
+    | durationLiteral
+    | listLiteral
+    | mapLiteral
+    | structLiteral
+    ;
+
+listLiteral
+    : LS_BRACKET ( constant ( ',' constant )* )? RS_BRACKET
+    ;
+
+mapLiteral
+    : LR_BRACE ( mapEntry ( ',' mapEntry )* )? RR_BRACE
+    ;
+
+mapEntry
+    : constant COLON constant
+    ;
+
+structLiteral
+    : LR_BRACE fieldEntry ( ',' fieldEntry )* RR_BRACE
+    ;
+
+fieldEntry
+    : SIMPLENAME COLON constant
     ;
 
 decimalLiteral
@@ -114,6 +163,14 @@ realLiteral
     : MINUS? REAL_LITERAL
     ;
 
+timeLiteral
+    : TIME_LITERAL
+    ;
+
+durationLiteral
+    : MINUS? DURATION_LITERAL
+    ;
+
 stringLiteral
     : DQUOTA_STRING | SQUOTA_STRING
     ;
@@ -162,14 +219,25 @@ FALSE                       : F A L S E ;
 NULL_LITERAL                : N U L L ;
 NOT                         : N O T ;
 SALIENCE                    : S A L I E N C E ;
+IF                          : I F ;
+ELSE                        : E L S E ;
+FOR                         : F O R ;
+IN                          : I N ;
+CONTAINS                    : C O N T A I N S ;
+MATCHES                     : M A T C H E S ;
 
-SIMPLENAME                  : [a-zA-Z] [a-zA-Z0-9]* ;
-DOTTEDNAME                  : SIMPLENAME ( DOT SIMPLENAME )+ ;
+SIMPLENAME                  : [a-zA-Z_] [a-zA-Z0-9_]* ;
+DOTTEDNAME                  : SIMPLENAME ( '?'? DOT SIMPLENAME )+ ;
 
 PLUS                        : '+' ;
 MINUS                       : '-' ;
 DIV                         : '/' ;
 MUL                         : '*' ;
+BITAND                      : '&' ;
+BITOR                       : '|' ;
+BITXOR                      : '^' ;
+SHL                         : '<<' ;
+SHR                         : '>>' ;
 
 EQUALS                      : '==' ;
 ASSIGN                      : '=' ;
@@ -180,14 +248,26 @@ LTE                         : '<=' ;
 NOTEQUALS                   : '!=' ;
 
 SEMICOLON                   : ';' ;
+NULL_COALESCE               : '??' ;
+QUESTION                    : '?' ;
+COLON                       : ':' ;
 LR_BRACE                    : '{';
 RR_BRACE                    : '}';
 LR_BRACKET                  : '(';
 RR_BRACKET                  : ')';
+LS_BRACKET                  : '[';
+RS_BRACKET                  : ']';
 DOT                         : '.' ;
 DQUOTA_STRING               : '"' ( '\\'. | '""' | ~('"'| '\\') )* '"';
 SQUOTA_STRING               : '\'' ('\\'. | '\'\'' | ~('\'' | '\\'))* '\'';
 
+TIME_LITERAL                : DEC_DIGIT DEC_DIGIT DEC_DIGIT DEC_DIGIT '-' DEC_DIGIT DEC_DIGIT '-' DEC_DIGIT DEC_DIGIT
+                              ( 'T' DEC_DIGIT DEC_DIGIT ':' DEC_DIGIT DEC_DIGIT ( ':' DEC_DIGIT DEC_DIGIT ( '.' DEC_DIGIT+ )? )?
+                                ( 'Z' | ( '+' | '-' ) DEC_DIGIT DEC_DIGIT ':' DEC_DIGIT DEC_DIGIT )? )?
+                            ;
+
+DURATION_LITERAL            : ( DEC_DIGIT+ ( 'd' | 'h' | 'm' | 's' | 'ms' ) )+ ;
+
 DECIMAL_LITERAL             : DEC_DIGIT+;
 
 REAL_LITERAL                : (DEC_DIGIT+)? '.' DEC_DIGIT+
@@ -75,6 +75,24 @@ func (s *BasegroolListener) EnterAssignExpression(ctx *AssignExpressionContext)
 // ExitAssignExpression is called when production assignExpression is exited.
 func (s *BasegroolListener) ExitAssignExpression(ctx *AssignExpressionContext) {}
 
+// EnterIfStatement is called when production ifStatement is entered.
+func (s *BasegroolListener) EnterIfStatement(ctx *IfStatementContext) {}
+
+// ExitIfStatement is called when production ifStatement is exited.
+func (s *BasegroolListener) ExitIfStatement(ctx *IfStatementContext) {}
+
+// EnterElseStatement is called when production elseStatement is entered.
+func (s *BasegroolListener) EnterElseStatement(ctx *ElseStatementContext) {}
+
+// ExitElseStatement is called when production elseStatement is exited.
+func (s *BasegroolListener) ExitElseStatement(ctx *ElseStatementContext) {}
+
+// EnterForStatement is called when production forStatement is entered.
+func (s *BasegroolListener) EnterForStatement(ctx *ForStatementContext) {}
+
+// ExitForStatement is called when production forStatement is exited.
+func (s *BasegroolListener) ExitForStatement(ctx *ForStatementContext) {}
+
 // EnterAssignment is called when production assignment is entered.
 func (s *BasegroolListener) EnterAssignment(ctx *AssignmentContext) {}
 
@@ -105,6 +123,12 @@ func (s *BasegroolListener) EnterMethodCall(ctx *MethodCallContext) {}
 // ExitMethodCall is called when production methodCall is exited.
 func (s *BasegroolListener) ExitMethodCall(ctx *MethodCallContext) {}
 
+// EnterSelector is called when production selector is entered.
+func (s *BasegroolListener) EnterSelector(ctx *SelectorContext) {}
+
+// ExitSelector is called when production selector is exited.
+func (s *BasegroolListener) ExitSelector(ctx *SelectorContext) {}
+
 // EnterFunctionCall is called when production functionCall is entered.
 func (s *BasegroolListener) EnterFunctionCall(ctx *FunctionCallContext) {}
 
@@ -147,6 +171,36 @@ func (s *BasegroolListener) EnterConstant(ctx *ConstantContext) {}
 // ExitConstant is called when production constant is exited.
 func (s *BasegroolListener) ExitConstant(ctx *ConstantContext) {}
 
+// EnterListLiteral is called when production listLiteral is entered.
+func (s *BasegroolListener) EnterListLiteral(ctx *ListLiteralContext) {}
+
+// ExitListLiteral is called when production listLiteral is exited.
+func (s *BasegroolListener) ExitListLiteral(ctx *ListLiteralContext) {}
+
+// EnterMapLiteral is called when production mapLiteral is entered.
+func (s *BasegroolListener) EnterMapLiteral(ctx *MapLiteralContext) {}
+
+// ExitMapLiteral is called when production mapLiteral is exited.
+func (s *BasegroolListener) ExitMapLiteral(ctx *MapLiteralContext) {}
+
+// EnterMapEntry is called when production mapEntry is entered.
+func (s *BasegroolListener) EnterMapEntry(ctx *MapEntryContext) {}
+
+// ExitMapEntry is called when production mapEntry is exited.
+func (s *BasegroolListener) ExitMapEntry(ctx *MapEntryContext) {}
+
+// EnterStructLiteral is called when production structLiteral is entered.
+func (s *BasegroolListener) EnterStructLiteral(ctx *StructLiteralContext) {}
+
+// ExitStructLiteral is called when production structLiteral is exited.
+func (s *BasegroolListener) ExitStructLiteral(ctx *StructLiteralContext) {}
+
+// EnterFieldEntry is called when production fieldEntry is entered.
+func (s *BasegroolListener) EnterFieldEntry(ctx *FieldEntryContext) {}
+
+// ExitFieldEntry is called when production fieldEntry is exited.
+func (s *BasegroolListener) ExitFieldEntry(ctx *FieldEntryContext) {}
+
 // EnterDecimalLiteral is called when production decimalLiteral is entered.
 func (s *BasegroolListener) EnterDecimalLiteral(ctx *DecimalLiteralContext) {}
 
@@ -159,6 +213,18 @@ func (s *BasegroolListener) EnterRealLiteral(ctx *RealLiteralContext) {}
 // ExitRealLiteral is called when production realLiteral is exited.
 func (s *BasegroolListener) ExitRealLiteral(ctx *RealLiteralContext) {}
 
+// EnterTimeLiteral is called when production timeLiteral is entered.
+func (s *BasegroolListener) EnterTimeLiteral(ctx *TimeLiteralContext) {}
+
+// ExitTimeLiteral is called when production timeLiteral is exited.
+func (s *BasegroolListener) ExitTimeLiteral(ctx *TimeLiteralContext) {}
+
+// EnterDurationLiteral is called when production durationLiteral is entered.
+func (s *BasegroolListener) EnterDurationLiteral(ctx *DurationLiteralContext) {}
+
+// ExitDurationLiteral is called when production durationLiteral is exited.
+func (s *BasegroolListener) ExitDurationLiteral(ctx *DurationLiteralContext) {}
+
 // EnterStringLiteral is called when production stringLiteral is entered.
 func (s *BasegroolListener) EnterStringLiteral(ctx *StringLiteralContext) {}
 
@@ -35,6 +35,15 @@ type groolListener interface {
 	// EnterAssignExpression is called when entering the assignExpression production.
 	EnterAssignExpression(c *AssignExpressionContext)
 
+	// EnterIfStatement is called when entering the ifStatement production.
+	EnterIfStatement(c *IfStatementContext)
+
+	// EnterElseStatement is called when entering the elseStatement production.
+	EnterElseStatement(c *ElseStatementContext)
+
+	// EnterForStatement is called when entering the forStatement production.
+	EnterForStatement(c *ForStatementContext)
+
 	// EnterAssignment is called when entering the assignment production.
 	EnterAssignment(c *AssignmentContext)
 
@@ -50,6 +59,9 @@ type groolListener interface {
 	// EnterMethodCall is called when entering the methodCall production.
 	EnterMethodCall(c *MethodCallContext)
 
+	// EnterSelector is called when entering the selector production.
+	EnterSelector(c *SelectorContext)
+
 	// EnterFunctionCall is called when entering the functionCall production.
 	EnterFunctionCall(c *FunctionCallContext)
 
@@ -71,12 +83,33 @@ type groolListener interface {
 	// EnterConstant is called when entering the constant production.
 	EnterConstant(c *ConstantContext)
 
+	// EnterListLiteral is called when entering the listLiteral production.
+	EnterListLiteral(c *ListLiteralContext)
+
+	// EnterMapLiteral is called when entering the mapLiteral production.
+	EnterMapLiteral(c *MapLiteralContext)
+
+	// EnterMapEntry is called when entering the mapEntry production.
+	EnterMapEntry(c *MapEntryContext)
+
+	// EnterStructLiteral is called when entering the structLiteral production.
+	EnterStructLiteral(c *StructLiteralContext)
+
+	// EnterFieldEntry is called when entering the fieldEntry production.
+	EnterFieldEntry(c *FieldEntryContext)
+
 	// EnterDecimalLiteral is called when entering the decimalLiteral production.
 	EnterDecimalLiteral(c *DecimalLiteralContext)
 
 	// EnterRealLiteral is called when entering the realLiteral production.
 	EnterRealLiteral(c *RealLiteralContext)
 
+	// EnterTimeLiteral is called when entering the timeLiteral production.
+	EnterTimeLiteral(c *TimeLiteralContext)
+
+	// EnterDurationLiteral is called when entering the durationLiteral production.
+	EnterDurationLiteral(c *DurationLiteralContext)
+
 	// EnterStringLiteral is called when entering the stringLiteral production.
 	EnterStringLiteral(c *StringLiteralContext)
 
@@ -110,6 +143,15 @@ type groolListener interface {
 	// ExitAssignExpression is called when exiting the assignExpression production.
 	ExitAssignExpression(c *AssignExpressionContext)
 
+	// ExitIfStatement is called when exiting the ifStatement production.
+	ExitIfStatement(c *IfStatementContext)
+
+	// ExitElseStatement is called when exiting the elseStatement production.
+	ExitElseStatement(c *ElseStatementContext)
+
+	// ExitForStatement is called when exiting the forStatement production.
+	ExitForStatement(c *ForStatementContext)
+
 	// ExitAssignment is called when exiting the assignment production.
 	ExitAssignment(c *AssignmentContext)
 
@@ -125,6 +167,9 @@ type groolListener interface {
 	// ExitMethodCall is called when exiting the methodCall production.
 	ExitMethodCall(c *MethodCallContext)
 
+	// ExitSelector is called when exiting the selector production.
+	ExitSelector(c *SelectorContext)
+
 	// ExitFunctionCall is called when exiting the functionCall production.
 	ExitFunctionCall(c *FunctionCallContext)
 
@@ -146,12 +191,33 @@ type groolListener interface {
 	// ExitConstant is called when exiting the constant production.
 	ExitConstant(c *ConstantContext)
 
+	// ExitListLiteral is called when exiting the listLiteral production.
+	ExitListLiteral(c *ListLiteralContext)
+
+	// ExitMapLiteral is called when exiting the mapLiteral production.
+	ExitMapLiteral(c *MapLiteralContext)
+
+	// ExitMapEntry is called when exiting the mapEntry production.
+	ExitMapEntry(c *MapEntryContext)
+
+	// ExitStructLiteral is called when exiting the structLiteral production.
+	ExitStructLiteral(c *StructLiteralContext)
+
+	// ExitFieldEntry is called when exiting the fieldEntry production.
+	ExitFieldEntry(c *FieldEntryContext)
+
 	// ExitDecimalLiteral is called when exiting the decimalLiteral production.
 	ExitDecimalLiteral(c *DecimalLiteralContext)
 
 	// ExitRealLiteral is called when exiting the realLiteral production.
 	ExitRealLiteral(c *RealLiteralContext)
 
+	// ExitTimeLiteral is called when exiting the timeLiteral production.
+	ExitTimeLiteral(c *TimeLiteralContext)
+
+	// ExitDurationLiteral is called when exiting the durationLiteral production.
+	ExitDurationLiteral(c *DurationLiteralContext)
+
 	// ExitStringLiteral is called when exiting the stringLiteral production.
 	ExitStringLiteral(c *StringLiteralContext)
 
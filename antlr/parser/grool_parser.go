@@ -16,127 +16,201 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 39, 223,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 57, 374,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
 	18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22, 4, 23, 9, 23,
-	4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 3, 2, 7, 2, 54, 10, 2, 12, 2,
-	14, 2, 57, 11, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 3, 5, 3, 64, 10, 3, 3, 3,
-	5, 3, 67, 10, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 3, 4, 3, 4, 3, 5,
-	3, 5, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 9, 6, 9, 88, 10,
-	9, 13, 9, 14, 9, 89, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3,
-	10, 3, 10, 5, 10, 101, 10, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12,
-	3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 5, 12, 115, 10, 12, 3, 12, 3,
-	12, 3, 12, 3, 12, 7, 12, 121, 10, 12, 12, 12, 14, 12, 124, 11, 12, 3, 13,
-	3, 13, 3, 13, 3, 13, 3, 13, 5, 13, 131, 10, 13, 3, 14, 3, 14, 3, 14, 3,
-	14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 5, 14, 144, 10, 14,
-	3, 14, 3, 14, 3, 14, 3, 14, 7, 14, 150, 10, 14, 12, 14, 14, 14, 153, 11,
-	14, 3, 15, 3, 15, 3, 15, 5, 15, 158, 10, 15, 3, 15, 3, 15, 3, 16, 3, 16,
-	3, 16, 5, 16, 165, 10, 16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3, 17, 3,
-	17, 5, 17, 174, 10, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 5, 17,
-	182, 10, 17, 7, 17, 184, 10, 17, 12, 17, 14, 17, 187, 11, 17, 3, 18, 3,
-	18, 3, 19, 3, 19, 3, 20, 3, 20, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 3, 22,
-	3, 22, 3, 22, 3, 22, 5, 22, 204, 10, 22, 3, 22, 5, 22, 207, 10, 22, 3,
-	23, 5, 23, 210, 10, 23, 3, 23, 3, 23, 3, 24, 5, 24, 215, 10, 24, 3, 24,
-	3, 24, 3, 25, 3, 25, 3, 26, 3, 26, 3, 26, 2, 4, 22, 26, 27, 2, 4, 6, 8,
-	10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44,
-	46, 48, 50, 2, 8, 3, 2, 33, 34, 3, 2, 7, 8, 3, 2, 14, 15, 3, 2, 16, 19,
-	4, 2, 20, 20, 22, 26, 3, 2, 9, 10, 2, 230, 2, 55, 3, 2, 2, 2, 4, 60, 3,
-	2, 2, 2, 6, 73, 3, 2, 2, 2, 8, 76, 3, 2, 2, 2, 10, 78, 3, 2, 2, 2, 12,
-	80, 3, 2, 2, 2, 14, 83, 3, 2, 2, 2, 16, 87, 3, 2, 2, 2, 18, 100, 3, 2,
-	2, 2, 20, 102, 3, 2, 2, 2, 22, 114, 3, 2, 2, 2, 24, 130, 3, 2, 2, 2, 26,
-	143, 3, 2, 2, 2, 28, 154, 3, 2, 2, 2, 30, 161, 3, 2, 2, 2, 32, 173, 3,
-	2, 2, 2, 34, 188, 3, 2, 2, 2, 36, 190, 3, 2, 2, 2, 38, 192, 3, 2, 2, 2,
-	40, 194, 3, 2, 2, 2, 42, 206, 3, 2, 2, 2, 44, 209, 3, 2, 2, 2, 46, 214,
-	3, 2, 2, 2, 48, 218, 3, 2, 2, 2, 50, 220, 3, 2, 2, 2, 52, 54, 5, 4, 3,
-	2, 53, 52, 3, 2, 2, 2, 54, 57, 3, 2, 2, 2, 55, 53, 3, 2, 2, 2, 55, 56,
-	3, 2, 2, 2, 56, 58, 3, 2, 2, 2, 57, 55, 3, 2, 2, 2, 58, 59, 7, 2, 2, 3,
-	59, 3, 3, 2, 2, 2, 60, 61, 7, 4, 2, 2, 61, 63, 5, 8, 5, 2, 62, 64, 5, 10,
-	6, 2, 63, 62, 3, 2, 2, 2, 63, 64, 3, 2, 2, 2, 64, 66, 3, 2, 2, 2, 65, 67,
-	5, 6, 4, 2, 66, 65, 3, 2, 2, 2, 66, 67, 3, 2, 2, 2, 67, 68, 3, 2, 2, 2,
-	68, 69, 7, 28, 2, 2, 69, 70, 5, 12, 7, 2, 70, 71, 5, 14, 8, 2, 71, 72,
-	7, 29, 2, 2, 72, 5, 3, 2, 2, 2, 73, 74, 7, 13, 2, 2, 74, 75, 5, 44, 23,
-	2, 75, 7, 3, 2, 2, 2, 76, 77, 7, 14, 2, 2, 77, 9, 3, 2, 2, 2, 78, 79, 9,
-	2, 2, 2, 79, 11, 3, 2, 2, 2, 80, 81, 7, 5, 2, 2, 81, 82, 5, 22, 12, 2,
-	82, 13, 3, 2, 2, 2, 83, 84, 7, 6, 2, 2, 84, 85, 5, 16, 9, 2, 85, 15, 3,
-	2, 2, 2, 86, 88, 5, 18, 10, 2, 87, 86, 3, 2, 2, 2, 88, 89, 3, 2, 2, 2,
-	89, 87, 3, 2, 2, 2, 89, 90, 3, 2, 2, 2, 90, 17, 3, 2, 2, 2, 91, 92, 5,
-	20, 11, 2, 92, 93, 7, 27, 2, 2, 93, 101, 3, 2, 2, 2, 94, 95, 5, 28, 15,
-	2, 95, 96, 7, 27, 2, 2, 96, 101, 3, 2, 2, 2, 97, 98, 5, 30, 16, 2, 98,
-	99, 7, 27, 2, 2, 99, 101, 3, 2, 2, 2, 100, 91, 3, 2, 2, 2, 100, 94, 3,
-	2, 2, 2, 100, 97, 3, 2, 2, 2, 101, 19, 3, 2, 2, 2, 102, 103, 5, 36, 19,
-	2, 103, 104, 7, 21, 2, 2, 104, 105, 5, 22, 12, 2, 105, 21, 3, 2, 2, 2,
-	106, 107, 8, 12, 1, 2, 107, 108, 7, 30, 2, 2, 108, 109, 5, 22, 12, 2, 109,
-	110, 5, 34, 18, 2, 110, 111, 5, 22, 12, 2, 111, 112, 7, 31, 2, 2, 112,
-	115, 3, 2, 2, 2, 113, 115, 5, 24, 13, 2, 114, 106, 3, 2, 2, 2, 114, 113,
-	3, 2, 2, 2, 115, 122, 3, 2, 2, 2, 116, 117, 12, 5, 2, 2, 117, 118, 5, 34,
-	18, 2, 118, 119, 5, 22, 12, 6, 119, 121, 3, 2, 2, 2, 120, 116, 3, 2, 2,
-	2, 121, 124, 3, 2, 2, 2, 122, 120, 3, 2, 2, 2, 122, 123, 3, 2, 2, 2, 123,
-	23, 3, 2, 2, 2, 124, 122, 3, 2, 2, 2, 125, 126, 5, 26, 14, 2, 126, 127,
-	5, 40, 21, 2, 127, 128, 5, 26, 14, 2, 128, 131, 3, 2, 2, 2, 129, 131, 5,
-	26, 14, 2, 130, 125, 3, 2, 2, 2, 130, 129, 3, 2, 2, 2, 131, 25, 3, 2, 2,
-	2, 132, 133, 8, 14, 1, 2, 133, 144, 5, 42, 22, 2, 134, 144, 5, 36, 19,
-	2, 135, 136, 7, 30, 2, 2, 136, 137, 5, 26, 14, 2, 137, 138, 5, 38, 20,
-	2, 138, 139, 5, 26, 14, 2, 139, 140, 7, 31, 2, 2, 140, 144, 3, 2, 2, 2,
-	141, 144, 5, 30, 16, 2, 142, 144, 5, 28, 15, 2, 143, 132, 3, 2, 2, 2, 143,
-	134, 3, 2, 2, 2, 143, 135, 3, 2, 2, 2, 143, 141, 3, 2, 2, 2, 143, 142,
-	3, 2, 2, 2, 144, 151, 3, 2, 2, 2, 145, 146, 12, 6, 2, 2, 146, 147, 5, 38,
-	20, 2, 147, 148, 5, 26, 14, 7, 148, 150, 3, 2, 2, 2, 149, 145, 3, 2, 2,
-	2, 150, 153, 3, 2, 2, 2, 151, 149, 3, 2, 2, 2, 151, 152, 3, 2, 2, 2, 152,
-	27, 3, 2, 2, 2, 153, 151, 3, 2, 2, 2, 154, 155, 7, 15, 2, 2, 155, 157,
-	7, 30, 2, 2, 156, 158, 5, 32, 17, 2, 157, 156, 3, 2, 2, 2, 157, 158, 3,
-	2, 2, 2, 158, 159, 3, 2, 2, 2, 159, 160, 7, 31, 2, 2, 160, 29, 3, 2, 2,
-	2, 161, 162, 7, 14, 2, 2, 162, 164, 7, 30, 2, 2, 163, 165, 5, 32, 17, 2,
-	164, 163, 3, 2, 2, 2, 164, 165, 3, 2, 2, 2, 165, 166, 3, 2, 2, 2, 166,
-	167, 7, 31, 2, 2, 167, 31, 3, 2, 2, 2, 168, 174, 5, 42, 22, 2, 169, 174,
-	5, 36, 19, 2, 170, 174, 5, 30, 16, 2, 171, 174, 5, 28, 15, 2, 172, 174,
-	5, 22, 12, 2, 173, 168, 3, 2, 2, 2, 173, 169, 3, 2, 2, 2, 173, 170, 3,
-	2, 2, 2, 173, 171, 3, 2, 2, 2, 173, 172, 3, 2, 2, 2, 174, 185, 3, 2, 2,
-	2, 175, 181, 7, 3, 2, 2, 176, 182, 5, 42, 22, 2, 177, 182, 5, 36, 19, 2,
-	178, 182, 5, 30, 16, 2, 179, 182, 5, 28, 15, 2, 180, 182, 5, 22, 12, 2,
-	181, 176, 3, 2, 2, 2, 181, 177, 3, 2, 2, 2, 181, 178, 3, 2, 2, 2, 181,
-	179, 3, 2, 2, 2, 181, 180, 3, 2, 2, 2, 182, 184, 3, 2, 2, 2, 183, 175,
-	3, 2, 2, 2, 184, 187, 3, 2, 2, 2, 185, 183, 3, 2, 2, 2, 185, 186, 3, 2,
-	2, 2, 186, 33, 3, 2, 2, 2, 187, 185, 3, 2, 2, 2, 188, 189, 9, 3, 2, 2,
-	189, 35, 3, 2, 2, 2, 190, 191, 9, 4, 2, 2, 191, 37, 3, 2, 2, 2, 192, 193,
-	9, 5, 2, 2, 193, 39, 3, 2, 2, 2, 194, 195, 9, 6, 2, 2, 195, 41, 3, 2, 2,
-	2, 196, 207, 5, 48, 25, 2, 197, 207, 5, 44, 23, 2, 198, 199, 7, 17, 2,
-	2, 199, 207, 5, 44, 23, 2, 200, 207, 5, 50, 26, 2, 201, 207, 5, 46, 24,
-	2, 202, 204, 7, 12, 2, 2, 203, 202, 3, 2, 2, 2, 203, 204, 3, 2, 2, 2, 204,
-	205, 3, 2, 2, 2, 205, 207, 7, 11, 2, 2, 206, 196, 3, 2, 2, 2, 206, 197,
-	3, 2, 2, 2, 206, 198, 3, 2, 2, 2, 206, 200, 3, 2, 2, 2, 206, 201, 3, 2,
-	2, 2, 206, 203, 3, 2, 2, 2, 207, 43, 3, 2, 2, 2, 208, 210, 7, 17, 2, 2,
-	209, 208, 3, 2, 2, 2, 209, 210, 3, 2, 2, 2, 210, 211, 3, 2, 2, 2, 211,
-	212, 7, 35, 2, 2, 212, 45, 3, 2, 2, 2, 213, 215, 7, 17, 2, 2, 214, 213,
-	3, 2, 2, 2, 214, 215, 3, 2, 2, 2, 215, 216, 3, 2, 2, 2, 216, 217, 7, 36,
-	2, 2, 217, 47, 3, 2, 2, 2, 218, 219, 9, 2, 2, 2, 219, 49, 3, 2, 2, 2, 220,
-	221, 9, 7, 2, 2, 221, 51, 3, 2, 2, 2, 21, 55, 63, 66, 89, 100, 114, 122,
-	130, 143, 151, 157, 164, 173, 181, 185, 203, 206, 209, 214,
+	4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27, 4, 28, 9, 28, 4,
+	29, 9, 29, 4, 30, 9, 30, 4, 31, 9, 31, 4, 32, 9, 32, 4, 33, 9, 33, 4, 34,
+	9, 34, 4, 35, 9, 35, 4, 36, 9, 36, 4, 37, 9, 37, 3, 2, 7, 2, 76, 10, 2,
+	12, 2, 14, 2, 79, 11, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 3, 5, 3, 86, 10, 3,
+	3, 3, 5, 3, 89, 10, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 3, 4, 3, 4,
+	3, 5, 3, 5, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 9, 6, 9,
+	110, 10, 9, 13, 9, 14, 9, 111, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10,
+	3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 5, 10, 129,
+	10, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 5, 11,
+	139, 10, 11, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 5, 12, 148,
+	10, 12, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 14,
+	3, 14, 3, 14, 3, 14, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3,
+	15, 5, 15, 170, 10, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15,
+	3, 15, 3, 15, 3, 15, 7, 15, 182, 10, 15, 12, 15, 14, 15, 185, 11, 15, 3,
+	16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 5, 16, 193, 10, 16, 3, 16, 3, 16,
+	3, 16, 3, 16, 3, 16, 7, 16, 200, 10, 16, 12, 16, 14, 16, 203, 11, 16, 3,
+	16, 3, 16, 3, 16, 5, 16, 208, 10, 16, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17,
+	3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 5, 17, 221, 10, 17, 3, 17, 3,
+	17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 7, 17, 232, 10, 17,
+	12, 17, 14, 17, 235, 11, 17, 3, 18, 3, 18, 3, 18, 5, 18, 240, 10, 18, 3,
+	18, 3, 18, 3, 19, 5, 19, 245, 10, 19, 3, 19, 3, 19, 3, 19, 3, 19, 5, 19,
+	251, 10, 19, 3, 19, 5, 19, 254, 10, 19, 3, 20, 3, 20, 3, 20, 5, 20, 259,
+	10, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 5, 21, 268, 10,
+	21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 5, 21, 276, 10, 21, 7, 21,
+	278, 10, 21, 12, 21, 14, 21, 281, 11, 21, 3, 22, 3, 22, 3, 23, 3, 23, 3,
+	24, 3, 24, 3, 25, 3, 25, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26,
+	5, 26, 298, 10, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 5, 26, 306,
+	10, 26, 3, 27, 3, 27, 3, 27, 3, 27, 7, 27, 312, 10, 27, 12, 27, 14, 27,
+	315, 11, 27, 5, 27, 317, 10, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 3,
+	28, 7, 28, 325, 10, 28, 12, 28, 14, 28, 328, 11, 28, 5, 28, 330, 10, 28,
+	3, 28, 3, 28, 3, 29, 3, 29, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 30, 7,
+	30, 342, 10, 30, 12, 30, 14, 30, 345, 11, 30, 3, 30, 3, 30, 3, 31, 3, 31,
+	3, 31, 3, 31, 3, 32, 5, 32, 354, 10, 32, 3, 32, 3, 32, 3, 33, 5, 33, 359,
+	10, 33, 3, 33, 3, 33, 3, 34, 3, 34, 3, 35, 5, 35, 366, 10, 35, 3, 35, 3,
+	35, 3, 36, 3, 36, 3, 37, 3, 37, 3, 37, 2, 4, 28, 32, 38, 2, 4, 6, 8, 10,
+	12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46,
+	48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 2, 8, 3, 2, 49, 50,
+	3, 2, 20, 21, 3, 2, 7, 8, 3, 2, 22, 30, 5, 2, 18, 19, 31, 31, 33, 37, 3,
+	2, 9, 10, 2, 395, 2, 77, 3, 2, 2, 2, 4, 82, 3, 2, 2, 2, 6, 95, 3, 2, 2,
+	2, 8, 98, 3, 2, 2, 2, 10, 100, 3, 2, 2, 2, 12, 102, 3, 2, 2, 2, 14, 105,
+	3, 2, 2, 2, 16, 109, 3, 2, 2, 2, 18, 128, 3, 2, 2, 2, 20, 130, 3, 2, 2,
+	2, 22, 147, 3, 2, 2, 2, 24, 149, 3, 2, 2, 2, 26, 157, 3, 2, 2, 2, 28, 169,
+	3, 2, 2, 2, 30, 207, 3, 2, 2, 2, 32, 220, 3, 2, 2, 2, 34, 236, 3, 2, 2,
+	2, 36, 244, 3, 2, 2, 2, 38, 255, 3, 2, 2, 2, 40, 267, 3, 2, 2, 2, 42, 282,
+	3, 2, 2, 2, 44, 284, 3, 2, 2, 2, 46, 286, 3, 2, 2, 2, 48, 288, 3, 2, 2,
+	2, 50, 305, 3, 2, 2, 2, 52, 307, 3, 2, 2, 2, 54, 320, 3, 2, 2, 2, 56, 333,
+	3, 2, 2, 2, 58, 337, 3, 2, 2, 2, 60, 348, 3, 2, 2, 2, 62, 353, 3, 2, 2,
+	2, 64, 358, 3, 2, 2, 2, 66, 362, 3, 2, 2, 2, 68, 365, 3, 2, 2, 2, 70, 369,
+	3, 2, 2, 2, 72, 371, 3, 2, 2, 2, 74, 76, 5, 4, 3, 2, 75, 74, 3, 2, 2, 2,
+	76, 79, 3, 2, 2, 2, 77, 75, 3, 2, 2, 2, 77, 78, 3, 2, 2, 2, 78, 80, 3,
+	2, 2, 2, 79, 77, 3, 2, 2, 2, 80, 81, 7, 2, 2, 3, 81, 3, 3, 2, 2, 2, 82,
+	83, 7, 4, 2, 2, 83, 85, 5, 8, 5, 2, 84, 86, 5, 10, 6, 2, 85, 84, 3, 2,
+	2, 2, 85, 86, 3, 2, 2, 2, 86, 88, 3, 2, 2, 2, 87, 89, 5, 6, 4, 2, 88, 87,
+	3, 2, 2, 2, 88, 89, 3, 2, 2, 2, 89, 90, 3, 2, 2, 2, 90, 91, 7, 42, 2, 2,
+	91, 92, 5, 12, 7, 2, 92, 93, 5, 14, 8, 2, 93, 94, 7, 43, 2, 2, 94, 5, 3,
+	2, 2, 2, 95, 96, 7, 13, 2, 2, 96, 97, 5, 62, 32, 2, 97, 7, 3, 2, 2, 2,
+	98, 99, 7, 20, 2, 2, 99, 9, 3, 2, 2, 2, 100, 101, 9, 2, 2, 2, 101, 11,
+	3, 2, 2, 2, 102, 103, 7, 5, 2, 2, 103, 104, 5, 28, 15, 2, 104, 13, 3, 2,
+	2, 2, 105, 106, 7, 6, 2, 2, 106, 107, 5, 16, 9, 2, 107, 15, 3, 2, 2, 2,
+	108, 110, 5, 18, 10, 2, 109, 108, 3, 2, 2, 2, 110, 111, 3, 2, 2, 2, 111,
+	109, 3, 2, 2, 2, 111, 112, 3, 2, 2, 2, 112, 17, 3, 2, 2, 2, 113, 114, 5,
+	26, 14, 2, 114, 115, 7, 38, 2, 2, 115, 129, 3, 2, 2, 2, 116, 117, 5, 34,
+	18, 2, 117, 118, 7, 38, 2, 2, 118, 129, 3, 2, 2, 2, 119, 120, 5, 32, 17,
+	2, 120, 121, 5, 36, 19, 2, 121, 122, 7, 38, 2, 2, 122, 129, 3, 2, 2, 2,
+	123, 124, 5, 38, 20, 2, 124, 125, 7, 38, 2, 2, 125, 129, 3, 2, 2, 2, 126,
+	129, 5, 20, 11, 2, 127, 129, 5, 24, 13, 2, 128, 113, 3, 2, 2, 2, 128, 116,
+	3, 2, 2, 2, 128, 119, 3, 2, 2, 2, 128, 123, 3, 2, 2, 2, 128, 126, 3, 2,
+	2, 2, 128, 127, 3, 2, 2, 2, 129, 19, 3, 2, 2, 2, 130, 131, 7, 14, 2, 2,
+	131, 132, 7, 44, 2, 2, 132, 133, 5, 28, 15, 2, 133, 134, 7, 45, 2, 2, 134,
+	135, 7, 42, 2, 2, 135, 136, 5, 16, 9, 2, 136, 138, 7, 43, 2, 2, 137, 139,
+	5, 22, 12, 2, 138, 137, 3, 2, 2, 2, 138, 139, 3, 2, 2, 2, 139, 21, 3, 2,
+	2, 2, 140, 141, 7, 15, 2, 2, 141, 148, 5, 20, 11, 2, 142, 143, 7, 15, 2,
+	2, 143, 144, 7, 42, 2, 2, 144, 145, 5, 16, 9, 2, 145, 146, 7, 43, 2, 2,
+	146, 148, 3, 2, 2, 2, 147, 140, 3, 2, 2, 2, 147, 142, 3, 2, 2, 2, 148,
+	23, 3, 2, 2, 2, 149, 150, 7, 16, 2, 2, 150, 151, 7, 20, 2, 2, 151, 152,
+	7, 17, 2, 2, 152, 153, 5, 44, 23, 2, 153, 154, 7, 42, 2, 2, 154, 155, 5,
+	16, 9, 2, 155, 156, 7, 43, 2, 2, 156, 25, 3, 2, 2, 2, 157, 158, 5, 44,
+	23, 2, 158, 159, 7, 32, 2, 2, 159, 160, 5, 28, 15, 2, 160, 27, 3, 2, 2,
+	2, 161, 162, 8, 15, 1, 2, 162, 163, 7, 44, 2, 2, 163, 164, 5, 28, 15, 2,
+	164, 165, 5, 42, 22, 2, 165, 166, 5, 28, 15, 2, 166, 167, 7, 45, 2, 2,
+	167, 170, 3, 2, 2, 2, 168, 170, 5, 30, 16, 2, 169, 161, 3, 2, 2, 2, 169,
+	168, 3, 2, 2, 2, 170, 183, 3, 2, 2, 2, 171, 172, 12, 6, 2, 2, 172, 173,
+	5, 42, 22, 2, 173, 174, 5, 28, 15, 7, 174, 182, 3, 2, 2, 2, 175, 176, 12,
+	5, 2, 2, 176, 177, 7, 40, 2, 2, 177, 178, 5, 28, 15, 2, 178, 179, 7, 41,
+	2, 2, 179, 180, 5, 28, 15, 5, 180, 182, 3, 2, 2, 2, 181, 171, 3, 2, 2,
+	2, 181, 175, 3, 2, 2, 2, 182, 185, 3, 2, 2, 2, 183, 181, 3, 2, 2, 2, 183,
+	184, 3, 2, 2, 2, 184, 29, 3, 2, 2, 2, 185, 183, 3, 2, 2, 2, 186, 187, 5,
+	32, 17, 2, 187, 188, 5, 48, 25, 2, 188, 189, 5, 32, 17, 2, 189, 208, 3,
+	2, 2, 2, 190, 192, 5, 32, 17, 2, 191, 193, 7, 12, 2, 2, 192, 191, 3, 2,
+	2, 2, 192, 193, 3, 2, 2, 2, 193, 194, 3, 2, 2, 2, 194, 195, 7, 17, 2, 2,
+	195, 196, 7, 44, 2, 2, 196, 201, 5, 32, 17, 2, 197, 198, 7, 3, 2, 2, 198,
+	200, 5, 32, 17, 2, 199, 197, 3, 2, 2, 2, 200, 203, 3, 2, 2, 2, 201, 199,
+	3, 2, 2, 2, 201, 202, 3, 2, 2, 2, 202, 204, 3, 2, 2, 2, 203, 201, 3, 2,
+	2, 2, 204, 205, 7, 45, 2, 2, 205, 208, 3, 2, 2, 2, 206, 208, 5, 32, 17,
+	2, 207, 186, 3, 2, 2, 2, 207, 190, 3, 2, 2, 2, 207, 206, 3, 2, 2, 2, 208,
+	31, 3, 2, 2, 2, 209, 210, 8, 17, 1, 2, 210, 221, 5, 50, 26, 2, 211, 221,
+	5, 44, 23, 2, 212, 213, 7, 44, 2, 2, 213, 214, 5, 32, 17, 2, 214, 215,
+	5, 46, 24, 2, 215, 216, 5, 32, 17, 2, 216, 217, 7, 45, 2, 2, 217, 221,
+	3, 2, 2, 2, 218, 221, 5, 38, 20, 2, 219, 221, 5, 34, 18, 2, 220, 209, 3,
+	2, 2, 2, 220, 211, 3, 2, 2, 2, 220, 212, 3, 2, 2, 2, 220, 218, 3, 2, 2,
+	2, 220, 219, 3, 2, 2, 2, 221, 233, 3, 2, 2, 2, 222, 223, 12, 7, 2, 2, 223,
+	224, 5, 46, 24, 2, 224, 225, 5, 32, 17, 8, 225, 232, 3, 2, 2, 2, 226, 227,
+	12, 6, 2, 2, 227, 228, 7, 39, 2, 2, 228, 232, 5, 32, 17, 7, 229, 230, 12,
+	8, 2, 2, 230, 232, 5, 36, 19, 2, 231, 222, 3, 2, 2, 2, 231, 226, 3, 2,
+	2, 2, 231, 229, 3, 2, 2, 2, 232, 235, 3, 2, 2, 2, 233, 231, 3, 2, 2, 2,
+	233, 234, 3, 2, 2, 2, 234, 33, 3, 2, 2, 2, 235, 233, 3, 2, 2, 2, 236, 237,
+	7, 21, 2, 2, 237, 239, 7, 44, 2, 2, 238, 240, 5, 40, 21, 2, 239, 238, 3,
+	2, 2, 2, 239, 240, 3, 2, 2, 2, 240, 241, 3, 2, 2, 2, 241, 242, 7, 45, 2,
+	2, 242, 35, 3, 2, 2, 2, 243, 245, 7, 40, 2, 2, 244, 243, 3, 2, 2, 2, 244,
+	245, 3, 2, 2, 2, 245, 246, 3, 2, 2, 2, 246, 247, 7, 48, 2, 2, 247, 253,
+	9, 3, 2, 2, 248, 250, 7, 44, 2, 2, 249, 251, 5, 40, 21, 2, 250, 249, 3,
+	2, 2, 2, 250, 251, 3, 2, 2, 2, 251, 252, 3, 2, 2, 2, 252, 254, 7, 45, 2,
+	2, 253, 248, 3, 2, 2, 2, 253, 254, 3, 2, 2, 2, 254, 37, 3, 2, 2, 2, 255,
+	256, 7, 20, 2, 2, 256, 258, 7, 44, 2, 2, 257, 259, 5, 40, 21, 2, 258, 257,
+	3, 2, 2, 2, 258, 259, 3, 2, 2, 2, 259, 260, 3, 2, 2, 2, 260, 261, 7, 45,
+	2, 2, 261, 39, 3, 2, 2, 2, 262, 268, 5, 50, 26, 2, 263, 268, 5, 44, 23,
+	2, 264, 268, 5, 38, 20, 2, 265, 268, 5, 34, 18, 2, 266, 268, 5, 28, 15,
+	2, 267, 262, 3, 2, 2, 2, 267, 263, 3, 2, 2, 2, 267, 264, 3, 2, 2, 2, 267,
+	265, 3, 2, 2, 2, 267, 266, 3, 2, 2, 2, 268, 279, 3, 2, 2, 2, 269, 275,
+	7, 3, 2, 2, 270, 276, 5, 50, 26, 2, 271, 276, 5, 44, 23, 2, 272, 276, 5,
+	38, 20, 2, 273, 276, 5, 34, 18, 2, 274, 276, 5, 28, 15, 2, 275, 270, 3,
+	2, 2, 2, 275, 271, 3, 2, 2, 2, 275, 272, 3, 2, 2, 2, 275, 273, 3, 2, 2,
+	2, 275, 274, 3, 2, 2, 2, 276, 278, 3, 2, 2, 2, 277, 269, 3, 2, 2, 2, 278,
+	281, 3, 2, 2, 2, 279, 277, 3, 2, 2, 2, 279, 280, 3, 2, 2, 2, 280, 41, 3,
+	2, 2, 2, 281, 279, 3, 2, 2, 2, 282, 283, 9, 4, 2, 2, 283, 43, 3, 2, 2,
+	2, 284, 285, 9, 3, 2, 2, 285, 45, 3, 2, 2, 2, 286, 287, 9, 5, 2, 2, 287,
+	47, 3, 2, 2, 2, 288, 289, 9, 6, 2, 2, 289, 49, 3, 2, 2, 2, 290, 306, 5,
+	70, 36, 2, 291, 306, 5, 62, 32, 2, 292, 293, 7, 23, 2, 2, 293, 306, 5,
+	62, 32, 2, 294, 306, 5, 72, 37, 2, 295, 306, 5, 64, 33, 2, 296, 298, 7,
+	12, 2, 2, 297, 296, 3, 2, 2, 2, 297, 298, 3, 2, 2, 2, 298, 299, 3, 2, 2,
+	2, 299, 306, 7, 11, 2, 2, 300, 306, 5, 66, 34, 2, 301, 306, 5, 68, 35,
+	2, 302, 306, 5, 52, 27, 2, 303, 306, 5, 54, 28, 2, 304, 306, 5, 58, 30,
+	2, 305, 290, 3, 2, 2, 2, 305, 291, 3, 2, 2, 2, 305, 292, 3, 2, 2, 2, 305,
+	294, 3, 2, 2, 2, 305, 295, 3, 2, 2, 2, 305, 297, 3, 2, 2, 2, 305, 300,
+	3, 2, 2, 2, 305, 301, 3, 2, 2, 2, 305, 302, 3, 2, 2, 2, 305, 303, 3, 2,
+	2, 2, 305, 304, 3, 2, 2, 2, 306, 51, 3, 2, 2, 2, 307, 316, 7, 46, 2, 2,
+	308, 313, 5, 50, 26, 2, 309, 310, 7, 3, 2, 2, 310, 312, 5, 50, 26, 2, 311,
+	309, 3, 2, 2, 2, 312, 315, 3, 2, 2, 2, 313, 311, 3, 2, 2, 2, 313, 314,
+	3, 2, 2, 2, 314, 317, 3, 2, 2, 2, 315, 313, 3, 2, 2, 2, 316, 308, 3, 2,
+	2, 2, 316, 317, 3, 2, 2, 2, 317, 318, 3, 2, 2, 2, 318, 319, 7, 47, 2, 2,
+	319, 53, 3, 2, 2, 2, 320, 329, 7, 42, 2, 2, 321, 326, 5, 56, 29, 2, 322,
+	323, 7, 3, 2, 2, 323, 325, 5, 56, 29, 2, 324, 322, 3, 2, 2, 2, 325, 328,
+	3, 2, 2, 2, 326, 324, 3, 2, 2, 2, 326, 327, 3, 2, 2, 2, 327, 330, 3, 2,
+	2, 2, 328, 326, 3, 2, 2, 2, 329, 321, 3, 2, 2, 2, 329, 330, 3, 2, 2, 2,
+	330, 331, 3, 2, 2, 2, 331, 332, 7, 43, 2, 2, 332, 55, 3, 2, 2, 2, 333,
+	334, 5, 50, 26, 2, 334, 335, 7, 41, 2, 2, 335, 336, 5, 50, 26, 2, 336,
+	57, 3, 2, 2, 2, 337, 338, 7, 42, 2, 2, 338, 343, 5, 60, 31, 2, 339, 340,
+	7, 3, 2, 2, 340, 342, 5, 60, 31, 2, 341, 339, 3, 2, 2, 2, 342, 345, 3,
+	2, 2, 2, 343, 341, 3, 2, 2, 2, 343, 344, 3, 2, 2, 2, 344, 346, 3, 2, 2,
+	2, 345, 343, 3, 2, 2, 2, 346, 347, 7, 43, 2, 2, 347, 59, 3, 2, 2, 2, 348,
+	349, 7, 20, 2, 2, 349, 350, 7, 41, 2, 2, 350, 351, 5, 50, 26, 2, 351, 61,
+	3, 2, 2, 2, 352, 354, 7, 23, 2, 2, 353, 352, 3, 2, 2, 2, 353, 354, 3, 2,
+	2, 2, 354, 355, 3, 2, 2, 2, 355, 356, 7, 53, 2, 2, 356, 63, 3, 2, 2, 2,
+	357, 359, 7, 23, 2, 2, 358, 357, 3, 2, 2, 2, 358, 359, 3, 2, 2, 2, 359,
+	360, 3, 2, 2, 2, 360, 361, 7, 54, 2, 2, 361, 65, 3, 2, 2, 2, 362, 363,
+	7, 51, 2, 2, 363, 67, 3, 2, 2, 2, 364, 366, 7, 23, 2, 2, 365, 364, 3, 2,
+	2, 2, 365, 366, 3, 2, 2, 2, 366, 367, 3, 2, 2, 2, 367, 368, 7, 52, 2, 2,
+	368, 69, 3, 2, 2, 2, 369, 370, 9, 2, 2, 2, 370, 71, 3, 2, 2, 2, 371, 372,
+	9, 7, 2, 2, 372, 73, 3, 2, 2, 2, 36, 77, 85, 88, 111, 128, 138, 147, 169,
+	181, 183, 192, 201, 207, 220, 231, 233, 239, 244, 250, 253, 258, 267, 275,
+	279, 297, 305, 313, 316, 326, 329, 343, 353, 358, 365,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
 
 var literalNames = []string{
-	"", "','", "", "", "", "'&&'", "'||'", "", "", "", "", "", "", "", "'+'",
-	"'-'", "'/'", "'*'", "'=='", "'='", "'>'", "'<'", "'>='", "'<='", "'!='",
-	"';'", "'{'", "'}'", "'('", "')'", "'.'",
+	"", "','", "", "", "", "'&&'", "'||'", "", "", "", "", "", "", "", "",
+	"", "", "", "", "", "'+'", "'-'", "'/'", "'*'", "'&'", "'|'", "'^'", "'<<'",
+	"'>>'", "'=='", "'='", "'>'", "'<'", "'>='", "'<='", "'!='", "';'", "'??'",
+	"'?'", "':'", "'{'", "'}'", "'('", "')'", "'['", "']'", "'.'",
 }
 var symbolicNames = []string{
 	"", "", "RULE", "WHEN", "THEN", "AND", "OR", "TRUE", "FALSE", "NULL_LITERAL",
-	"NOT", "SALIENCE", "SIMPLENAME", "DOTTEDNAME", "PLUS", "MINUS", "DIV",
-	"MUL", "EQUALS", "ASSIGN", "GT", "LT", "GTE", "LTE", "NOTEQUALS", "SEMICOLON",
-	"LR_BRACE", "RR_BRACE", "LR_BRACKET", "RR_BRACKET", "DOT", "DQUOTA_STRING",
-	"SQUOTA_STRING", "DECIMAL_LITERAL", "REAL_LITERAL", "SPACE", "COMMENT",
-	"LINE_COMMENT",
+	"NOT", "SALIENCE", "IF", "ELSE", "FOR", "IN", "CONTAINS", "MATCHES", "SIMPLENAME",
+	"DOTTEDNAME", "PLUS", "MINUS", "DIV", "MUL", "BITAND", "BITOR", "BITXOR",
+	"SHL", "SHR", "EQUALS", "ASSIGN", "GT", "LT", "GTE", "LTE", "NOTEQUALS",
+	"SEMICOLON", "NULL_COALESCE", "QUESTION", "COLON", "LR_BRACE", "RR_BRACE",
+	"LR_BRACKET", "RR_BRACKET", "LS_BRACKET", "RS_BRACKET", "DOT", "DQUOTA_STRING",
+	"SQUOTA_STRING", "TIME_LITERAL", "DURATION_LITERAL", "DECIMAL_LITERAL",
+	"REAL_LITERAL", "SPACE", "COMMENT", "LINE_COMMENT",
 }
 
 var ruleNames = []string{
 	"root", "ruleEntry", "salience", "ruleName", "ruleDescription", "whenScope",
-	"thenScope", "assignExpressions", "assignExpression", "assignment", "expression",
-	"predicate", "expressionAtom", "methodCall", "functionCall", "functionArgs",
-	"logicalOperator", "variable", "mathOperator", "comparisonOperator", "constant",
-	"decimalLiteral", "realLiteral", "stringLiteral", "booleanLiteral",
+	"thenScope", "assignExpressions", "assignExpression", "ifStatement", "elseStatement",
+	"forStatement", "assignment", "expression", "predicate", "expressionAtom",
+	"methodCall", "selector", "functionCall", "functionArgs", "logicalOperator",
+	"variable", "mathOperator", "comparisonOperator", "constant", "listLiteral",
+	"mapLiteral", "mapEntry", "structLiteral", "fieldEntry", "decimalLiteral",
+	"realLiteral", "timeLiteral", "durationLiteral", "stringLiteral", "booleanLiteral",
 }
 var decisionToDFA = make([]*antlr.DFA, len(deserializedATN.DecisionToState))
 
@@ -166,44 +240,62 @@ func NewgroolParser(input antlr.TokenStream) *groolParser {
 
 // groolParser tokens.
 const (
-	groolParserEOF             = antlr.TokenEOF
-	groolParserT__0            = 1
-	groolParserRULE            = 2
-	groolParserWHEN            = 3
-	groolParserTHEN            = 4
-	groolParserAND             = 5
-	groolParserOR              = 6
-	groolParserTRUE            = 7
-	groolParserFALSE           = 8
-	groolParserNULL_LITERAL    = 9
-	groolParserNOT             = 10
-	groolParserSALIENCE        = 11
-	groolParserSIMPLENAME      = 12
-	groolParserDOTTEDNAME      = 13
-	groolParserPLUS            = 14
-	groolParserMINUS           = 15
-	groolParserDIV             = 16
-	groolParserMUL             = 17
-	groolParserEQUALS          = 18
-	groolParserASSIGN          = 19
-	groolParserGT              = 20
-	groolParserLT              = 21
-	groolParserGTE             = 22
-	groolParserLTE             = 23
-	groolParserNOTEQUALS       = 24
-	groolParserSEMICOLON       = 25
-	groolParserLR_BRACE        = 26
-	groolParserRR_BRACE        = 27
-	groolParserLR_BRACKET      = 28
-	groolParserRR_BRACKET      = 29
-	groolParserDOT             = 30
-	groolParserDQUOTA_STRING   = 31
-	groolParserSQUOTA_STRING   = 32
-	groolParserDECIMAL_LITERAL = 33
-	groolParserREAL_LITERAL    = 34
-	groolParserSPACE           = 35
-	groolParserCOMMENT         = 36
-	groolParserLINE_COMMENT    = 37
+	groolParserEOF              = antlr.TokenEOF
+	groolParserT__0             = 1
+	groolParserRULE             = 2
+	groolParserWHEN             = 3
+	groolParserTHEN             = 4
+	groolParserAND              = 5
+	groolParserOR               = 6
+	groolParserTRUE             = 7
+	groolParserFALSE            = 8
+	groolParserNULL_LITERAL     = 9
+	groolParserNOT              = 10
+	groolParserSALIENCE         = 11
+	groolParserIF               = 12
+	groolParserELSE             = 13
+	groolParserFOR              = 14
+	groolParserIN               = 15
+	groolParserCONTAINS         = 16
+	groolParserMATCHES          = 17
+	groolParserSIMPLENAME       = 18
+	groolParserDOTTEDNAME       = 19
+	groolParserPLUS             = 20
+	groolParserMINUS            = 21
+	groolParserDIV              = 22
+	groolParserMUL              = 23
+	groolParserBITAND           = 24
+	groolParserBITOR            = 25
+	groolParserBITXOR           = 26
+	groolParserSHL              = 27
+	groolParserSHR              = 28
+	groolParserEQUALS           = 29
+	groolParserASSIGN           = 30
+	groolParserGT               = 31
+	groolParserLT               = 32
+	groolParserGTE              = 33
+	groolParserLTE              = 34
+	groolParserNOTEQUALS        = 35
+	groolParserSEMICOLON        = 36
+	groolParserNULL_COALESCE    = 37
+	groolParserQUESTION         = 38
+	groolParserCOLON            = 39
+	groolParserLR_BRACE         = 40
+	groolParserRR_BRACE         = 41
+	groolParserLR_BRACKET       = 42
+	groolParserRR_BRACKET       = 43
+	groolParserLS_BRACKET       = 44
+	groolParserRS_BRACKET       = 45
+	groolParserDOT              = 46
+	groolParserDQUOTA_STRING    = 47
+	groolParserSQUOTA_STRING    = 48
+	groolParserTIME_LITERAL     = 49
+	groolParserDURATION_LITERAL = 50
+	groolParserDECIMAL_LITERAL  = 51
+	groolParserREAL_LITERAL     = 52
+	groolParserSPACE            = 53
+	groolParserCOMMENT          = 54
+	groolParserLINE_COMMENT     = 55
 )
 
 // groolParser rules.
@@ -217,22 +309,33 @@ const (
 	groolParserRULE_thenScope          = 6
 	groolParserRULE_assignExpressions  = 7
 	groolParserRULE_assignExpression   = 8
-	groolParserRULE_assignment         = 9
-	groolParserRULE_expression         = 10
-	groolParserRULE_predicate          = 11
-	groolParserRULE_expressionAtom     = 12
-	groolParserRULE_methodCall         = 13
-	groolParserRULE_functionCall       = 14
-	groolParserRULE_functionArgs       = 15
-	groolParserRULE_logicalOperator    = 16
-	groolParserRULE_variable           = 17
-	groolParserRULE_mathOperator       = 18
-	groolParserRULE_comparisonOperator = 19
-	groolParserRULE_constant           = 20
-	groolParserRULE_decimalLiteral     = 21
-	groolParserRULE_realLiteral        = 22
-	groolParserRULE_stringLiteral      = 23
-	groolParserRULE_booleanLiteral     = 24
+	groolParserRULE_ifStatement        = 9
+	groolParserRULE_elseStatement      = 10
+	groolParserRULE_forStatement       = 11
+	groolParserRULE_assignment         = 12
+	groolParserRULE_expression         = 13
+	groolParserRULE_predicate          = 14
+	groolParserRULE_expressionAtom     = 15
+	groolParserRULE_methodCall         = 16
+	groolParserRULE_selector           = 17
+	groolParserRULE_functionCall       = 18
+	groolParserRULE_functionArgs       = 19
+	groolParserRULE_logicalOperator    = 20
+	groolParserRULE_variable           = 21
+	groolParserRULE_mathOperator       = 22
+	groolParserRULE_comparisonOperator = 23
+	groolParserRULE_constant           = 24
+	groolParserRULE_listLiteral        = 25
+	groolParserRULE_mapLiteral         = 26
+	groolParserRULE_mapEntry           = 27
+	groolParserRULE_structLiteral      = 28
+	groolParserRULE_fieldEntry         = 29
+	groolParserRULE_decimalLiteral     = 30
+	groolParserRULE_realLiteral        = 31
+	groolParserRULE_timeLiteral        = 32
+	groolParserRULE_durationLiteral    = 33
+	groolParserRULE_stringLiteral      = 34
+	groolParserRULE_booleanLiteral     = 35
 )
 
 // IRootContext is an interface to support dynamic dispatch.
@@ -342,22 +445,22 @@ func (p *groolParser) Root() (localctx IRootContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(53)
+	p.SetState(75)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == groolParserRULE {
 		{
-			p.SetState(50)
+			p.SetState(72)
 			p.RuleEntry()
 		}
 
-		p.SetState(55)
+		p.SetState(77)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(56)
+		p.SetState(78)
 		p.Match(groolParserEOF)
 	}
 
@@ -507,49 +610,49 @@ func (p *groolParser) RuleEntry() (localctx IRuleEntryContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(58)
+		p.SetState(80)
 		p.Match(groolParserRULE)
 	}
 	{
-		p.SetState(59)
+		p.SetState(81)
 		p.RuleName()
 	}
-	p.SetState(61)
+	p.SetState(83)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == groolParserDQUOTA_STRING || _la == groolParserSQUOTA_STRING {
 		{
-			p.SetState(60)
+			p.SetState(82)
 			p.RuleDescription()
 		}
 
 	}
-	p.SetState(64)
+	p.SetState(86)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == groolParserSALIENCE {
 		{
-			p.SetState(63)
+			p.SetState(85)
 			p.Salience()
 		}
 
 	}
 	{
-		p.SetState(66)
+		p.SetState(88)
 		p.Match(groolParserLR_BRACE)
 	}
 	{
-		p.SetState(67)
+		p.SetState(89)
 		p.WhenScope()
 	}
 	{
-		p.SetState(68)
+		p.SetState(90)
 		p.ThenScope()
 	}
 	{
-		p.SetState(69)
+		p.SetState(91)
 		p.Match(groolParserRR_BRACE)
 	}
 
@@ -650,11 +753,11 @@ func (p *groolParser) Salience() (localctx ISalienceContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(71)
+		p.SetState(93)
 		p.Match(groolParserSALIENCE)
 	}
 	{
-		p.SetState(72)
+		p.SetState(94)
 		p.DecimalLiteral()
 	}
 
@@ -745,7 +848,7 @@ func (p *groolParser) RuleName() (localctx IRuleNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(74)
+		p.SetState(96)
 		p.Match(groolParserSIMPLENAME)
 	}
 
@@ -840,7 +943,7 @@ func (p *groolParser) RuleDescription() (localctx IRuleDescriptionContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(76)
+	p.SetState(98)
 	_la = p.GetTokenStream().LA(1)
 
 	if !(_la == groolParserDQUOTA_STRING || _la == groolParserSQUOTA_STRING) {
@@ -947,11 +1050,11 @@ func (p *groolParser) WhenScope() (localctx IWhenScopeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(78)
+		p.SetState(100)
 		p.Match(groolParserWHEN)
 	}
 	{
-		p.SetState(79)
+		p.SetState(101)
 		p.expression(0)
 	}
 
@@ -1052,11 +1155,11 @@ func (p *groolParser) ThenScope() (localctx IThenScopeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(81)
+		p.SetState(103)
 		p.Match(groolParserTHEN)
 	}
 	{
-		p.SetState(82)
+		p.SetState(104)
 		p.AssignExpressions()
 	}
 
@@ -1166,17 +1269,17 @@ func (p *groolParser) AssignExpressions() (localctx IAssignExpressionsContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(85)
+	p.SetState(107)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for ok := true; ok; ok = _la == groolParserSIMPLENAME || _la == groolParserDOTTEDNAME {
+	for ok := true; ok; ok = (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<groolParserTRUE)|(1<<groolParserFALSE)|(1<<groolParserNULL_LITERAL)|(1<<groolParserNOT)|(1<<groolParserIF)|(1<<groolParserFOR)|(1<<groolParserSIMPLENAME)|(1<<groolParserDOTTEDNAME)|(1<<groolParserMINUS))) != 0) || (((_la-40)&-(0x1f+1)) == 0 && ((1<<uint((_la-40)))&((1<<(groolParserLR_BRACE-40))|(1<<(groolParserLR_BRACKET-40))|(1<<(groolParserLS_BRACKET-40))|(1<<(groolParserDQUOTA_STRING-40))|(1<<(groolParserSQUOTA_STRING-40))|(1<<(groolParserTIME_LITERAL-40))|(1<<(groolParserDURATION_LITERAL-40))|(1<<(groolParserDECIMAL_LITERAL-40))|(1<<(groolParserREAL_LITERAL-40)))) != 0) {
 		{
-			p.SetState(84)
+			p.SetState(106)
 			p.AssignExpression()
 		}
 
-		p.SetState(87)
+		p.SetState(109)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -1246,6 +1349,26 @@ func (s *AssignExpressionContext) MethodCall() IMethodCallContext {
 	return t.(IMethodCallContext)
 }
 
+func (s *AssignExpressionContext) ExpressionAtom() IExpressionAtomContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionAtomContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionAtomContext)
+}
+
+func (s *AssignExpressionContext) Selector() ISelectorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISelectorContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectorContext)
+}
+
 func (s *AssignExpressionContext) FunctionCall() IFunctionCallContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionCallContext)(nil)).Elem(), 0)
 
@@ -1256,6 +1379,26 @@ func (s *AssignExpressionContext) FunctionCall() IFunctionCallContext {
 	return t.(IFunctionCallContext)
 }
 
+func (s *AssignExpressionContext) IfStatement() IIfStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIfStatementContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIfStatementContext)
+}
+
+func (s *AssignExpressionContext) ForStatement() IForStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IForStatementContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IForStatementContext)
+}
+
 func (s *AssignExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1296,132 +1439,188 @@ func (p *groolParser) AssignExpression() (localctx IAssignExpressionContext) {
 		}
 	}()
 
-	p.SetState(98)
+	p.SetState(126)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 4, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(89)
+			p.SetState(111)
 			p.Assignment()
 		}
 		{
-			p.SetState(90)
+			p.SetState(112)
 			p.Match(groolParserSEMICOLON)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(92)
+			p.SetState(114)
 			p.MethodCall()
 		}
 		{
-			p.SetState(93)
+			p.SetState(115)
 			p.Match(groolParserSEMICOLON)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(95)
+			p.SetState(117)
+			p.expressionAtom(0)
+		}
+		{
+			p.SetState(118)
+			p.Selector()
+		}
+		{
+			p.SetState(119)
+			p.Match(groolParserSEMICOLON)
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(121)
 			p.FunctionCall()
 		}
 		{
-			p.SetState(96)
+			p.SetState(122)
 			p.Match(groolParserSEMICOLON)
 		}
 
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(124)
+			p.IfStatement()
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(125)
+			p.ForStatement()
+		}
+
 	}
 
 	return localctx
 }
 
-// IAssignmentContext is an interface to support dynamic dispatch.
-type IAssignmentContext interface {
+// IIfStatementContext is an interface to support dynamic dispatch.
+type IIfStatementContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsAssignmentContext differentiates from other interfaces.
-	IsAssignmentContext()
+	// IsIfStatementContext differentiates from other interfaces.
+	IsIfStatementContext()
 }
 
-type AssignmentContext struct {
+type IfStatementContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyAssignmentContext() *AssignmentContext {
-	var p = new(AssignmentContext)
+func NewEmptyIfStatementContext() *IfStatementContext {
+	var p = new(IfStatementContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = groolParserRULE_assignment
+	p.RuleIndex = groolParserRULE_ifStatement
 	return p
 }
 
-func (*AssignmentContext) IsAssignmentContext() {}
+func (*IfStatementContext) IsIfStatementContext() {}
 
-func NewAssignmentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AssignmentContext {
-	var p = new(AssignmentContext)
+func NewIfStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IfStatementContext {
+	var p = new(IfStatementContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = groolParserRULE_assignment
+	p.RuleIndex = groolParserRULE_ifStatement
 
 	return p
 }
 
-func (s *AssignmentContext) GetParser() antlr.Parser { return s.parser }
+func (s *IfStatementContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *AssignmentContext) Variable() IVariableContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVariableContext)(nil)).Elem(), 0)
+func (s *IfStatementContext) IF() antlr.TerminalNode {
+	return s.GetToken(groolParserIF, 0)
+}
+
+func (s *IfStatementContext) LR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(groolParserLR_BRACKET, 0)
+}
+
+func (s *IfStatementContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IVariableContext)
+	return t.(IExpressionContext)
 }
 
-func (s *AssignmentContext) ASSIGN() antlr.TerminalNode {
-	return s.GetToken(groolParserASSIGN, 0)
+func (s *IfStatementContext) RR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(groolParserRR_BRACKET, 0)
 }
 
-func (s *AssignmentContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+func (s *IfStatementContext) LR_BRACE() antlr.TerminalNode {
+	return s.GetToken(groolParserLR_BRACE, 0)
+}
+
+func (s *IfStatementContext) AssignExpressions() IAssignExpressionsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAssignExpressionsContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IAssignExpressionsContext)
 }
 
-func (s *AssignmentContext) GetRuleContext() antlr.RuleContext {
+func (s *IfStatementContext) RR_BRACE() antlr.TerminalNode {
+	return s.GetToken(groolParserRR_BRACE, 0)
+}
+
+func (s *IfStatementContext) ElseStatement() IElseStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IElseStatementContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IElseStatementContext)
+}
+
+func (s *IfStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AssignmentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *IfStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *AssignmentContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *IfStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.EnterAssignment(s)
+		listenerT.EnterIfStatement(s)
 	}
 }
 
-func (s *AssignmentContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *IfStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.ExitAssignment(s)
+		listenerT.ExitIfStatement(s)
 	}
 }
 
-func (p *groolParser) Assignment() (localctx IAssignmentContext) {
-	localctx = NewAssignmentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 18, groolParserRULE_assignment)
+func (p *groolParser) IfStatement() (localctx IIfStatementContext) {
+	localctx = NewIfStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 18, groolParserRULE_ifStatement)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -1441,145 +1640,144 @@ func (p *groolParser) Assignment() (localctx IAssignmentContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(100)
-		p.Variable()
+		p.SetState(128)
+		p.Match(groolParserIF)
 	}
 	{
-		p.SetState(101)
-		p.Match(groolParserASSIGN)
+		p.SetState(129)
+		p.Match(groolParserLR_BRACKET)
 	}
 	{
-		p.SetState(102)
+		p.SetState(130)
 		p.expression(0)
 	}
+	{
+		p.SetState(131)
+		p.Match(groolParserRR_BRACKET)
+	}
+	{
+		p.SetState(132)
+		p.Match(groolParserLR_BRACE)
+	}
+	{
+		p.SetState(133)
+		p.AssignExpressions()
+	}
+	{
+		p.SetState(134)
+		p.Match(groolParserRR_BRACE)
+	}
+	p.SetState(136)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == groolParserELSE {
+		{
+			p.SetState(135)
+			p.ElseStatement()
+		}
+
+	}
 
 	return localctx
 }
 
-// IExpressionContext is an interface to support dynamic dispatch.
-type IExpressionContext interface {
+// IElseStatementContext is an interface to support dynamic dispatch.
+type IElseStatementContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsExpressionContext differentiates from other interfaces.
-	IsExpressionContext()
+	// IsElseStatementContext differentiates from other interfaces.
+	IsElseStatementContext()
 }
 
-type ExpressionContext struct {
+type ElseStatementContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExpressionContext() *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewEmptyElseStatementContext() *ElseStatementContext {
+	var p = new(ElseStatementContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = groolParserRULE_expression
+	p.RuleIndex = groolParserRULE_elseStatement
 	return p
 }
 
-func (*ExpressionContext) IsExpressionContext() {}
+func (*ElseStatementContext) IsElseStatementContext() {}
 
-func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewElseStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ElseStatementContext {
+	var p = new(ElseStatementContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = groolParserRULE_expression
+	p.RuleIndex = groolParserRULE_elseStatement
 
 	return p
 }
 
-func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ExpressionContext) LR_BRACKET() antlr.TerminalNode {
-	return s.GetToken(groolParserLR_BRACKET, 0)
-}
-
-func (s *ExpressionContext) AllExpression() []IExpressionContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
-	var tst = make([]IExpressionContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExpressionContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *ExpressionContext) Expression(i int) IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
+func (s *ElseStatementContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IExpressionContext)
+func (s *ElseStatementContext) ELSE() antlr.TerminalNode {
+	return s.GetToken(groolParserELSE, 0)
 }
 
-func (s *ExpressionContext) LogicalOperator() ILogicalOperatorContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILogicalOperatorContext)(nil)).Elem(), 0)
+func (s *ElseStatementContext) IfStatement() IIfStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIfStatementContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ILogicalOperatorContext)
+	return t.(IIfStatementContext)
 }
 
-func (s *ExpressionContext) RR_BRACKET() antlr.TerminalNode {
-	return s.GetToken(groolParserRR_BRACKET, 0)
+func (s *ElseStatementContext) LR_BRACE() antlr.TerminalNode {
+	return s.GetToken(groolParserLR_BRACE, 0)
 }
 
-func (s *ExpressionContext) Predicate() IPredicateContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPredicateContext)(nil)).Elem(), 0)
+func (s *ElseStatementContext) AssignExpressions() IAssignExpressionsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAssignExpressionsContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IPredicateContext)
+	return t.(IAssignExpressionsContext)
 }
 
-func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *ElseStatementContext) RR_BRACE() antlr.TerminalNode {
+	return s.GetToken(groolParserRR_BRACE, 0)
+}
+
+func (s *ElseStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ElseStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ElseStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.EnterExpression(s)
+		listenerT.EnterElseStatement(s)
 	}
 }
 
-func (s *ExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ElseStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.ExitExpression(s)
+		listenerT.ExitElseStatement(s)
 	}
 }
 
-func (p *groolParser) Expression() (localctx IExpressionContext) {
-	return p.expression(0)
-}
-
-func (p *groolParser) expression(_p int) (localctx IExpressionContext) {
-	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
-	_parentState := p.GetState()
-	localctx = NewExpressionContext(p, p.GetParserRuleContext(), _parentState)
-	var _prevctx IExpressionContext = localctx
-	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 20
-	p.EnterRecursionRule(localctx, 20, groolParserRULE_expression, _p)
+func (p *groolParser) ElseStatement() (localctx IElseStatementContext) {
+	localctx = NewElseStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, groolParserRULE_elseStatement)
 
 	defer func() {
-		p.UnrollRecursionContexts(_parentctx)
+		p.ExitRule()
 	}()
 
 	defer func() {
@@ -1594,171 +1792,145 @@ func (p *groolParser) expression(_p int) (localctx IExpressionContext) {
 		}
 	}()
 
-	var _alt int
-
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(112)
+	p.SetState(145)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 5, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext()) {
 	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(105)
-			p.Match(groolParserLR_BRACKET)
+			p.SetState(138)
+			p.Match(groolParserELSE)
 		}
 		{
-			p.SetState(106)
-			p.expression(0)
+			p.SetState(139)
+			p.IfStatement()
 		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(107)
-			p.LogicalOperator()
+			p.SetState(140)
+			p.Match(groolParserELSE)
 		}
 		{
-			p.SetState(108)
-			p.expression(0)
+			p.SetState(141)
+			p.Match(groolParserLR_BRACE)
 		}
 		{
-			p.SetState(109)
-			p.Match(groolParserRR_BRACKET)
+			p.SetState(142)
+			p.AssignExpressions()
 		}
-
-	case 2:
 		{
-			p.SetState(111)
-			p.Predicate()
+			p.SetState(143)
+			p.Match(groolParserRR_BRACE)
 		}
 
 	}
-	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(120)
-	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
-
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			if p.GetParseListeners() != nil {
-				p.TriggerExitRuleEvent()
-			}
-			_prevctx = localctx
-			localctx = NewExpressionContext(p, _parentctx, _parentState)
-			p.PushNewRecursionContext(localctx, _startState, groolParserRULE_expression)
-			p.SetState(114)
-
-			if !(p.Precpred(p.GetParserRuleContext(), 3)) {
-				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
-			}
-			{
-				p.SetState(115)
-				p.LogicalOperator()
-			}
-			{
-				p.SetState(116)
-				p.expression(4)
-			}
-
-		}
-		p.SetState(122)
-		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
-	}
 
 	return localctx
 }
 
-// IPredicateContext is an interface to support dynamic dispatch.
-type IPredicateContext interface {
+// IForStatementContext is an interface to support dynamic dispatch.
+type IForStatementContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsPredicateContext differentiates from other interfaces.
-	IsPredicateContext()
+	// IsForStatementContext differentiates from other interfaces.
+	IsForStatementContext()
 }
 
-type PredicateContext struct {
+type ForStatementContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPredicateContext() *PredicateContext {
-	var p = new(PredicateContext)
+func NewEmptyForStatementContext() *ForStatementContext {
+	var p = new(ForStatementContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = groolParserRULE_predicate
+	p.RuleIndex = groolParserRULE_forStatement
 	return p
 }
 
-func (*PredicateContext) IsPredicateContext() {}
+func (*ForStatementContext) IsForStatementContext() {}
 
-func NewPredicateContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PredicateContext {
-	var p = new(PredicateContext)
+func NewForStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ForStatementContext {
+	var p = new(ForStatementContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = groolParserRULE_predicate
+	p.RuleIndex = groolParserRULE_forStatement
 
 	return p
 }
 
-func (s *PredicateContext) GetParser() antlr.Parser { return s.parser }
+func (s *ForStatementContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PredicateContext) AllExpressionAtom() []IExpressionAtomContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionAtomContext)(nil)).Elem())
-	var tst = make([]IExpressionAtomContext, len(ts))
+func (s *ForStatementContext) FOR() antlr.TerminalNode {
+	return s.GetToken(groolParserFOR, 0)
+}
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExpressionAtomContext)
-		}
-	}
+func (s *ForStatementContext) SIMPLENAME() antlr.TerminalNode {
+	return s.GetToken(groolParserSIMPLENAME, 0)
+}
 
-	return tst
+func (s *ForStatementContext) IN() antlr.TerminalNode {
+	return s.GetToken(groolParserIN, 0)
 }
 
-func (s *PredicateContext) ExpressionAtom(i int) IExpressionAtomContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionAtomContext)(nil)).Elem(), i)
+func (s *ForStatementContext) Variable() IVariableContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVariableContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionAtomContext)
+	return t.(IVariableContext)
 }
 
-func (s *PredicateContext) ComparisonOperator() IComparisonOperatorContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IComparisonOperatorContext)(nil)).Elem(), 0)
+func (s *ForStatementContext) LR_BRACE() antlr.TerminalNode {
+	return s.GetToken(groolParserLR_BRACE, 0)
+}
+
+func (s *ForStatementContext) AssignExpressions() IAssignExpressionsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAssignExpressionsContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IComparisonOperatorContext)
+	return t.(IAssignExpressionsContext)
 }
 
-func (s *PredicateContext) GetRuleContext() antlr.RuleContext {
+func (s *ForStatementContext) RR_BRACE() antlr.TerminalNode {
+	return s.GetToken(groolParserRR_BRACE, 0)
+}
+
+func (s *ForStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PredicateContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ForStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PredicateContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ForStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.EnterPredicate(s)
+		listenerT.EnterForStatement(s)
 	}
 }
 
-func (s *PredicateContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ForStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.ExitPredicate(s)
+		listenerT.ExitForStatement(s)
 	}
 }
 
-func (p *groolParser) Predicate() (localctx IPredicateContext) {
-	localctx = NewPredicateContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, groolParserRULE_predicate)
+func (p *groolParser) ForStatement() (localctx IForStatementContext) {
+	localctx = NewForStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, groolParserRULE_forStatement)
 
 	defer func() {
 		p.ExitRule()
@@ -1776,107 +1948,78 @@ func (p *groolParser) Predicate() (localctx IPredicateContext) {
 		}
 	}()
 
-	p.SetState(128)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 7, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(123)
-			p.expressionAtom(0)
-		}
-		{
-			p.SetState(124)
-			p.ComparisonOperator()
-		}
-		{
-			p.SetState(125)
-			p.expressionAtom(0)
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(127)
-			p.expressionAtom(0)
-		}
-
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(147)
+		p.Match(groolParserFOR)
+	}
+	{
+		p.SetState(148)
+		p.Match(groolParserSIMPLENAME)
+	}
+	{
+		p.SetState(149)
+		p.Match(groolParserIN)
+	}
+	{
+		p.SetState(150)
+		p.Variable()
+	}
+	{
+		p.SetState(151)
+		p.Match(groolParserLR_BRACE)
+	}
+	{
+		p.SetState(152)
+		p.AssignExpressions()
+	}
+	{
+		p.SetState(153)
+		p.Match(groolParserRR_BRACE)
 	}
 
 	return localctx
 }
 
-// IExpressionAtomContext is an interface to support dynamic dispatch.
-type IExpressionAtomContext interface {
+// IAssignmentContext is an interface to support dynamic dispatch.
+type IAssignmentContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// GetLeft returns the left rule contexts.
-	GetLeft() IExpressionAtomContext
-
-	// GetRight returns the right rule contexts.
-	GetRight() IExpressionAtomContext
-
-	// SetLeft sets the left rule contexts.
-	SetLeft(IExpressionAtomContext)
-
-	// SetRight sets the right rule contexts.
-	SetRight(IExpressionAtomContext)
-
-	// IsExpressionAtomContext differentiates from other interfaces.
-	IsExpressionAtomContext()
+	// IsAssignmentContext differentiates from other interfaces.
+	IsAssignmentContext()
 }
 
-type ExpressionAtomContext struct {
+type AssignmentContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
-	left   IExpressionAtomContext
-	right  IExpressionAtomContext
 }
 
-func NewEmptyExpressionAtomContext() *ExpressionAtomContext {
-	var p = new(ExpressionAtomContext)
+func NewEmptyAssignmentContext() *AssignmentContext {
+	var p = new(AssignmentContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = groolParserRULE_expressionAtom
+	p.RuleIndex = groolParserRULE_assignment
 	return p
 }
 
-func (*ExpressionAtomContext) IsExpressionAtomContext() {}
+func (*AssignmentContext) IsAssignmentContext() {}
 
-func NewExpressionAtomContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionAtomContext {
-	var p = new(ExpressionAtomContext)
+func NewAssignmentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AssignmentContext {
+	var p = new(AssignmentContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = groolParserRULE_expressionAtom
+	p.RuleIndex = groolParserRULE_assignment
 
 	return p
 }
 
-func (s *ExpressionAtomContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ExpressionAtomContext) GetLeft() IExpressionAtomContext { return s.left }
-
-func (s *ExpressionAtomContext) GetRight() IExpressionAtomContext { return s.right }
-
-func (s *ExpressionAtomContext) SetLeft(v IExpressionAtomContext) { s.left = v }
-
-func (s *ExpressionAtomContext) SetRight(v IExpressionAtomContext) { s.right = v }
-
-func (s *ExpressionAtomContext) Constant() IConstantContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConstantContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IConstantContext)
-}
+func (s *AssignmentContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExpressionAtomContext) Variable() IVariableContext {
+func (s *AssignmentContext) Variable() IVariableContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVariableContext)(nil)).Elem(), 0)
 
 	if t == nil {
@@ -1886,102 +2029,46 @@ func (s *ExpressionAtomContext) Variable() IVariableContext {
 	return t.(IVariableContext)
 }
 
-func (s *ExpressionAtomContext) LR_BRACKET() antlr.TerminalNode {
-	return s.GetToken(groolParserLR_BRACKET, 0)
-}
-
-func (s *ExpressionAtomContext) MathOperator() IMathOperatorContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMathOperatorContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IMathOperatorContext)
-}
-
-func (s *ExpressionAtomContext) RR_BRACKET() antlr.TerminalNode {
-	return s.GetToken(groolParserRR_BRACKET, 0)
-}
-
-func (s *ExpressionAtomContext) AllExpressionAtom() []IExpressionAtomContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionAtomContext)(nil)).Elem())
-	var tst = make([]IExpressionAtomContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExpressionAtomContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *ExpressionAtomContext) ExpressionAtom(i int) IExpressionAtomContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionAtomContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExpressionAtomContext)
-}
-
-func (s *ExpressionAtomContext) FunctionCall() IFunctionCallContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionCallContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFunctionCallContext)
+func (s *AssignmentContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(groolParserASSIGN, 0)
 }
 
-func (s *ExpressionAtomContext) MethodCall() IMethodCallContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMethodCallContext)(nil)).Elem(), 0)
+func (s *AssignmentContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IMethodCallContext)
+	return t.(IExpressionContext)
 }
 
-func (s *ExpressionAtomContext) GetRuleContext() antlr.RuleContext {
+func (s *AssignmentContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExpressionAtomContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AssignmentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ExpressionAtomContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AssignmentContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.EnterExpressionAtom(s)
+		listenerT.EnterAssignment(s)
 	}
 }
 
-func (s *ExpressionAtomContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AssignmentContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.ExitExpressionAtom(s)
+		listenerT.ExitAssignment(s)
 	}
 }
 
-func (p *groolParser) ExpressionAtom() (localctx IExpressionAtomContext) {
-	return p.expressionAtom(0)
-}
-
-func (p *groolParser) expressionAtom(_p int) (localctx IExpressionAtomContext) {
-	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
-	_parentState := p.GetState()
-	localctx = NewExpressionAtomContext(p, p.GetParserRuleContext(), _parentState)
-	var _prevctx IExpressionAtomContext = localctx
-	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 24
-	p.EnterRecursionRule(localctx, 24, groolParserRULE_expressionAtom, _p)
+func (p *groolParser) Assignment() (localctx IAssignmentContext) {
+	localctx = NewAssignmentContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, groolParserRULE_assignment)
 
 	defer func() {
-		p.UnrollRecursionContexts(_parentctx)
+		p.ExitRule()
 	}()
 
 	defer func() {
@@ -1996,67 +2083,206 @@ func (p *groolParser) expressionAtom(_p int) (localctx IExpressionAtomContext) {
 		}
 	}()
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(141)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 8, p.GetParserRuleContext()) {
-	case 1:
-		{
-			p.SetState(131)
-			p.Constant()
-		}
+	{
+		p.SetState(155)
+		p.Variable()
+	}
+	{
+		p.SetState(156)
+		p.Match(groolParserASSIGN)
+	}
+	{
+		p.SetState(157)
+		p.expression(0)
+	}
 
-	case 2:
-		{
-			p.SetState(132)
-			p.Variable()
-		}
+	return localctx
+}
 
-	case 3:
-		{
-			p.SetState(133)
-			p.Match(groolParserLR_BRACKET)
+// IExpressionContext is an interface to support dynamic dispatch.
+type IExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExpressionContext differentiates from other interfaces.
+	IsExpressionContext()
+}
+
+type ExpressionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExpressionContext() *ExpressionContext {
+	var p = new(ExpressionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = groolParserRULE_expression
+	return p
+}
+
+func (*ExpressionContext) IsExpressionContext() {}
+
+func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
+	var p = new(ExpressionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = groolParserRULE_expression
+
+	return p
+}
+
+func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExpressionContext) LR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(groolParserLR_BRACKET, 0)
+}
+
+func (s *ExpressionContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
 		}
-		{
-			p.SetState(134)
+	}
 
-			var _x = p.expressionAtom(0)
+	return tst
+}
 
-			localctx.(*ExpressionAtomContext).left = _x
+func (s *ExpressionContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ExpressionContext) LogicalOperator() ILogicalOperatorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILogicalOperatorContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILogicalOperatorContext)
+}
+
+func (s *ExpressionContext) RR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(groolParserRR_BRACKET, 0)
+}
+
+func (s *ExpressionContext) Predicate() IPredicateContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPredicateContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPredicateContext)
+}
+
+func (s *ExpressionContext) QUESTION() antlr.TerminalNode {
+	return s.GetToken(groolParserQUESTION, 0)
+}
+
+func (s *ExpressionContext) COLON() antlr.TerminalNode {
+	return s.GetToken(groolParserCOLON, 0)
+}
+
+func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.EnterExpression(s)
+	}
+}
+
+func (s *ExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.ExitExpression(s)
+	}
+}
+
+func (p *groolParser) Expression() (localctx IExpressionContext) {
+	return p.expression(0)
+}
+
+func (p *groolParser) expression(_p int) (localctx IExpressionContext) {
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+	_parentState := p.GetState()
+	localctx = NewExpressionContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IExpressionContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 26
+	p.EnterRecursionRule(localctx, 26, groolParserRULE_expression, _p)
+
+	defer func() {
+		p.UnrollRecursionContexts(_parentctx)
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
 		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(167)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 7, p.GetParserRuleContext()) {
+	case 1:
 		{
-			p.SetState(135)
-			p.MathOperator()
+			p.SetState(160)
+			p.Match(groolParserLR_BRACKET)
 		}
 		{
-			p.SetState(136)
-
-			var _x = p.expressionAtom(0)
-
-			localctx.(*ExpressionAtomContext).right = _x
+			p.SetState(161)
+			p.expression(0)
 		}
 		{
-			p.SetState(137)
-			p.Match(groolParserRR_BRACKET)
+			p.SetState(162)
+			p.LogicalOperator()
 		}
-
-	case 4:
 		{
-			p.SetState(139)
-			p.FunctionCall()
+			p.SetState(163)
+			p.expression(0)
+		}
+		{
+			p.SetState(164)
+			p.Match(groolParserRR_BRACKET)
 		}
 
-	case 5:
+	case 2:
 		{
-			p.SetState(140)
-			p.MethodCall()
+			p.SetState(166)
+			p.Predicate()
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(149)
+	p.SetState(181)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext())
 
@@ -2066,28 +2292,55 @@ func (p *groolParser) expressionAtom(_p int) (localctx IExpressionAtomContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			localctx = NewExpressionAtomContext(p, _parentctx, _parentState)
-			localctx.(*ExpressionAtomContext).left = _prevctx
-			p.PushNewRecursionContext(localctx, _startState, groolParserRULE_expressionAtom)
-			p.SetState(143)
-
-			if !(p.Precpred(p.GetParserRuleContext(), 4)) {
-				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
-			}
-			{
-				p.SetState(144)
-				p.MathOperator()
-			}
-			{
-				p.SetState(145)
-
-				var _x = p.expressionAtom(5)
+			p.SetState(179)
+			p.GetErrorHandler().Sync(p)
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 8, p.GetParserRuleContext()) {
+			case 1:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, groolParserRULE_expression)
+				p.SetState(169)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
+				}
+				{
+					p.SetState(170)
+					p.LogicalOperator()
+				}
+				{
+					p.SetState(171)
+					p.expression(5)
+				}
+
+			case 2:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, groolParserRULE_expression)
+				p.SetState(173)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
+				}
+				{
+					p.SetState(174)
+					p.Match(groolParserQUESTION)
+				}
+				{
+					p.SetState(175)
+					p.expression(0)
+				}
+				{
+					p.SetState(176)
+					p.Match(groolParserCOLON)
+				}
+				{
+					p.SetState(177)
+					p.expression(3)
+				}
 
-				localctx.(*ExpressionAtomContext).right = _x
 			}
 
 		}
-		p.SetState(151)
+		p.SetState(183)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext())
 	}
@@ -2095,202 +2348,2074 @@ func (p *groolParser) expressionAtom(_p int) (localctx IExpressionAtomContext) {
 	return localctx
 }
 
-// IMethodCallContext is an interface to support dynamic dispatch.
-type IMethodCallContext interface {
+// IPredicateContext is an interface to support dynamic dispatch.
+type IPredicateContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsMethodCallContext differentiates from other interfaces.
-	IsMethodCallContext()
+	// IsPredicateContext differentiates from other interfaces.
+	IsPredicateContext()
 }
 
-type MethodCallContext struct {
+type PredicateContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMethodCallContext() *MethodCallContext {
-	var p = new(MethodCallContext)
+func NewEmptyPredicateContext() *PredicateContext {
+	var p = new(PredicateContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = groolParserRULE_methodCall
+	p.RuleIndex = groolParserRULE_predicate
 	return p
 }
 
-func (*MethodCallContext) IsMethodCallContext() {}
+func (*PredicateContext) IsPredicateContext() {}
 
-func NewMethodCallContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MethodCallContext {
-	var p = new(MethodCallContext)
+func NewPredicateContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PredicateContext {
+	var p = new(PredicateContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = groolParserRULE_methodCall
+	p.RuleIndex = groolParserRULE_predicate
 
 	return p
 }
 
-func (s *MethodCallContext) GetParser() antlr.Parser { return s.parser }
+func (s *PredicateContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MethodCallContext) DOTTEDNAME() antlr.TerminalNode {
-	return s.GetToken(groolParserDOTTEDNAME, 0)
+func (s *PredicateContext) AllExpressionAtom() []IExpressionAtomContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionAtomContext)(nil)).Elem())
+	var tst = make([]IExpressionAtomContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionAtomContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *MethodCallContext) FunctionArgs() IFunctionArgsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionArgsContext)(nil)).Elem(), 0)
+func (s *PredicateContext) ExpressionAtom(i int) IExpressionAtomContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionAtomContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IFunctionArgsContext)
+	return t.(IExpressionAtomContext)
 }
 
-func (s *MethodCallContext) GetRuleContext() antlr.RuleContext {
+func (s *PredicateContext) ComparisonOperator() IComparisonOperatorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IComparisonOperatorContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IComparisonOperatorContext)
+}
+
+func (s *PredicateContext) IN() antlr.TerminalNode {
+	return s.GetToken(groolParserIN, 0)
+}
+
+func (s *PredicateContext) LR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(groolParserLR_BRACKET, 0)
+}
+
+func (s *PredicateContext) RR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(groolParserRR_BRACKET, 0)
+}
+
+func (s *PredicateContext) NOT() antlr.TerminalNode {
+	return s.GetToken(groolParserNOT, 0)
+}
+
+func (s *PredicateContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MethodCallContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PredicateContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MethodCallContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PredicateContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.EnterMethodCall(s)
+		listenerT.EnterPredicate(s)
 	}
 }
 
-func (s *MethodCallContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PredicateContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.ExitMethodCall(s)
+		listenerT.ExitPredicate(s)
+	}
+}
+
+func (p *groolParser) Predicate() (localctx IPredicateContext) {
+	localctx = NewPredicateContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, groolParserRULE_predicate)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(205)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 12, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(184)
+			p.expressionAtom(0)
+		}
+		{
+			p.SetState(185)
+			p.ComparisonOperator()
+		}
+		{
+			p.SetState(186)
+			p.expressionAtom(0)
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(188)
+			p.expressionAtom(0)
+		}
+		p.SetState(190)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == groolParserNOT {
+			{
+				p.SetState(189)
+				p.Match(groolParserNOT)
+			}
+
+		}
+		{
+			p.SetState(192)
+			p.Match(groolParserIN)
+		}
+		{
+			p.SetState(193)
+			p.Match(groolParserLR_BRACKET)
+		}
+		{
+			p.SetState(194)
+			p.expressionAtom(0)
+		}
+		p.SetState(199)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == groolParserT__0 {
+			{
+				p.SetState(195)
+				p.Match(groolParserT__0)
+			}
+			{
+				p.SetState(196)
+				p.expressionAtom(0)
+			}
+
+			p.SetState(201)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(202)
+			p.Match(groolParserRR_BRACKET)
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(204)
+			p.expressionAtom(0)
+		}
+
+	}
+
+	return localctx
+}
+
+// IExpressionAtomContext is an interface to support dynamic dispatch.
+type IExpressionAtomContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetLeft returns the left rule contexts.
+	GetLeft() IExpressionAtomContext
+
+	// GetRight returns the right rule contexts.
+	GetRight() IExpressionAtomContext
+
+	// SetLeft sets the left rule contexts.
+	SetLeft(IExpressionAtomContext)
+
+	// SetRight sets the right rule contexts.
+	SetRight(IExpressionAtomContext)
+
+	// IsExpressionAtomContext differentiates from other interfaces.
+	IsExpressionAtomContext()
+}
+
+type ExpressionAtomContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+	left   IExpressionAtomContext
+	right  IExpressionAtomContext
+}
+
+func NewEmptyExpressionAtomContext() *ExpressionAtomContext {
+	var p = new(ExpressionAtomContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = groolParserRULE_expressionAtom
+	return p
+}
+
+func (*ExpressionAtomContext) IsExpressionAtomContext() {}
+
+func NewExpressionAtomContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionAtomContext {
+	var p = new(ExpressionAtomContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = groolParserRULE_expressionAtom
+
+	return p
+}
+
+func (s *ExpressionAtomContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExpressionAtomContext) GetLeft() IExpressionAtomContext { return s.left }
+
+func (s *ExpressionAtomContext) GetRight() IExpressionAtomContext { return s.right }
+
+func (s *ExpressionAtomContext) SetLeft(v IExpressionAtomContext) { s.left = v }
+
+func (s *ExpressionAtomContext) SetRight(v IExpressionAtomContext) { s.right = v }
+
+func (s *ExpressionAtomContext) Constant() IConstantContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConstantContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConstantContext)
+}
+
+func (s *ExpressionAtomContext) Variable() IVariableContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVariableContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IVariableContext)
+}
+
+func (s *ExpressionAtomContext) LR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(groolParserLR_BRACKET, 0)
+}
+
+func (s *ExpressionAtomContext) MathOperator() IMathOperatorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMathOperatorContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMathOperatorContext)
+}
+
+func (s *ExpressionAtomContext) RR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(groolParserRR_BRACKET, 0)
+}
+
+func (s *ExpressionAtomContext) AllExpressionAtom() []IExpressionAtomContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionAtomContext)(nil)).Elem())
+	var tst = make([]IExpressionAtomContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionAtomContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ExpressionAtomContext) ExpressionAtom(i int) IExpressionAtomContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionAtomContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionAtomContext)
+}
+
+func (s *ExpressionAtomContext) FunctionCall() IFunctionCallContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionCallContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionCallContext)
+}
+
+func (s *ExpressionAtomContext) MethodCall() IMethodCallContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMethodCallContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMethodCallContext)
+}
+
+func (s *ExpressionAtomContext) NULL_COALESCE() antlr.TerminalNode {
+	return s.GetToken(groolParserNULL_COALESCE, 0)
+}
+
+func (s *ExpressionAtomContext) Selector() ISelectorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISelectorContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectorContext)
+}
+
+func (s *ExpressionAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExpressionAtomContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExpressionAtomContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.EnterExpressionAtom(s)
+	}
+}
+
+func (s *ExpressionAtomContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.ExitExpressionAtom(s)
+	}
+}
+
+func (p *groolParser) ExpressionAtom() (localctx IExpressionAtomContext) {
+	return p.expressionAtom(0)
+}
+
+func (p *groolParser) expressionAtom(_p int) (localctx IExpressionAtomContext) {
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+	_parentState := p.GetState()
+	localctx = NewExpressionAtomContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IExpressionAtomContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 30
+	p.EnterRecursionRule(localctx, 30, groolParserRULE_expressionAtom, _p)
+
+	defer func() {
+		p.UnrollRecursionContexts(_parentctx)
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(218)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 13, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(208)
+			p.Constant()
+		}
+
+	case 2:
+		{
+			p.SetState(209)
+			p.Variable()
+		}
+
+	case 3:
+		{
+			p.SetState(210)
+			p.Match(groolParserLR_BRACKET)
+		}
+		{
+			p.SetState(211)
+
+			var _x = p.expressionAtom(0)
+
+			localctx.(*ExpressionAtomContext).left = _x
+		}
+		{
+			p.SetState(212)
+			p.MathOperator()
+		}
+		{
+			p.SetState(213)
+
+			var _x = p.expressionAtom(0)
+
+			localctx.(*ExpressionAtomContext).right = _x
+		}
+		{
+			p.SetState(214)
+			p.Match(groolParserRR_BRACKET)
+		}
+
+	case 4:
+		{
+			p.SetState(216)
+			p.FunctionCall()
+		}
+
+	case 5:
+		{
+			p.SetState(217)
+			p.MethodCall()
+		}
+
+	}
+	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
+	p.SetState(231)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 15, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			if p.GetParseListeners() != nil {
+				p.TriggerExitRuleEvent()
+			}
+			_prevctx = localctx
+			p.SetState(229)
+			p.GetErrorHandler().Sync(p)
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 14, p.GetParserRuleContext()) {
+			case 1:
+				localctx = NewExpressionAtomContext(p, _parentctx, _parentState)
+				localctx.(*ExpressionAtomContext).left = _prevctx
+				p.PushNewRecursionContext(localctx, _startState, groolParserRULE_expressionAtom)
+				p.SetState(220)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
+				}
+				{
+					p.SetState(221)
+					p.MathOperator()
+				}
+				{
+					p.SetState(222)
+
+					var _x = p.expressionAtom(6)
+
+					localctx.(*ExpressionAtomContext).right = _x
+				}
+
+			case 2:
+				localctx = NewExpressionAtomContext(p, _parentctx, _parentState)
+				localctx.(*ExpressionAtomContext).left = _prevctx
+				p.PushNewRecursionContext(localctx, _startState, groolParserRULE_expressionAtom)
+				p.SetState(224)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
+				}
+				{
+					p.SetState(225)
+					p.Match(groolParserNULL_COALESCE)
+				}
+				{
+					p.SetState(226)
+
+					var _x = p.expressionAtom(5)
+
+					localctx.(*ExpressionAtomContext).right = _x
+				}
+
+			case 3:
+				localctx = NewExpressionAtomContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, groolParserRULE_expressionAtom)
+				p.SetState(227)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
+				}
+				{
+					p.SetState(228)
+					p.Selector()
+				}
+
+			}
+
+		}
+		p.SetState(233)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 15, p.GetParserRuleContext())
+	}
+
+	return localctx
+}
+
+// IMethodCallContext is an interface to support dynamic dispatch.
+type IMethodCallContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsMethodCallContext differentiates from other interfaces.
+	IsMethodCallContext()
+}
+
+type MethodCallContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMethodCallContext() *MethodCallContext {
+	var p = new(MethodCallContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = groolParserRULE_methodCall
+	return p
+}
+
+func (*MethodCallContext) IsMethodCallContext() {}
+
+func NewMethodCallContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MethodCallContext {
+	var p = new(MethodCallContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = groolParserRULE_methodCall
+
+	return p
+}
+
+func (s *MethodCallContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MethodCallContext) DOTTEDNAME() antlr.TerminalNode {
+	return s.GetToken(groolParserDOTTEDNAME, 0)
+}
+
+func (s *MethodCallContext) FunctionArgs() IFunctionArgsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionArgsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionArgsContext)
+}
+
+func (s *MethodCallContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MethodCallContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MethodCallContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.EnterMethodCall(s)
+	}
+}
+
+func (s *MethodCallContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.ExitMethodCall(s)
+	}
+}
+
+func (p *groolParser) MethodCall() (localctx IMethodCallContext) {
+	localctx = NewMethodCallContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 32, groolParserRULE_methodCall)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(234)
+		p.Match(groolParserDOTTEDNAME)
+	}
+	{
+		p.SetState(235)
+		p.Match(groolParserLR_BRACKET)
+	}
+	p.SetState(237)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<groolParserTRUE)|(1<<groolParserFALSE)|(1<<groolParserNULL_LITERAL)|(1<<groolParserNOT)|(1<<groolParserSIMPLENAME)|(1<<groolParserDOTTEDNAME)|(1<<groolParserMINUS))) != 0) || (((_la-40)&-(0x1f+1)) == 0 && ((1<<uint((_la-40)))&((1<<(groolParserLR_BRACE-40))|(1<<(groolParserLR_BRACKET-40))|(1<<(groolParserLS_BRACKET-40))|(1<<(groolParserDQUOTA_STRING-40))|(1<<(groolParserSQUOTA_STRING-40))|(1<<(groolParserTIME_LITERAL-40))|(1<<(groolParserDURATION_LITERAL-40))|(1<<(groolParserDECIMAL_LITERAL-40))|(1<<(groolParserREAL_LITERAL-40)))) != 0) {
+		{
+			p.SetState(236)
+			p.FunctionArgs()
+		}
+
+	}
+	{
+		p.SetState(239)
+		p.Match(groolParserRR_BRACKET)
+	}
+
+	return localctx
+}
+
+// ISelectorContext is an interface to support dynamic dispatch.
+type ISelectorContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSelectorContext differentiates from other interfaces.
+	IsSelectorContext()
+}
+
+type SelectorContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySelectorContext() *SelectorContext {
+	var p = new(SelectorContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = groolParserRULE_selector
+	return p
+}
+
+func (*SelectorContext) IsSelectorContext() {}
+
+func NewSelectorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectorContext {
+	var p = new(SelectorContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = groolParserRULE_selector
+
+	return p
+}
+
+func (s *SelectorContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SelectorContext) DOT() antlr.TerminalNode {
+	return s.GetToken(groolParserDOT, 0)
+}
+
+func (s *SelectorContext) SIMPLENAME() antlr.TerminalNode {
+	return s.GetToken(groolParserSIMPLENAME, 0)
+}
+
+func (s *SelectorContext) DOTTEDNAME() antlr.TerminalNode {
+	return s.GetToken(groolParserDOTTEDNAME, 0)
+}
+
+func (s *SelectorContext) QUESTION() antlr.TerminalNode {
+	return s.GetToken(groolParserQUESTION, 0)
+}
+
+func (s *SelectorContext) LR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(groolParserLR_BRACKET, 0)
+}
+
+func (s *SelectorContext) RR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(groolParserRR_BRACKET, 0)
+}
+
+func (s *SelectorContext) FunctionArgs() IFunctionArgsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionArgsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionArgsContext)
+}
+
+func (s *SelectorContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SelectorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SelectorContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.EnterSelector(s)
+	}
+}
+
+func (s *SelectorContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.ExitSelector(s)
+	}
+}
+
+func (p *groolParser) Selector() (localctx ISelectorContext) {
+	localctx = NewSelectorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, groolParserRULE_selector)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(242)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == groolParserQUESTION {
+		{
+			p.SetState(241)
+			p.Match(groolParserQUESTION)
+		}
+
+	}
+	{
+		p.SetState(244)
+		p.Match(groolParserDOT)
+	}
+	p.SetState(245)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(_la == groolParserSIMPLENAME || _la == groolParserDOTTEDNAME) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
+	}
+	p.SetState(251)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(246)
+			p.Match(groolParserLR_BRACKET)
+		}
+		p.SetState(248)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<groolParserTRUE)|(1<<groolParserFALSE)|(1<<groolParserNULL_LITERAL)|(1<<groolParserNOT)|(1<<groolParserSIMPLENAME)|(1<<groolParserDOTTEDNAME)|(1<<groolParserMINUS))) != 0) || (((_la-40)&-(0x1f+1)) == 0 && ((1<<uint((_la-40)))&((1<<(groolParserLR_BRACE-40))|(1<<(groolParserLR_BRACKET-40))|(1<<(groolParserLS_BRACKET-40))|(1<<(groolParserDQUOTA_STRING-40))|(1<<(groolParserSQUOTA_STRING-40))|(1<<(groolParserTIME_LITERAL-40))|(1<<(groolParserDURATION_LITERAL-40))|(1<<(groolParserDECIMAL_LITERAL-40))|(1<<(groolParserREAL_LITERAL-40)))) != 0) {
+			{
+				p.SetState(247)
+				p.FunctionArgs()
+			}
+
+		}
+		{
+			p.SetState(250)
+			p.Match(groolParserRR_BRACKET)
+		}
+
+	}
+
+	return localctx
+}
+
+// IFunctionCallContext is an interface to support dynamic dispatch.
+type IFunctionCallContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsFunctionCallContext differentiates from other interfaces.
+	IsFunctionCallContext()
+}
+
+type FunctionCallContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFunctionCallContext() *FunctionCallContext {
+	var p = new(FunctionCallContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = groolParserRULE_functionCall
+	return p
+}
+
+func (*FunctionCallContext) IsFunctionCallContext() {}
+
+func NewFunctionCallContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionCallContext {
+	var p = new(FunctionCallContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = groolParserRULE_functionCall
+
+	return p
+}
+
+func (s *FunctionCallContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FunctionCallContext) SIMPLENAME() antlr.TerminalNode {
+	return s.GetToken(groolParserSIMPLENAME, 0)
+}
+
+func (s *FunctionCallContext) FunctionArgs() IFunctionArgsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionArgsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionArgsContext)
+}
+
+func (s *FunctionCallContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FunctionCallContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FunctionCallContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.EnterFunctionCall(s)
+	}
+}
+
+func (s *FunctionCallContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.ExitFunctionCall(s)
+	}
+}
+
+func (p *groolParser) FunctionCall() (localctx IFunctionCallContext) {
+	localctx = NewFunctionCallContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 36, groolParserRULE_functionCall)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(253)
+		p.Match(groolParserSIMPLENAME)
+	}
+	{
+		p.SetState(254)
+		p.Match(groolParserLR_BRACKET)
+	}
+	p.SetState(256)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<groolParserTRUE)|(1<<groolParserFALSE)|(1<<groolParserNULL_LITERAL)|(1<<groolParserNOT)|(1<<groolParserSIMPLENAME)|(1<<groolParserDOTTEDNAME)|(1<<groolParserMINUS))) != 0) || (((_la-40)&-(0x1f+1)) == 0 && ((1<<uint((_la-40)))&((1<<(groolParserLR_BRACE-40))|(1<<(groolParserLR_BRACKET-40))|(1<<(groolParserLS_BRACKET-40))|(1<<(groolParserDQUOTA_STRING-40))|(1<<(groolParserSQUOTA_STRING-40))|(1<<(groolParserTIME_LITERAL-40))|(1<<(groolParserDURATION_LITERAL-40))|(1<<(groolParserDECIMAL_LITERAL-40))|(1<<(groolParserREAL_LITERAL-40)))) != 0) {
+		{
+			p.SetState(255)
+			p.FunctionArgs()
+		}
+
+	}
+	{
+		p.SetState(258)
+		p.Match(groolParserRR_BRACKET)
+	}
+
+	return localctx
+}
+
+// IFunctionArgsContext is an interface to support dynamic dispatch.
+type IFunctionArgsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsFunctionArgsContext differentiates from other interfaces.
+	IsFunctionArgsContext()
+}
+
+type FunctionArgsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFunctionArgsContext() *FunctionArgsContext {
+	var p = new(FunctionArgsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = groolParserRULE_functionArgs
+	return p
+}
+
+func (*FunctionArgsContext) IsFunctionArgsContext() {}
+
+func NewFunctionArgsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionArgsContext {
+	var p = new(FunctionArgsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = groolParserRULE_functionArgs
+
+	return p
+}
+
+func (s *FunctionArgsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FunctionArgsContext) AllConstant() []IConstantContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IConstantContext)(nil)).Elem())
+	var tst = make([]IConstantContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IConstantContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *FunctionArgsContext) Constant(i int) IConstantContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConstantContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConstantContext)
+}
+
+func (s *FunctionArgsContext) AllVariable() []IVariableContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IVariableContext)(nil)).Elem())
+	var tst = make([]IVariableContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IVariableContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *FunctionArgsContext) Variable(i int) IVariableContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVariableContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IVariableContext)
+}
+
+func (s *FunctionArgsContext) AllFunctionCall() []IFunctionCallContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IFunctionCallContext)(nil)).Elem())
+	var tst = make([]IFunctionCallContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IFunctionCallContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *FunctionArgsContext) FunctionCall(i int) IFunctionCallContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionCallContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionCallContext)
+}
+
+func (s *FunctionArgsContext) AllMethodCall() []IMethodCallContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IMethodCallContext)(nil)).Elem())
+	var tst = make([]IMethodCallContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IMethodCallContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *FunctionArgsContext) MethodCall(i int) IMethodCallContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMethodCallContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMethodCallContext)
+}
+
+func (s *FunctionArgsContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *FunctionArgsContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *FunctionArgsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FunctionArgsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FunctionArgsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.EnterFunctionArgs(s)
+	}
+}
+
+func (s *FunctionArgsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.ExitFunctionArgs(s)
+	}
+}
+
+func (p *groolParser) FunctionArgs() (localctx IFunctionArgsContext) {
+	localctx = NewFunctionArgsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, groolParserRULE_functionArgs)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(265)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 21, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(260)
+			p.Constant()
+		}
+
+	case 2:
+		{
+			p.SetState(261)
+			p.Variable()
+		}
+
+	case 3:
+		{
+			p.SetState(262)
+			p.FunctionCall()
+		}
+
+	case 4:
+		{
+			p.SetState(263)
+			p.MethodCall()
+		}
+
+	case 5:
+		{
+			p.SetState(264)
+			p.expression(0)
+		}
+
+	}
+	p.SetState(277)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == groolParserT__0 {
+		{
+			p.SetState(267)
+			p.Match(groolParserT__0)
+		}
+		p.SetState(273)
+		p.GetErrorHandler().Sync(p)
+		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 22, p.GetParserRuleContext()) {
+		case 1:
+			{
+				p.SetState(268)
+				p.Constant()
+			}
+
+		case 2:
+			{
+				p.SetState(269)
+				p.Variable()
+			}
+
+		case 3:
+			{
+				p.SetState(270)
+				p.FunctionCall()
+			}
+
+		case 4:
+			{
+				p.SetState(271)
+				p.MethodCall()
+			}
+
+		case 5:
+			{
+				p.SetState(272)
+				p.expression(0)
+			}
+
+		}
+
+		p.SetState(279)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// ILogicalOperatorContext is an interface to support dynamic dispatch.
+type ILogicalOperatorContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsLogicalOperatorContext differentiates from other interfaces.
+	IsLogicalOperatorContext()
+}
+
+type LogicalOperatorContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyLogicalOperatorContext() *LogicalOperatorContext {
+	var p = new(LogicalOperatorContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = groolParserRULE_logicalOperator
+	return p
+}
+
+func (*LogicalOperatorContext) IsLogicalOperatorContext() {}
+
+func NewLogicalOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LogicalOperatorContext {
+	var p = new(LogicalOperatorContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = groolParserRULE_logicalOperator
+
+	return p
+}
+
+func (s *LogicalOperatorContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *LogicalOperatorContext) AND() antlr.TerminalNode {
+	return s.GetToken(groolParserAND, 0)
+}
+
+func (s *LogicalOperatorContext) OR() antlr.TerminalNode {
+	return s.GetToken(groolParserOR, 0)
+}
+
+func (s *LogicalOperatorContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LogicalOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *LogicalOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.EnterLogicalOperator(s)
+	}
+}
+
+func (s *LogicalOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.ExitLogicalOperator(s)
+	}
+}
+
+func (p *groolParser) LogicalOperator() (localctx ILogicalOperatorContext) {
+	localctx = NewLogicalOperatorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 40, groolParserRULE_logicalOperator)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(280)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(_la == groolParserAND || _la == groolParserOR) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
+	}
+
+	return localctx
+}
+
+// IVariableContext is an interface to support dynamic dispatch.
+type IVariableContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsVariableContext differentiates from other interfaces.
+	IsVariableContext()
+}
+
+type VariableContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyVariableContext() *VariableContext {
+	var p = new(VariableContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = groolParserRULE_variable
+	return p
+}
+
+func (*VariableContext) IsVariableContext() {}
+
+func NewVariableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VariableContext {
+	var p = new(VariableContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = groolParserRULE_variable
+
+	return p
+}
+
+func (s *VariableContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *VariableContext) SIMPLENAME() antlr.TerminalNode {
+	return s.GetToken(groolParserSIMPLENAME, 0)
+}
+
+func (s *VariableContext) DOTTEDNAME() antlr.TerminalNode {
+	return s.GetToken(groolParserDOTTEDNAME, 0)
+}
+
+func (s *VariableContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *VariableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *VariableContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.EnterVariable(s)
+	}
+}
+
+func (s *VariableContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.ExitVariable(s)
+	}
+}
+
+func (p *groolParser) Variable() (localctx IVariableContext) {
+	localctx = NewVariableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, groolParserRULE_variable)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(282)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(_la == groolParserSIMPLENAME || _la == groolParserDOTTEDNAME) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
+	}
+
+	return localctx
+}
+
+// IMathOperatorContext is an interface to support dynamic dispatch.
+type IMathOperatorContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsMathOperatorContext differentiates from other interfaces.
+	IsMathOperatorContext()
+}
+
+type MathOperatorContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMathOperatorContext() *MathOperatorContext {
+	var p = new(MathOperatorContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = groolParserRULE_mathOperator
+	return p
+}
+
+func (*MathOperatorContext) IsMathOperatorContext() {}
+
+func NewMathOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MathOperatorContext {
+	var p = new(MathOperatorContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = groolParserRULE_mathOperator
+
+	return p
+}
+
+func (s *MathOperatorContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MathOperatorContext) MUL() antlr.TerminalNode {
+	return s.GetToken(groolParserMUL, 0)
+}
+
+func (s *MathOperatorContext) DIV() antlr.TerminalNode {
+	return s.GetToken(groolParserDIV, 0)
+}
+
+func (s *MathOperatorContext) PLUS() antlr.TerminalNode {
+	return s.GetToken(groolParserPLUS, 0)
+}
+
+func (s *MathOperatorContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(groolParserMINUS, 0)
+}
+
+func (s *MathOperatorContext) BITAND() antlr.TerminalNode {
+	return s.GetToken(groolParserBITAND, 0)
+}
+
+func (s *MathOperatorContext) BITOR() antlr.TerminalNode {
+	return s.GetToken(groolParserBITOR, 0)
+}
+
+func (s *MathOperatorContext) BITXOR() antlr.TerminalNode {
+	return s.GetToken(groolParserBITXOR, 0)
+}
+
+func (s *MathOperatorContext) SHL() antlr.TerminalNode {
+	return s.GetToken(groolParserSHL, 0)
+}
+
+func (s *MathOperatorContext) SHR() antlr.TerminalNode {
+	return s.GetToken(groolParserSHR, 0)
+}
+
+func (s *MathOperatorContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MathOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MathOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.EnterMathOperator(s)
+	}
+}
+
+func (s *MathOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.ExitMathOperator(s)
+	}
+}
+
+func (p *groolParser) MathOperator() (localctx IMathOperatorContext) {
+	localctx = NewMathOperatorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, groolParserRULE_mathOperator)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(284)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<groolParserPLUS)|(1<<groolParserMINUS)|(1<<groolParserDIV)|(1<<groolParserMUL)|(1<<groolParserBITAND)|(1<<groolParserBITOR)|(1<<groolParserBITXOR)|(1<<groolParserSHL)|(1<<groolParserSHR))) != 0) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
+	}
+
+	return localctx
+}
+
+// IComparisonOperatorContext is an interface to support dynamic dispatch.
+type IComparisonOperatorContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsComparisonOperatorContext differentiates from other interfaces.
+	IsComparisonOperatorContext()
+}
+
+type ComparisonOperatorContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyComparisonOperatorContext() *ComparisonOperatorContext {
+	var p = new(ComparisonOperatorContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = groolParserRULE_comparisonOperator
+	return p
+}
+
+func (*ComparisonOperatorContext) IsComparisonOperatorContext() {}
+
+func NewComparisonOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ComparisonOperatorContext {
+	var p = new(ComparisonOperatorContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = groolParserRULE_comparisonOperator
+
+	return p
+}
+
+func (s *ComparisonOperatorContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ComparisonOperatorContext) GT() antlr.TerminalNode {
+	return s.GetToken(groolParserGT, 0)
+}
+
+func (s *ComparisonOperatorContext) LT() antlr.TerminalNode {
+	return s.GetToken(groolParserLT, 0)
+}
+
+func (s *ComparisonOperatorContext) GTE() antlr.TerminalNode {
+	return s.GetToken(groolParserGTE, 0)
+}
+
+func (s *ComparisonOperatorContext) LTE() antlr.TerminalNode {
+	return s.GetToken(groolParserLTE, 0)
+}
+
+func (s *ComparisonOperatorContext) EQUALS() antlr.TerminalNode {
+	return s.GetToken(groolParserEQUALS, 0)
+}
+
+func (s *ComparisonOperatorContext) NOTEQUALS() antlr.TerminalNode {
+	return s.GetToken(groolParserNOTEQUALS, 0)
+}
+
+func (s *ComparisonOperatorContext) CONTAINS() antlr.TerminalNode {
+	return s.GetToken(groolParserCONTAINS, 0)
+}
+
+func (s *ComparisonOperatorContext) MATCHES() antlr.TerminalNode {
+	return s.GetToken(groolParserMATCHES, 0)
+}
+
+func (s *ComparisonOperatorContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ComparisonOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ComparisonOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.EnterComparisonOperator(s)
+	}
+}
+
+func (s *ComparisonOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.ExitComparisonOperator(s)
+	}
+}
+
+func (p *groolParser) ComparisonOperator() (localctx IComparisonOperatorContext) {
+	localctx = NewComparisonOperatorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, groolParserRULE_comparisonOperator)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(286)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(((_la-16)&-(0x1f+1)) == 0 && ((1<<uint((_la-16)))&((1<<(groolParserCONTAINS-16))|(1<<(groolParserMATCHES-16))|(1<<(groolParserEQUALS-16))|(1<<(groolParserGT-16))|(1<<(groolParserLT-16))|(1<<(groolParserGTE-16))|(1<<(groolParserLTE-16))|(1<<(groolParserNOTEQUALS-16)))) != 0) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
+	}
+
+	return localctx
+}
+
+// IConstantContext is an interface to support dynamic dispatch.
+type IConstantContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsConstantContext differentiates from other interfaces.
+	IsConstantContext()
+}
+
+type ConstantContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyConstantContext() *ConstantContext {
+	var p = new(ConstantContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = groolParserRULE_constant
+	return p
+}
+
+func (*ConstantContext) IsConstantContext() {}
+
+func NewConstantContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstantContext {
+	var p = new(ConstantContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = groolParserRULE_constant
+
+	return p
+}
+
+func (s *ConstantContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ConstantContext) StringLiteral() IStringLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStringLiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStringLiteralContext)
+}
+
+func (s *ConstantContext) DecimalLiteral() IDecimalLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDecimalLiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDecimalLiteralContext)
+}
+
+func (s *ConstantContext) BooleanLiteral() IBooleanLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBooleanLiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBooleanLiteralContext)
+}
+
+func (s *ConstantContext) RealLiteral() IRealLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IRealLiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRealLiteralContext)
+}
+
+func (s *ConstantContext) NULL_LITERAL() antlr.TerminalNode {
+	return s.GetToken(groolParserNULL_LITERAL, 0)
+}
+
+func (s *ConstantContext) NOT() antlr.TerminalNode {
+	return s.GetToken(groolParserNOT, 0)
+}
+
+func (s *ConstantContext) TimeLiteral() ITimeLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITimeLiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITimeLiteralContext)
+}
+
+func (s *ConstantContext) DurationLiteral() IDurationLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDurationLiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDurationLiteralContext)
+}
+
+func (s *ConstantContext) ListLiteral() IListLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IListLiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IListLiteralContext)
+}
+
+func (s *ConstantContext) MapLiteral() IMapLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMapLiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMapLiteralContext)
+}
+
+func (s *ConstantContext) StructLiteral() IStructLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStructLiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStructLiteralContext)
+}
+
+func (s *ConstantContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ConstantContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ConstantContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.EnterConstant(s)
+	}
+}
+
+func (s *ConstantContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(groolListener); ok {
+		listenerT.ExitConstant(s)
 	}
 }
 
-func (p *groolParser) MethodCall() (localctx IMethodCallContext) {
-	localctx = NewMethodCallContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, groolParserRULE_methodCall)
-	var _la int
+func (p *groolParser) Constant() (localctx IConstantContext) {
+	localctx = NewConstantContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, groolParserRULE_constant)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(303)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 25, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(288)
+			p.StringLiteral()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(289)
+			p.DecimalLiteral()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(290)
+			p.Match(groolParserMINUS)
+		}
+		{
+			p.SetState(291)
+			p.DecimalLiteral()
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(292)
+			p.BooleanLiteral()
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(293)
+			p.RealLiteral()
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		p.SetState(295)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == groolParserNOT {
+			{
+				p.SetState(294)
+				p.Match(groolParserNOT)
+			}
+
+		}
+		{
+			p.SetState(297)
+			p.Match(groolParserNULL_LITERAL)
+		}
+
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(298)
+			p.TimeLiteral()
+		}
 
-	defer func() {
-		p.ExitRule()
-	}()
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(299)
+			p.DurationLiteral()
+		}
 
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
+	case 9:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(300)
+			p.ListLiteral()
 		}
-	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(152)
-		p.Match(groolParserDOTTEDNAME)
-	}
-	{
-		p.SetState(153)
-		p.Match(groolParserLR_BRACKET)
-	}
-	p.SetState(155)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+	case 10:
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(301)
+			p.MapLiteral()
+		}
 
-	if ((_la-7)&-(0x1f+1)) == 0 && ((1<<uint((_la-7)))&((1<<(groolParserTRUE-7))|(1<<(groolParserFALSE-7))|(1<<(groolParserNULL_LITERAL-7))|(1<<(groolParserNOT-7))|(1<<(groolParserSIMPLENAME-7))|(1<<(groolParserDOTTEDNAME-7))|(1<<(groolParserMINUS-7))|(1<<(groolParserLR_BRACKET-7))|(1<<(groolParserDQUOTA_STRING-7))|(1<<(groolParserSQUOTA_STRING-7))|(1<<(groolParserDECIMAL_LITERAL-7))|(1<<(groolParserREAL_LITERAL-7)))) != 0 {
+	case 11:
+		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(154)
-			p.FunctionArgs()
+			p.SetState(302)
+			p.StructLiteral()
 		}
 
 	}
-	{
-		p.SetState(157)
-		p.Match(groolParserRR_BRACKET)
-	}
 
 	return localctx
 }
 
-// IFunctionCallContext is an interface to support dynamic dispatch.
-type IFunctionCallContext interface {
+// IListLiteralContext is an interface to support dynamic dispatch.
+type IListLiteralContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsFunctionCallContext differentiates from other interfaces.
-	IsFunctionCallContext()
+	// IsListLiteralContext differentiates from other interfaces.
+	IsListLiteralContext()
 }
 
-type FunctionCallContext struct {
+type ListLiteralContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFunctionCallContext() *FunctionCallContext {
-	var p = new(FunctionCallContext)
+func NewEmptyListLiteralContext() *ListLiteralContext {
+	var p = new(ListLiteralContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = groolParserRULE_functionCall
+	p.RuleIndex = groolParserRULE_listLiteral
 	return p
 }
 
-func (*FunctionCallContext) IsFunctionCallContext() {}
+func (*ListLiteralContext) IsListLiteralContext() {}
 
-func NewFunctionCallContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionCallContext {
-	var p = new(FunctionCallContext)
+func NewListLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ListLiteralContext {
+	var p = new(ListLiteralContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = groolParserRULE_functionCall
+	p.RuleIndex = groolParserRULE_listLiteral
 
 	return p
 }
 
-func (s *FunctionCallContext) GetParser() antlr.Parser { return s.parser }
+func (s *ListLiteralContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FunctionCallContext) SIMPLENAME() antlr.TerminalNode {
-	return s.GetToken(groolParserSIMPLENAME, 0)
+func (s *ListLiteralContext) LS_BRACKET() antlr.TerminalNode {
+	return s.GetToken(groolParserLS_BRACKET, 0)
 }
 
-func (s *FunctionCallContext) FunctionArgs() IFunctionArgsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionArgsContext)(nil)).Elem(), 0)
+func (s *ListLiteralContext) RS_BRACKET() antlr.TerminalNode {
+	return s.GetToken(groolParserRS_BRACKET, 0)
+}
+
+func (s *ListLiteralContext) AllConstant() []IConstantContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IConstantContext)(nil)).Elem())
+	var tst = make([]IConstantContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IConstantContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ListLiteralContext) Constant(i int) IConstantContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConstantContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IFunctionArgsContext)
+	return t.(IConstantContext)
 }
 
-func (s *FunctionCallContext) GetRuleContext() antlr.RuleContext {
+func (s *ListLiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FunctionCallContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ListLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FunctionCallContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ListLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.EnterFunctionCall(s)
+		listenerT.EnterListLiteral(s)
 	}
 }
 
-func (s *FunctionCallContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ListLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.ExitFunctionCall(s)
+		listenerT.ExitListLiteral(s)
 	}
 }
 
-func (p *groolParser) FunctionCall() (localctx IFunctionCallContext) {
-	localctx = NewFunctionCallContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, groolParserRULE_functionCall)
+func (p *groolParser) ListLiteral() (localctx IListLiteralContext) {
+	localctx = NewListLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, groolParserRULE_listLiteral)
 	var _la int
 
 	defer func() {
@@ -2311,383 +4436,286 @@ func (p *groolParser) FunctionCall() (localctx IFunctionCallContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(159)
-		p.Match(groolParserSIMPLENAME)
-	}
-	{
-		p.SetState(160)
-		p.Match(groolParserLR_BRACKET)
+		p.SetState(305)
+		p.Match(groolParserLS_BRACKET)
 	}
-	p.SetState(162)
+	p.SetState(314)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if ((_la-7)&-(0x1f+1)) == 0 && ((1<<uint((_la-7)))&((1<<(groolParserTRUE-7))|(1<<(groolParserFALSE-7))|(1<<(groolParserNULL_LITERAL-7))|(1<<(groolParserNOT-7))|(1<<(groolParserSIMPLENAME-7))|(1<<(groolParserDOTTEDNAME-7))|(1<<(groolParserMINUS-7))|(1<<(groolParserLR_BRACKET-7))|(1<<(groolParserDQUOTA_STRING-7))|(1<<(groolParserSQUOTA_STRING-7))|(1<<(groolParserDECIMAL_LITERAL-7))|(1<<(groolParserREAL_LITERAL-7)))) != 0 {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<groolParserTRUE)|(1<<groolParserFALSE)|(1<<groolParserNULL_LITERAL)|(1<<groolParserNOT)|(1<<groolParserMINUS))) != 0) || (((_la-40)&-(0x1f+1)) == 0 && ((1<<uint((_la-40)))&((1<<(groolParserLR_BRACE-40))|(1<<(groolParserLS_BRACKET-40))|(1<<(groolParserDQUOTA_STRING-40))|(1<<(groolParserSQUOTA_STRING-40))|(1<<(groolParserTIME_LITERAL-40))|(1<<(groolParserDURATION_LITERAL-40))|(1<<(groolParserDECIMAL_LITERAL-40))|(1<<(groolParserREAL_LITERAL-40)))) != 0) {
 		{
-			p.SetState(161)
-			p.FunctionArgs()
+			p.SetState(306)
+			p.Constant()
+		}
+		p.SetState(311)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == groolParserT__0 {
+			{
+				p.SetState(307)
+				p.Match(groolParserT__0)
+			}
+			{
+				p.SetState(308)
+				p.Constant()
+			}
+
+			p.SetState(313)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
 		}
 
 	}
 	{
-		p.SetState(164)
-		p.Match(groolParserRR_BRACKET)
+		p.SetState(316)
+		p.Match(groolParserRS_BRACKET)
 	}
 
 	return localctx
 }
 
-// IFunctionArgsContext is an interface to support dynamic dispatch.
-type IFunctionArgsContext interface {
+// IMapLiteralContext is an interface to support dynamic dispatch.
+type IMapLiteralContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsFunctionArgsContext differentiates from other interfaces.
-	IsFunctionArgsContext()
+	// IsMapLiteralContext differentiates from other interfaces.
+	IsMapLiteralContext()
 }
 
-type FunctionArgsContext struct {
+type MapLiteralContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFunctionArgsContext() *FunctionArgsContext {
-	var p = new(FunctionArgsContext)
+func NewEmptyMapLiteralContext() *MapLiteralContext {
+	var p = new(MapLiteralContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = groolParserRULE_functionArgs
+	p.RuleIndex = groolParserRULE_mapLiteral
 	return p
 }
 
-func (*FunctionArgsContext) IsFunctionArgsContext() {}
+func (*MapLiteralContext) IsMapLiteralContext() {}
 
-func NewFunctionArgsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionArgsContext {
-	var p = new(FunctionArgsContext)
+func NewMapLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MapLiteralContext {
+	var p = new(MapLiteralContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = groolParserRULE_functionArgs
+	p.RuleIndex = groolParserRULE_mapLiteral
 
 	return p
 }
 
-func (s *FunctionArgsContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *FunctionArgsContext) AllConstant() []IConstantContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IConstantContext)(nil)).Elem())
-	var tst = make([]IConstantContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IConstantContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *FunctionArgsContext) Constant(i int) IConstantContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConstantContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IConstantContext)
-}
-
-func (s *FunctionArgsContext) AllVariable() []IVariableContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IVariableContext)(nil)).Elem())
-	var tst = make([]IVariableContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IVariableContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *FunctionArgsContext) Variable(i int) IVariableContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVariableContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IVariableContext)
-}
-
-func (s *FunctionArgsContext) AllFunctionCall() []IFunctionCallContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IFunctionCallContext)(nil)).Elem())
-	var tst = make([]IFunctionCallContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IFunctionCallContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *FunctionArgsContext) FunctionCall(i int) IFunctionCallContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionCallContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFunctionCallContext)
-}
-
-func (s *FunctionArgsContext) AllMethodCall() []IMethodCallContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IMethodCallContext)(nil)).Elem())
-	var tst = make([]IMethodCallContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IMethodCallContext)
-		}
-	}
+func (s *MapLiteralContext) GetParser() antlr.Parser { return s.parser }
 
-	return tst
+func (s *MapLiteralContext) LR_BRACE() antlr.TerminalNode {
+	return s.GetToken(groolParserLR_BRACE, 0)
 }
 
-func (s *FunctionArgsContext) MethodCall(i int) IMethodCallContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMethodCallContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IMethodCallContext)
+func (s *MapLiteralContext) RR_BRACE() antlr.TerminalNode {
+	return s.GetToken(groolParserRR_BRACE, 0)
 }
 
-func (s *FunctionArgsContext) AllExpression() []IExpressionContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
-	var tst = make([]IExpressionContext, len(ts))
+func (s *MapLiteralContext) AllMapEntry() []IMapEntryContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IMapEntryContext)(nil)).Elem())
+	var tst = make([]IMapEntryContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(IExpressionContext)
+			tst[i] = t.(IMapEntryContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *FunctionArgsContext) Expression(i int) IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+func (s *MapLiteralContext) MapEntry(i int) IMapEntryContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMapEntryContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IMapEntryContext)
 }
 
-func (s *FunctionArgsContext) GetRuleContext() antlr.RuleContext {
+func (s *MapLiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FunctionArgsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MapLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FunctionArgsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MapLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.EnterFunctionArgs(s)
+		listenerT.EnterMapLiteral(s)
 	}
 }
 
-func (s *FunctionArgsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MapLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.ExitFunctionArgs(s)
+		listenerT.ExitMapLiteral(s)
 	}
 }
 
-func (p *groolParser) FunctionArgs() (localctx IFunctionArgsContext) {
-	localctx = NewFunctionArgsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, groolParserRULE_functionArgs)
+func (p *groolParser) MapLiteral() (localctx IMapLiteralContext) {
+	localctx = NewMapLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, groolParserRULE_mapLiteral)
 	var _la int
 
 	defer func() {
-		p.ExitRule()
-	}()
-
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
-		}
-	}()
-
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(171)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 12, p.GetParserRuleContext()) {
-	case 1:
-		{
-			p.SetState(166)
-			p.Constant()
-		}
-
-	case 2:
-		{
-			p.SetState(167)
-			p.Variable()
-		}
-
-	case 3:
-		{
-			p.SetState(168)
-			p.FunctionCall()
-		}
-
-	case 4:
-		{
-			p.SetState(169)
-			p.MethodCall()
-		}
+		p.ExitRule()
+	}()
 
-	case 5:
-		{
-			p.SetState(170)
-			p.expression(0)
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
 		}
+	}()
 
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(318)
+		p.Match(groolParserLR_BRACE)
 	}
-	p.SetState(183)
+	p.SetState(327)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == groolParserT__0 {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<groolParserTRUE)|(1<<groolParserFALSE)|(1<<groolParserNULL_LITERAL)|(1<<groolParserNOT)|(1<<groolParserMINUS))) != 0) || (((_la-40)&-(0x1f+1)) == 0 && ((1<<uint((_la-40)))&((1<<(groolParserLR_BRACE-40))|(1<<(groolParserLS_BRACKET-40))|(1<<(groolParserDQUOTA_STRING-40))|(1<<(groolParserSQUOTA_STRING-40))|(1<<(groolParserTIME_LITERAL-40))|(1<<(groolParserDURATION_LITERAL-40))|(1<<(groolParserDECIMAL_LITERAL-40))|(1<<(groolParserREAL_LITERAL-40)))) != 0) {
 		{
-			p.SetState(173)
-			p.Match(groolParserT__0)
+			p.SetState(319)
+			p.MapEntry()
 		}
-		p.SetState(179)
+		p.SetState(324)
 		p.GetErrorHandler().Sync(p)
-		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 13, p.GetParserRuleContext()) {
-		case 1:
-			{
-				p.SetState(174)
-				p.Constant()
-			}
-
-		case 2:
-			{
-				p.SetState(175)
-				p.Variable()
-			}
-
-		case 3:
-			{
-				p.SetState(176)
-				p.FunctionCall()
-			}
+		_la = p.GetTokenStream().LA(1)
 
-		case 4:
+		for _la == groolParserT__0 {
 			{
-				p.SetState(177)
-				p.MethodCall()
+				p.SetState(320)
+				p.Match(groolParserT__0)
 			}
-
-		case 5:
 			{
-				p.SetState(178)
-				p.expression(0)
+				p.SetState(321)
+				p.MapEntry()
 			}
 
+			p.SetState(326)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
 		}
 
-		p.SetState(185)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(329)
+		p.Match(groolParserRR_BRACE)
 	}
 
 	return localctx
 }
 
-// ILogicalOperatorContext is an interface to support dynamic dispatch.
-type ILogicalOperatorContext interface {
+// IMapEntryContext is an interface to support dynamic dispatch.
+type IMapEntryContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsLogicalOperatorContext differentiates from other interfaces.
-	IsLogicalOperatorContext()
+	// IsMapEntryContext differentiates from other interfaces.
+	IsMapEntryContext()
 }
 
-type LogicalOperatorContext struct {
+type MapEntryContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyLogicalOperatorContext() *LogicalOperatorContext {
-	var p = new(LogicalOperatorContext)
+func NewEmptyMapEntryContext() *MapEntryContext {
+	var p = new(MapEntryContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = groolParserRULE_logicalOperator
+	p.RuleIndex = groolParserRULE_mapEntry
 	return p
 }
 
-func (*LogicalOperatorContext) IsLogicalOperatorContext() {}
+func (*MapEntryContext) IsMapEntryContext() {}
 
-func NewLogicalOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LogicalOperatorContext {
-	var p = new(LogicalOperatorContext)
+func NewMapEntryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MapEntryContext {
+	var p = new(MapEntryContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = groolParserRULE_logicalOperator
+	p.RuleIndex = groolParserRULE_mapEntry
 
 	return p
 }
 
-func (s *LogicalOperatorContext) GetParser() antlr.Parser { return s.parser }
+func (s *MapEntryContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *LogicalOperatorContext) AND() antlr.TerminalNode {
-	return s.GetToken(groolParserAND, 0)
+func (s *MapEntryContext) AllConstant() []IConstantContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IConstantContext)(nil)).Elem())
+	var tst = make([]IConstantContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IConstantContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *LogicalOperatorContext) OR() antlr.TerminalNode {
-	return s.GetToken(groolParserOR, 0)
+func (s *MapEntryContext) Constant(i int) IConstantContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConstantContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConstantContext)
 }
 
-func (s *LogicalOperatorContext) GetRuleContext() antlr.RuleContext {
+func (s *MapEntryContext) COLON() antlr.TerminalNode {
+	return s.GetToken(groolParserCOLON, 0)
+}
+
+func (s *MapEntryContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *LogicalOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MapEntryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *LogicalOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MapEntryContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.EnterLogicalOperator(s)
+		listenerT.EnterMapEntry(s)
 	}
 }
 
-func (s *LogicalOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MapEntryContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.ExitLogicalOperator(s)
+		listenerT.ExitMapEntry(s)
 	}
 }
 
-func (p *groolParser) LogicalOperator() (localctx ILogicalOperatorContext) {
-	localctx = NewLogicalOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, groolParserRULE_logicalOperator)
-	var _la int
+func (p *groolParser) MapEntry() (localctx IMapEntryContext) {
+	localctx = NewMapEntryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, groolParserRULE_mapEntry)
 
 	defer func() {
 		p.ExitRule()
@@ -2706,88 +4734,114 @@ func (p *groolParser) LogicalOperator() (localctx ILogicalOperatorContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(186)
-	_la = p.GetTokenStream().LA(1)
-
-	if !(_la == groolParserAND || _la == groolParserOR) {
-		p.GetErrorHandler().RecoverInline(p)
-	} else {
-		p.GetErrorHandler().ReportMatch(p)
-		p.Consume()
+	{
+		p.SetState(331)
+		p.Constant()
+	}
+	{
+		p.SetState(332)
+		p.Match(groolParserCOLON)
+	}
+	{
+		p.SetState(333)
+		p.Constant()
 	}
 
 	return localctx
 }
 
-// IVariableContext is an interface to support dynamic dispatch.
-type IVariableContext interface {
+// IStructLiteralContext is an interface to support dynamic dispatch.
+type IStructLiteralContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsVariableContext differentiates from other interfaces.
-	IsVariableContext()
+	// IsStructLiteralContext differentiates from other interfaces.
+	IsStructLiteralContext()
 }
 
-type VariableContext struct {
+type StructLiteralContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyVariableContext() *VariableContext {
-	var p = new(VariableContext)
+func NewEmptyStructLiteralContext() *StructLiteralContext {
+	var p = new(StructLiteralContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = groolParserRULE_variable
+	p.RuleIndex = groolParserRULE_structLiteral
 	return p
 }
 
-func (*VariableContext) IsVariableContext() {}
+func (*StructLiteralContext) IsStructLiteralContext() {}
 
-func NewVariableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VariableContext {
-	var p = new(VariableContext)
+func NewStructLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructLiteralContext {
+	var p = new(StructLiteralContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = groolParserRULE_variable
+	p.RuleIndex = groolParserRULE_structLiteral
 
 	return p
 }
 
-func (s *VariableContext) GetParser() antlr.Parser { return s.parser }
+func (s *StructLiteralContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *VariableContext) SIMPLENAME() antlr.TerminalNode {
-	return s.GetToken(groolParserSIMPLENAME, 0)
+func (s *StructLiteralContext) LR_BRACE() antlr.TerminalNode {
+	return s.GetToken(groolParserLR_BRACE, 0)
 }
 
-func (s *VariableContext) DOTTEDNAME() antlr.TerminalNode {
-	return s.GetToken(groolParserDOTTEDNAME, 0)
+func (s *StructLiteralContext) AllFieldEntry() []IFieldEntryContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IFieldEntryContext)(nil)).Elem())
+	var tst = make([]IFieldEntryContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IFieldEntryContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *VariableContext) GetRuleContext() antlr.RuleContext {
+func (s *StructLiteralContext) FieldEntry(i int) IFieldEntryContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFieldEntryContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldEntryContext)
+}
+
+func (s *StructLiteralContext) RR_BRACE() antlr.TerminalNode {
+	return s.GetToken(groolParserRR_BRACE, 0)
+}
+
+func (s *StructLiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *VariableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StructLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *VariableContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StructLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.EnterVariable(s)
+		listenerT.EnterStructLiteral(s)
 	}
 }
 
-func (s *VariableContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StructLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.ExitVariable(s)
+		listenerT.ExitStructLiteral(s)
 	}
 }
 
-func (p *groolParser) Variable() (localctx IVariableContext) {
-	localctx = NewVariableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, groolParserRULE_variable)
+func (p *groolParser) StructLiteral() (localctx IStructLiteralContext) {
+	localctx = NewStructLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, groolParserRULE_structLiteral)
 	var _la int
 
 	defer func() {
@@ -2807,97 +4861,119 @@ func (p *groolParser) Variable() (localctx IVariableContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(188)
+	{
+		p.SetState(335)
+		p.Match(groolParserLR_BRACE)
+	}
+	{
+		p.SetState(336)
+		p.FieldEntry()
+	}
+	p.SetState(341)
+	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if !(_la == groolParserSIMPLENAME || _la == groolParserDOTTEDNAME) {
-		p.GetErrorHandler().RecoverInline(p)
-	} else {
-		p.GetErrorHandler().ReportMatch(p)
-		p.Consume()
+	for _la == groolParserT__0 {
+		{
+			p.SetState(337)
+			p.Match(groolParserT__0)
+		}
+		{
+			p.SetState(338)
+			p.FieldEntry()
+		}
+
+		p.SetState(343)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(344)
+		p.Match(groolParserRR_BRACE)
 	}
 
 	return localctx
 }
 
-// IMathOperatorContext is an interface to support dynamic dispatch.
-type IMathOperatorContext interface {
+// IFieldEntryContext is an interface to support dynamic dispatch.
+type IFieldEntryContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsMathOperatorContext differentiates from other interfaces.
-	IsMathOperatorContext()
+	// IsFieldEntryContext differentiates from other interfaces.
+	IsFieldEntryContext()
 }
 
-type MathOperatorContext struct {
+type FieldEntryContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMathOperatorContext() *MathOperatorContext {
-	var p = new(MathOperatorContext)
+func NewEmptyFieldEntryContext() *FieldEntryContext {
+	var p = new(FieldEntryContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = groolParserRULE_mathOperator
+	p.RuleIndex = groolParserRULE_fieldEntry
 	return p
 }
 
-func (*MathOperatorContext) IsMathOperatorContext() {}
+func (*FieldEntryContext) IsFieldEntryContext() {}
 
-func NewMathOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MathOperatorContext {
-	var p = new(MathOperatorContext)
+func NewFieldEntryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldEntryContext {
+	var p = new(FieldEntryContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = groolParserRULE_mathOperator
+	p.RuleIndex = groolParserRULE_fieldEntry
 
 	return p
 }
 
-func (s *MathOperatorContext) GetParser() antlr.Parser { return s.parser }
+func (s *FieldEntryContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MathOperatorContext) MUL() antlr.TerminalNode {
-	return s.GetToken(groolParserMUL, 0)
+func (s *FieldEntryContext) SIMPLENAME() antlr.TerminalNode {
+	return s.GetToken(groolParserSIMPLENAME, 0)
 }
 
-func (s *MathOperatorContext) DIV() antlr.TerminalNode {
-	return s.GetToken(groolParserDIV, 0)
+func (s *FieldEntryContext) COLON() antlr.TerminalNode {
+	return s.GetToken(groolParserCOLON, 0)
 }
 
-func (s *MathOperatorContext) PLUS() antlr.TerminalNode {
-	return s.GetToken(groolParserPLUS, 0)
-}
+func (s *FieldEntryContext) Constant() IConstantContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConstantContext)(nil)).Elem(), 0)
 
-func (s *MathOperatorContext) MINUS() antlr.TerminalNode {
-	return s.GetToken(groolParserMINUS, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConstantContext)
 }
 
-func (s *MathOperatorContext) GetRuleContext() antlr.RuleContext {
+func (s *FieldEntryContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MathOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FieldEntryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MathOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FieldEntryContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.EnterMathOperator(s)
+		listenerT.EnterFieldEntry(s)
 	}
 }
 
-func (s *MathOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FieldEntryContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.ExitMathOperator(s)
+		listenerT.ExitFieldEntry(s)
 	}
 }
 
-func (p *groolParser) MathOperator() (localctx IMathOperatorContext) {
-	localctx = NewMathOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, groolParserRULE_mathOperator)
-	var _la int
+func (p *groolParser) FieldEntry() (localctx IFieldEntryContext) {
+	localctx = NewFieldEntryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, groolParserRULE_fieldEntry)
 
 	defer func() {
 		p.ExitRule()
@@ -2916,104 +4992,91 @@ func (p *groolParser) MathOperator() (localctx IMathOperatorContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(190)
-	_la = p.GetTokenStream().LA(1)
-
-	if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<groolParserPLUS)|(1<<groolParserMINUS)|(1<<groolParserDIV)|(1<<groolParserMUL))) != 0) {
-		p.GetErrorHandler().RecoverInline(p)
-	} else {
-		p.GetErrorHandler().ReportMatch(p)
-		p.Consume()
+	{
+		p.SetState(346)
+		p.Match(groolParserSIMPLENAME)
+	}
+	{
+		p.SetState(347)
+		p.Match(groolParserCOLON)
+	}
+	{
+		p.SetState(348)
+		p.Constant()
 	}
 
 	return localctx
 }
 
-// IComparisonOperatorContext is an interface to support dynamic dispatch.
-type IComparisonOperatorContext interface {
+// IDecimalLiteralContext is an interface to support dynamic dispatch.
+type IDecimalLiteralContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsComparisonOperatorContext differentiates from other interfaces.
-	IsComparisonOperatorContext()
+	// IsDecimalLiteralContext differentiates from other interfaces.
+	IsDecimalLiteralContext()
 }
 
-type ComparisonOperatorContext struct {
+type DecimalLiteralContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyComparisonOperatorContext() *ComparisonOperatorContext {
-	var p = new(ComparisonOperatorContext)
+func NewEmptyDecimalLiteralContext() *DecimalLiteralContext {
+	var p = new(DecimalLiteralContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = groolParserRULE_comparisonOperator
+	p.RuleIndex = groolParserRULE_decimalLiteral
 	return p
 }
 
-func (*ComparisonOperatorContext) IsComparisonOperatorContext() {}
+func (*DecimalLiteralContext) IsDecimalLiteralContext() {}
 
-func NewComparisonOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ComparisonOperatorContext {
-	var p = new(ComparisonOperatorContext)
+func NewDecimalLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DecimalLiteralContext {
+	var p = new(DecimalLiteralContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = groolParserRULE_comparisonOperator
+	p.RuleIndex = groolParserRULE_decimalLiteral
 
 	return p
 }
 
-func (s *ComparisonOperatorContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ComparisonOperatorContext) GT() antlr.TerminalNode {
-	return s.GetToken(groolParserGT, 0)
-}
-
-func (s *ComparisonOperatorContext) LT() antlr.TerminalNode {
-	return s.GetToken(groolParserLT, 0)
-}
-
-func (s *ComparisonOperatorContext) GTE() antlr.TerminalNode {
-	return s.GetToken(groolParserGTE, 0)
-}
-
-func (s *ComparisonOperatorContext) LTE() antlr.TerminalNode {
-	return s.GetToken(groolParserLTE, 0)
-}
+func (s *DecimalLiteralContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ComparisonOperatorContext) EQUALS() antlr.TerminalNode {
-	return s.GetToken(groolParserEQUALS, 0)
+func (s *DecimalLiteralContext) DECIMAL_LITERAL() antlr.TerminalNode {
+	return s.GetToken(groolParserDECIMAL_LITERAL, 0)
 }
 
-func (s *ComparisonOperatorContext) NOTEQUALS() antlr.TerminalNode {
-	return s.GetToken(groolParserNOTEQUALS, 0)
+func (s *DecimalLiteralContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(groolParserMINUS, 0)
 }
 
-func (s *ComparisonOperatorContext) GetRuleContext() antlr.RuleContext {
+func (s *DecimalLiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ComparisonOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DecimalLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ComparisonOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DecimalLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.EnterComparisonOperator(s)
+		listenerT.EnterDecimalLiteral(s)
 	}
 }
 
-func (s *ComparisonOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DecimalLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.ExitComparisonOperator(s)
+		listenerT.ExitDecimalLiteral(s)
 	}
 }
 
-func (p *groolParser) ComparisonOperator() (localctx IComparisonOperatorContext) {
-	localctx = NewComparisonOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, groolParserRULE_comparisonOperator)
+func (p *groolParser) DecimalLiteral() (localctx IDecimalLiteralContext) {
+	localctx = NewDecimalLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, groolParserRULE_decimalLiteral)
 	var _la int
 
 	defer func() {
@@ -3033,128 +5096,94 @@ func (p *groolParser) ComparisonOperator() (localctx IComparisonOperatorContext)
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(192)
+	p.SetState(351)
+	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<groolParserEQUALS)|(1<<groolParserGT)|(1<<groolParserLT)|(1<<groolParserGTE)|(1<<groolParserLTE)|(1<<groolParserNOTEQUALS))) != 0) {
-		p.GetErrorHandler().RecoverInline(p)
-	} else {
-		p.GetErrorHandler().ReportMatch(p)
-		p.Consume()
+	if _la == groolParserMINUS {
+		{
+			p.SetState(350)
+			p.Match(groolParserMINUS)
+		}
+
+	}
+	{
+		p.SetState(353)
+		p.Match(groolParserDECIMAL_LITERAL)
 	}
 
 	return localctx
 }
 
-// IConstantContext is an interface to support dynamic dispatch.
-type IConstantContext interface {
+// IRealLiteralContext is an interface to support dynamic dispatch.
+type IRealLiteralContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsConstantContext differentiates from other interfaces.
-	IsConstantContext()
+	// IsRealLiteralContext differentiates from other interfaces.
+	IsRealLiteralContext()
 }
 
-type ConstantContext struct {
+type RealLiteralContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyConstantContext() *ConstantContext {
-	var p = new(ConstantContext)
+func NewEmptyRealLiteralContext() *RealLiteralContext {
+	var p = new(RealLiteralContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = groolParserRULE_constant
+	p.RuleIndex = groolParserRULE_realLiteral
 	return p
 }
 
-func (*ConstantContext) IsConstantContext() {}
+func (*RealLiteralContext) IsRealLiteralContext() {}
 
-func NewConstantContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstantContext {
-	var p = new(ConstantContext)
+func NewRealLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RealLiteralContext {
+	var p = new(RealLiteralContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = groolParserRULE_constant
+	p.RuleIndex = groolParserRULE_realLiteral
 
 	return p
 }
 
-func (s *ConstantContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ConstantContext) StringLiteral() IStringLiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStringLiteralContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IStringLiteralContext)
-}
-
-func (s *ConstantContext) DecimalLiteral() IDecimalLiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDecimalLiteralContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IDecimalLiteralContext)
-}
-
-func (s *ConstantContext) BooleanLiteral() IBooleanLiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBooleanLiteralContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IBooleanLiteralContext)
-}
-
-func (s *ConstantContext) RealLiteral() IRealLiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IRealLiteralContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IRealLiteralContext)
-}
+func (s *RealLiteralContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ConstantContext) NULL_LITERAL() antlr.TerminalNode {
-	return s.GetToken(groolParserNULL_LITERAL, 0)
+func (s *RealLiteralContext) REAL_LITERAL() antlr.TerminalNode {
+	return s.GetToken(groolParserREAL_LITERAL, 0)
 }
 
-func (s *ConstantContext) NOT() antlr.TerminalNode {
-	return s.GetToken(groolParserNOT, 0)
+func (s *RealLiteralContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(groolParserMINUS, 0)
 }
 
-func (s *ConstantContext) GetRuleContext() antlr.RuleContext {
+func (s *RealLiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ConstantContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RealLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ConstantContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RealLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.EnterConstant(s)
+		listenerT.EnterRealLiteral(s)
 	}
 }
 
-func (s *ConstantContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RealLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.ExitConstant(s)
+		listenerT.ExitRealLiteral(s)
 	}
 }
 
-func (p *groolParser) Constant() (localctx IConstantContext) {
-	localctx = NewConstantContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, groolParserRULE_constant)
+func (p *groolParser) RealLiteral() (localctx IRealLiteralContext) {
+	localctx = NewRealLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, groolParserRULE_realLiteral)
 	var _la int
 
 	defer func() {
@@ -3173,141 +5202,91 @@ func (p *groolParser) Constant() (localctx IConstantContext) {
 		}
 	}()
 
-	p.SetState(204)
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(356)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 16, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(194)
-			p.StringLiteral()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(195)
-			p.DecimalLiteral()
-		}
+	_la = p.GetTokenStream().LA(1)
 
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
+	if _la == groolParserMINUS {
 		{
-			p.SetState(196)
+			p.SetState(355)
 			p.Match(groolParserMINUS)
 		}
-		{
-			p.SetState(197)
-			p.DecimalLiteral()
-		}
-
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(198)
-			p.BooleanLiteral()
-		}
-
-	case 5:
-		p.EnterOuterAlt(localctx, 5)
-		{
-			p.SetState(199)
-			p.RealLiteral()
-		}
-
-	case 6:
-		p.EnterOuterAlt(localctx, 6)
-		p.SetState(201)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == groolParserNOT {
-			{
-				p.SetState(200)
-				p.Match(groolParserNOT)
-			}
-
-		}
-		{
-			p.SetState(203)
-			p.Match(groolParserNULL_LITERAL)
-		}
 
 	}
+	{
+		p.SetState(358)
+		p.Match(groolParserREAL_LITERAL)
+	}
 
 	return localctx
 }
 
-// IDecimalLiteralContext is an interface to support dynamic dispatch.
-type IDecimalLiteralContext interface {
+// ITimeLiteralContext is an interface to support dynamic dispatch.
+type ITimeLiteralContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsDecimalLiteralContext differentiates from other interfaces.
-	IsDecimalLiteralContext()
+	// IsTimeLiteralContext differentiates from other interfaces.
+	IsTimeLiteralContext()
 }
 
-type DecimalLiteralContext struct {
+type TimeLiteralContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDecimalLiteralContext() *DecimalLiteralContext {
-	var p = new(DecimalLiteralContext)
+func NewEmptyTimeLiteralContext() *TimeLiteralContext {
+	var p = new(TimeLiteralContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = groolParserRULE_decimalLiteral
+	p.RuleIndex = groolParserRULE_timeLiteral
 	return p
 }
 
-func (*DecimalLiteralContext) IsDecimalLiteralContext() {}
+func (*TimeLiteralContext) IsTimeLiteralContext() {}
 
-func NewDecimalLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DecimalLiteralContext {
-	var p = new(DecimalLiteralContext)
+func NewTimeLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TimeLiteralContext {
+	var p = new(TimeLiteralContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = groolParserRULE_decimalLiteral
+	p.RuleIndex = groolParserRULE_timeLiteral
 
 	return p
 }
 
-func (s *DecimalLiteralContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *DecimalLiteralContext) DECIMAL_LITERAL() antlr.TerminalNode {
-	return s.GetToken(groolParserDECIMAL_LITERAL, 0)
-}
+func (s *TimeLiteralContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *DecimalLiteralContext) MINUS() antlr.TerminalNode {
-	return s.GetToken(groolParserMINUS, 0)
+func (s *TimeLiteralContext) TIME_LITERAL() antlr.TerminalNode {
+	return s.GetToken(groolParserTIME_LITERAL, 0)
 }
 
-func (s *DecimalLiteralContext) GetRuleContext() antlr.RuleContext {
+func (s *TimeLiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DecimalLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TimeLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DecimalLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TimeLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.EnterDecimalLiteral(s)
+		listenerT.EnterTimeLiteral(s)
 	}
 }
 
-func (s *DecimalLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TimeLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.ExitDecimalLiteral(s)
+		listenerT.ExitTimeLiteral(s)
 	}
 }
 
-func (p *groolParser) DecimalLiteral() (localctx IDecimalLiteralContext) {
-	localctx = NewDecimalLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, groolParserRULE_decimalLiteral)
-	var _la int
+func (p *groolParser) TimeLiteral() (localctx ITimeLiteralContext) {
+	localctx = NewTimeLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, groolParserRULE_timeLiteral)
 
 	defer func() {
 		p.ExitRule()
@@ -3326,94 +5305,83 @@ func (p *groolParser) DecimalLiteral() (localctx IDecimalLiteralContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(207)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == groolParserMINUS {
-		{
-			p.SetState(206)
-			p.Match(groolParserMINUS)
-		}
-
-	}
 	{
-		p.SetState(209)
-		p.Match(groolParserDECIMAL_LITERAL)
+		p.SetState(360)
+		p.Match(groolParserTIME_LITERAL)
 	}
 
 	return localctx
 }
 
-// IRealLiteralContext is an interface to support dynamic dispatch.
-type IRealLiteralContext interface {
+// IDurationLiteralContext is an interface to support dynamic dispatch.
+type IDurationLiteralContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsRealLiteralContext differentiates from other interfaces.
-	IsRealLiteralContext()
+	// IsDurationLiteralContext differentiates from other interfaces.
+	IsDurationLiteralContext()
 }
 
-type RealLiteralContext struct {
+type DurationLiteralContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyRealLiteralContext() *RealLiteralContext {
-	var p = new(RealLiteralContext)
+func NewEmptyDurationLiteralContext() *DurationLiteralContext {
+	var p = new(DurationLiteralContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = groolParserRULE_realLiteral
+	p.RuleIndex = groolParserRULE_durationLiteral
 	return p
 }
 
-func (*RealLiteralContext) IsRealLiteralContext() {}
+func (*DurationLiteralContext) IsDurationLiteralContext() {}
 
-func NewRealLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RealLiteralContext {
-	var p = new(RealLiteralContext)
+func NewDurationLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DurationLiteralContext {
+	var p = new(DurationLiteralContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = groolParserRULE_realLiteral
+	p.RuleIndex = groolParserRULE_durationLiteral
 
 	return p
 }
 
-func (s *RealLiteralContext) GetParser() antlr.Parser { return s.parser }
+func (s *DurationLiteralContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *RealLiteralContext) REAL_LITERAL() antlr.TerminalNode {
-	return s.GetToken(groolParserREAL_LITERAL, 0)
+func (s *DurationLiteralContext) DURATION_LITERAL() antlr.TerminalNode {
+	return s.GetToken(groolParserDURATION_LITERAL, 0)
 }
 
-func (s *RealLiteralContext) MINUS() antlr.TerminalNode {
+func (s *DurationLiteralContext) MINUS() antlr.TerminalNode {
 	return s.GetToken(groolParserMINUS, 0)
 }
 
-func (s *RealLiteralContext) GetRuleContext() antlr.RuleContext {
+func (s *DurationLiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RealLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DurationLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *RealLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DurationLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.EnterRealLiteral(s)
+		listenerT.EnterDurationLiteral(s)
 	}
 }
 
-func (s *RealLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DurationLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(groolListener); ok {
-		listenerT.ExitRealLiteral(s)
+		listenerT.ExitDurationLiteral(s)
 	}
 }
 
-func (p *groolParser) RealLiteral() (localctx IRealLiteralContext) {
-	localctx = NewRealLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, groolParserRULE_realLiteral)
+func (p *groolParser) DurationLiteral() (localctx IDurationLiteralContext) {
+	localctx = NewDurationLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, groolParserRULE_durationLiteral)
 	var _la int
 
 	defer func() {
@@ -3433,20 +5401,20 @@ func (p *groolParser) RealLiteral() (localctx IRealLiteralContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(212)
+	p.SetState(363)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == groolParserMINUS {
 		{
-			p.SetState(211)
+			p.SetState(362)
 			p.Match(groolParserMINUS)
 		}
 
 	}
 	{
-		p.SetState(214)
-		p.Match(groolParserREAL_LITERAL)
+		p.SetState(365)
+		p.Match(groolParserDURATION_LITERAL)
 	}
 
 	return localctx
@@ -3520,7 +5488,7 @@ func (s *StringLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *groolParser) StringLiteral() (localctx IStringLiteralContext) {
 	localctx = NewStringLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, groolParserRULE_stringLiteral)
+	p.EnterRule(localctx, 68, groolParserRULE_stringLiteral)
 	var _la int
 
 	defer func() {
@@ -3540,7 +5508,7 @@ func (p *groolParser) StringLiteral() (localctx IStringLiteralContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(216)
+	p.SetState(367)
 	_la = p.GetTokenStream().LA(1)
 
 	if !(_la == groolParserDQUOTA_STRING || _la == groolParserSQUOTA_STRING) {
@@ -3621,7 +5589,7 @@ func (s *BooleanLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *groolParser) BooleanLiteral() (localctx IBooleanLiteralContext) {
 	localctx = NewBooleanLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, groolParserRULE_booleanLiteral)
+	p.EnterRule(localctx, 70, groolParserRULE_booleanLiteral)
 	var _la int
 
 	defer func() {
@@ -3641,7 +5609,7 @@ func (p *groolParser) BooleanLiteral() (localctx IBooleanLiteralContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(218)
+	p.SetState(369)
 	_la = p.GetTokenStream().LA(1)
 
 	if !(_la == groolParserTRUE || _la == groolParserFALSE) {
@@ -3656,14 +5624,14 @@ func (p *groolParser) BooleanLiteral() (localctx IBooleanLiteralContext) {
 
 func (p *groolParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 10:
+	case 13:
 		var t *ExpressionContext = nil
 		if localctx != nil {
 			t = localctx.(*ExpressionContext)
 		}
 		return p.Expression_Sempred(t, predIndex)
 
-	case 12:
+	case 15:
 		var t *ExpressionAtomContext = nil
 		if localctx != nil {
 			t = localctx.(*ExpressionAtomContext)
@@ -3678,6 +5646,9 @@ func (p *groolParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex i
 func (p *groolParser) Expression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 0:
+		return p.Precpred(p.GetParserRuleContext(), 4)
+
+	case 1:
 		return p.Precpred(p.GetParserRuleContext(), 3)
 
 	default:
@@ -3687,9 +5658,15 @@ func (p *groolParser) Expression_Sempred(localctx antlr.RuleContext, predIndex i
 
 func (p *groolParser) ExpressionAtom_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
-	case 1:
+	case 2:
+		return p.Precpred(p.GetParserRuleContext(), 5)
+
+	case 3:
 		return p.Precpred(p.GetParserRuleContext(), 4)
 
+	case 4:
+		return p.Precpred(p.GetParserRuleContext(), 6)
+
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
 	}
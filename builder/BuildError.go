@@ -0,0 +1,106 @@
+package builder
+
+import (
+	"fmt"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"strings"
+)
+
+// BuildError is an error found while building rules from a resource.
+// Line and Column locates it in the resource, they are zero if the position is not known.
+type BuildError struct {
+	Resource       string
+	Line           int
+	Column         int
+	OffendingToken string
+	ExpectedTokens []string
+	Snippet        string
+	Message        string
+}
+
+// Error returns the error message along with the resource name, position and the source snippet.
+func (e *BuildError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%s : %s", e.Resource, e.Message)
+	}
+	return fmt.Sprintf("%s line %d column %d : %s\n%s", e.Resource, e.Line, e.Column, e.Message, e.Snippet)
+}
+
+// BuildErrors holds all errors found while building rules from a resource.
+type BuildErrors []*BuildError
+
+// Error returns all the error messages.
+func (errs BuildErrors) Error() string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d errors found.\n%s", len(errs), strings.Join(msgs, "\n"))
+}
+
+// errorCollector is an antlr error listener collecting every syntax error reported by the lexer and parser.
+type errorCollector struct {
+	*antlr.DefaultErrorListener
+	resource string
+	lines    []string
+	errors   BuildErrors
+}
+
+func newErrorCollector(resource, source string) *errorCollector {
+	return &errorCollector{
+		DefaultErrorListener: antlr.NewDefaultErrorListener(),
+		resource:             resource,
+		lines:                strings.Split(source, "\n"),
+		errors:               make(BuildErrors, 0),
+	}
+}
+
+// SyntaxError is called by the lexer and parser on every syntax error.
+func (c *errorCollector) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	buildError := &BuildError{
+		Resource: c.resource,
+		Line:     line,
+		Column:   column + 1,
+		Snippet:  c.snippet(line, column),
+		Message:  msg,
+	}
+	if token, ok := offendingSymbol.(antlr.Token); ok && token != nil {
+		if token.GetTokenType() == antlr.TokenEOF {
+			buildError.OffendingToken = "<EOF>"
+		} else {
+			buildError.OffendingToken = token.GetText()
+		}
+	}
+	if psr, ok := recognizer.(antlr.Parser); ok {
+		expected := psr.GetExpectedTokens().StringVerbose(psr.GetLiteralNames(), psr.GetSymbolicNames(), false)
+		expected = strings.TrimSuffix(strings.TrimPrefix(expected, "{"), "}")
+		if len(expected) > 0 {
+			buildError.ExpectedTokens = strings.Split(expected, ", ")
+		}
+	}
+	c.errors = append(c.errors, buildError)
+}
+
+// snippet returns the source line with a marker under the column.
+func (c *errorCollector) snippet(line, column int) string {
+	if line < 1 || line > len(c.lines) {
+		return ""
+	}
+	source := strings.TrimRight(c.lines[line-1], "\r")
+	marker := make([]rune, 0, column+1)
+	for i, r := range []rune(source) {
+		if i >= column {
+			break
+		}
+		// keep the tabs, so the marker lines up with the source
+		if r == '\t' {
+			marker = append(marker, '\t')
+		} else {
+			marker = append(marker, ' ')
+		}
+	}
+	for len(marker) < column {
+		marker = append(marker, ' ')
+	}
+	return source + "\n" + string(marker) + "^"
+}
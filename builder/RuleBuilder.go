@@ -20,6 +20,8 @@ func NewRuleBuilder(KnowledgeBase *model.KnowledgeBase) *RuleBuilder {
 // RuleBuilder builds rule from DRL script into contained KnowledgeBase
 type RuleBuilder struct {
 	KnowledgeBase *model.KnowledgeBase
+	// Optimize folds the constant parts of the rules once they are built, see KnowledgeBase Optimize.
+	Optimize bool
 }
 
 // MustBuildRuleFromResources is similar to BuildRuleFromResources, with the difference is, it will panic if rule script contains error.
@@ -58,20 +60,80 @@ func (builder *RuleBuilder) BuildRuleFromResource(resource pkg.Resource) error {
 	}
 	sdata := string(data)
 
+	collector := newErrorCollector(resource.String(), sdata)
+
 	is := antlr.NewInputStream(sdata)
 	lexer := parser.NewgroolLexer(is)
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(collector)
 	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
 
+	existing := make(map[string]*model.RuleEntry, len(builder.KnowledgeBase.RuleEntries))
+	for name, entry := range builder.KnowledgeBase.RuleEntries {
+		existing[name] = entry
+	}
+
 	listener := antlr2.NewGroolParserListener(builder.KnowledgeBase)
 
 	psr := parser.NewgroolParser(stream)
+	psr.RemoveErrorListeners()
+	psr.AddErrorListener(collector)
 	psr.BuildParseTrees = true
-	antlr.ParseTreeWalkerDefault.Walk(listener, psr.Root())
+	root := psr.Root()
+
+	// do not walk a malformed rule, the listener expects a complete parse tree.
+	if len(collector.errors) > 0 {
+		log.Errorf("Loading rule resource : %s failed. Got %d syntax errors. 1st error : %v", resource.String(), len(collector.errors), collector.errors[0])
+		return collector.errors
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(listener, root)
 
 	if len(listener.ParseErrors) > 0 {
+		builder.restore(existing)
 		log.Errorf("Loading rule resource : %s failed. Got %d errors. 1st error : %v", resource.String(), len(listener.ParseErrors), listener.ParseErrors[0])
-		return errors.Errorf("error were found before builder bailing out. %d errors. 1st error : %v", len(listener.ParseErrors), listener.ParseErrors[0])
+		buildErrors := make(BuildErrors, len(listener.ParseErrors))
+		for i, err := range listener.ParseErrors {
+			buildErrors[i] = &BuildError{
+				Resource: resource.String(),
+				Message:  err.Error(),
+			}
+		}
+		return buildErrors
+	}
+
+	entries := make([]*model.RuleEntry, 0)
+	for name, entry := range builder.KnowledgeBase.RuleEntries {
+		if existing[name] != entry {
+			entries = append(entries, entry)
+		}
+	}
+	// type check the rules of this resource if the facts were declared.
+	if len(builder.KnowledgeBase.Facts) > 0 {
+		typeErrors := builder.KnowledgeBase.CheckTypes(entries...)
+		if len(typeErrors) > 0 {
+			builder.restore(existing)
+			log.Errorf("Loading rule resource : %s failed. Got %d type errors. 1st error : %v", resource.String(), len(typeErrors), typeErrors[0])
+			return typeErrors
+		}
+	}
+	if builder.Optimize {
+		folded := builder.KnowledgeBase.Optimize(entries...)
+		log.Debugf("Optimizing rule resource : %s. Got %d simplifications", resource.String(), folded)
 	}
 	log.Debugf("Loading rule resource : %s success", resource.String())
 	return nil
 }
+
+// restore puts back the rule entries the knowledge base held before a failed build,
+// so none of the rules of the failed resource is executed.
+func (builder *RuleBuilder) restore(existing map[string]*model.RuleEntry) {
+	for name := range builder.KnowledgeBase.RuleEntries {
+		if _, ok := existing[name]; !ok {
+			delete(builder.KnowledgeBase.RuleEntries, name)
+		}
+	}
+	for name, entry := range existing {
+		builder.KnowledgeBase.RuleEntries[name] = entry
+	}
+}
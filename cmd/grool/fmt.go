@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"github.com/newm4n/grool/printer"
+	"io"
+	"io/ioutil"
+	"os"
+)
+
+// fmtCommand rewrites the GRL files in the canonical GRL style.
+// With -l it only lists the files whose formatting differs, and exits with 1 if there are any.
+func fmtCommand(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("fmt", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	list := flags.Bool("l", false, "list the files whose formatting differs, without rewriting them")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() == 0 {
+		fmt.Fprintln(stderr, "grool fmt: no GRL file to format")
+		return 2
+	}
+	code := 0
+	for _, file := range flags.Args() {
+		source, err := ioutil.ReadFile(file)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			code = 1
+			continue
+		}
+		formatted, err := printer.Format(source)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			code = 1
+			continue
+		}
+		if bytes.Equal(source, formatted) {
+			continue
+		}
+		if *list {
+			fmt.Fprintln(stdout, file)
+			code = 1
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			code = 1
+			continue
+		}
+		if err := ioutil.WriteFile(file, formatted, info.Mode()); err != nil {
+			fmt.Fprintln(stderr, err)
+			code = 1
+		}
+	}
+	return code
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/juju/errors"
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/gen"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// factTypeFlags collects the repeated --fact Name=Type flags.
+type factTypeFlags map[string]string
+
+func (f factTypeFlags) String() string {
+	pairs := make([]string, 0, len(f))
+	for name, typ := range f {
+		pairs = append(pairs, name+"="+typ)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (f factTypeFlags) Set(value string) error {
+	idx := strings.Index(value, "=")
+	if idx <= 0 || idx == len(value)-1 {
+		return errors.Errorf("fact %s is not in the Name=Type form", value)
+	}
+	f[value[:idx]] = value[idx+1:]
+	return nil
+}
+
+// genCommand generates the Go code executing the GRL files against the fact types of a Go package.
+// The code is written into the output file, or printed if there is none.
+func genCommand(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("gen", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	facts := make(factTypeFlags)
+	flags.Var(facts, "fact", "a fact in the Name=Type form, where Type is a struct type of the package, may be repeated")
+	dir := flags.String("dir", ".", "the directory of the Go package the code is generated into")
+	output := flags.String("o", "", "the file to write the generated code into")
+
+	// the flags may come before or after the GRL files.
+	files := make([]string, 0)
+	for {
+		if err := flags.Parse(args); err != nil {
+			return 2
+		}
+		if flags.NArg() == 0 {
+			break
+		}
+		files = append(files, flags.Arg(0))
+		args = flags.Args()[1:]
+	}
+	if len(files) == 0 {
+		fmt.Fprintln(stderr, "grool gen: no GRL file to generate")
+		return 2
+	}
+
+	knowledgeBase := model.NewKnowledgeBase()
+	ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+	for _, file := range files {
+		if err := ruleBuilder.BuildRuleFromResource(pkg.NewFileResource(file)); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+	}
+	factPackage, err := gen.LoadPackage(*dir)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	code, err := gen.Generate(knowledgeBase, &gen.Config{
+		Package: factPackage,
+		Facts:   facts,
+	})
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	if *output == "" {
+		stdout.Write(code)
+		return 0
+	}
+	if err := ioutil.WriteFile(*output, code, 0644); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	return 0
+}
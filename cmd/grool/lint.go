@@ -0,0 +1,41 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/lint"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"io"
+)
+
+// lintCommand loads all the GRL files into a single knowledge base and prints the lint warnings.
+// It exits with 1 if a file can not be built or there are warnings.
+func lintCommand(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("lint", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() == 0 {
+		fmt.Fprintln(stderr, "grool lint: no GRL file to lint")
+		return 2
+	}
+	knowledgeBase := model.NewKnowledgeBase()
+	ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+	for _, file := range flags.Args() {
+		if err := ruleBuilder.BuildRuleFromResource(pkg.NewFileResource(file)); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+	}
+	warnings := lint.Lint(knowledgeBase)
+	for _, warning := range warnings {
+		fmt.Fprintln(stdout, warning)
+	}
+	if len(warnings) > 0 {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,73 @@
+// Command grool is the command line tool to work with GRL files.
+//
+// Usage:
+//
+//	grool <command> [arguments]
+package main
+
+import (
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+)
+
+// command is a sub command of the grool tool, it returns the process exit code.
+type command struct {
+	usage string
+	run   func(args []string, stdout, stderr io.Writer) int
+}
+
+var commands = map[string]*command{
+	"fmt": {
+		usage: "fmt [-l] [file.grl ...]\trewrite the rules in the canonical GRL style",
+		run:   fmtCommand,
+	},
+	"run": {
+		usage: "run [--fact Name=file.json ...] [file.grl ...]\texecute the rules against facts read from JSON files",
+		run:   runCommand,
+	},
+	"lint": {
+		usage: "lint [file.grl ...]\tcheck the rules for possible mistakes",
+		run:   lintCommand,
+	},
+	"gen": {
+		usage: "gen [-dir pkgdir] [--fact Name=Type ...] [-o file.go] [file.grl ...]\tgenerate Go code executing the rules against the fact types of a package",
+		run:   genCommand,
+	},
+}
+
+func main() {
+	// the commands report the errors themselves.
+	log.SetOutput(ioutil.Discard)
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "grool: unknown command %s\n", args[0])
+		usage(stderr)
+		return 2
+	}
+	return cmd.run(args[1:], stdout, stderr)
+}
+
+func usage(stderr io.Writer) {
+	fmt.Fprintln(stderr, "Usage: grool <command> [arguments]")
+	fmt.Fprintln(stderr, "Commands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(stderr, "\t%s\n", commands[name].usage)
+	}
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRun_UnknownCommand(t *testing.T) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	if code := run([]string{"unknown"}, stdout, stderr); code != 2 {
+		t.Errorf("expect exit code 2 but %d", code)
+	}
+	if !strings.Contains(stderr.String(), "Usage") {
+		t.Errorf("expect usage printed but %s", stderr.String())
+	}
+}
+
+func TestRun_Lint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "grool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "rules.grl")
+	rule := "rule A \"\" {\n when\n Cart.Total > 5 && Cart.Total < 3\n then\n Cart.Total = 4;\n}"
+	if err := ioutil.WriteFile(file, []byte(rule), 0644); err != nil {
+		t.Fatal(err)
+	}
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	if code := run([]string{"lint", file}, stdout, stderr); code != 1 {
+		t.Errorf("expect exit code 1 but %d. %s", code, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), "[unreachable-condition]") {
+		t.Errorf("expect unreachable condition warning but %s", stdout.String())
+	}
+}
+
+func TestRun_Fmt(t *testing.T) {
+	dir, err := ioutil.TempDir("", "grool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "rules.grl")
+	rule := "rule A \"\" { when Cart.Total>5 then Cart.Total = 4; }"
+	if err := ioutil.WriteFile(file, []byte(rule), 0644); err != nil {
+		t.Fatal(err)
+	}
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	if code := run([]string{"fmt", "-l", file}, stdout, stderr); code != 1 {
+		t.Errorf("expect exit code 1 but %d. %s", code, stderr.String())
+	}
+	if strings.TrimSpace(stdout.String()) != file {
+		t.Errorf("expect %s listed but %s", file, stdout.String())
+	}
+	if code := run([]string{"fmt", file}, stdout, stderr); code != 0 {
+		t.Errorf("expect exit code 0 but %d. %s", code, stderr.String())
+	}
+	formatted, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "rule A \"\" {\n\twhen\n\t\tCart.Total > 5\n\tthen\n\t\tCart.Total = 4;\n}\n"
+	if string(formatted) != expect {
+		t.Errorf("expect\n%s\nbut\n%s", expect, formatted)
+	}
+}
+
+func TestRun_Run(t *testing.T) {
+	dir, err := ioutil.TempDir("", "grool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ruleFile := filepath.Join(dir, "rules.grl")
+	rule := `rule Discount "" {
+	when
+		Purchase.Total > 100 && Purchase.Discount == 0
+	then
+		Purchase.Discount = Purchase.Total * Purchase.Rate;
+		for item in Purchase.Items {
+			item.Price = item.Price + 1;
+		}
+}`
+	if err := ioutil.WriteFile(ruleFile, []byte(rule), 0644); err != nil {
+		t.Fatal(err)
+	}
+	factFile := filepath.Join(dir, "purchase.json")
+	fact := `{"total": 150, "discount": 0.0, "rate": 0.5, "items": [{"price": 10}]}`
+	if err := ioutil.WriteFile(factFile, []byte(fact), 0644); err != nil {
+		t.Fatal(err)
+	}
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	if code := run([]string{"run", ruleFile, "--fact", "Purchase=" + factFile}, stdout, stderr); code != 0 {
+		t.Fatalf("expect exit code 0 but %d. %s", code, stderr.String())
+	}
+	var result struct {
+		Facts map[string]struct {
+			Discount float64
+			Items    []struct{ Price int }
+		}
+		Fired []string
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &result); err != nil {
+		t.Fatal(err)
+	}
+	purchase := result.Facts["Purchase"]
+	if purchase.Discount != 75 || len(purchase.Items) != 1 || purchase.Items[0].Price != 11 {
+		t.Errorf("unexpected facts %s", stdout.String())
+	}
+	if len(result.Fired) != 1 || result.Fired[0] != "Discount" {
+		t.Errorf("expect Discount fired once but %v", result.Fired)
+	}
+
+	if code := run([]string{"run", "--fact", "Purchase", ruleFile}, stdout, stderr); code != 2 {
+		t.Errorf("expect exit code 2 but %d", code)
+	}
+}
+
+func TestRun_RunRealIntoInteger(t *testing.T) {
+	dir, err := ioutil.TempDir("", "grool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ruleFile := filepath.Join(dir, "rules.grl")
+	rule := `rule Tax "" {
+	when
+		Purchase.Taxed == false
+	then
+		Purchase.Price = Purchase.Price * 1.15;
+		Purchase.Taxed = true;
+}`
+	if err := ioutil.WriteFile(ruleFile, []byte(rule), 0644); err != nil {
+		t.Fatal(err)
+	}
+	factFile := filepath.Join(dir, "purchase.json")
+	if err := ioutil.WriteFile(factFile, []byte(`{"price": 100, "taxed": false}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	if code := run([]string{"run", ruleFile, "--fact", "Purchase=" + factFile}, stdout, stderr); code != 0 {
+		t.Fatalf("expect exit code 0 but %d. %s", code, stderr.String())
+	}
+	var result struct {
+		Facts map[string]struct{ Price float64 }
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &result); err != nil {
+		t.Fatal(err)
+	}
+	if price := result.Facts["Purchase"].Price; price < 114.99 || price > 115.01 {
+		t.Errorf("expect price 115 but %s", stdout.String())
+	}
+}
+
+func TestRun_Gen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "grool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	facts := "package cart\n\ntype Cart struct {\n\tTotal int\n\tFree  bool\n}\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "Cart.go"), []byte(facts), 0644); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "rules.grl")
+	rule := "rule Free \"\" {\n when\n Cart.Total > 100 && Cart.Free == false\n then\n Cart.Free = true;\n}"
+	if err := ioutil.WriteFile(file, []byte(rule), 0644); err != nil {
+		t.Fatal(err)
+	}
+	output := filepath.Join(dir, "Rules.go")
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	if code := run([]string{"gen", "-dir", dir, file, "--fact", "Cart=Cart", "-o", output}, stdout, stderr); code != 0 {
+		t.Fatalf("expect exit code 0 but %d. %s", code, stderr.String())
+	}
+	code, err := ioutil.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(code), "package cart") || !strings.Contains(string(code), "s.facts.Cart.Free = true") {
+		t.Errorf("unexpected generated code %s", code)
+	}
+
+	stdout, stderr = &bytes.Buffer{}, &bytes.Buffer{}
+	if code := run([]string{"gen", "-dir", dir, "--fact", "Cart=Basket", file}, stdout, stderr); code != 1 {
+		t.Errorf("expect exit code 1 but %d", code)
+	}
+	if !strings.Contains(stderr.String(), "type Basket of fact Cart is not found") {
+		t.Errorf("expect unknown type error but %s", stderr.String())
+	}
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/juju/errors"
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// factFlags collects the repeated --fact Name=file.json flags.
+type factFlags []string
+
+func (f *factFlags) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *factFlags) Set(value string) error {
+	if !strings.Contains(value, "=") {
+		return errors.Errorf("fact %s is not in the Name=file.json form", value)
+	}
+	*f = append(*f, value)
+	return nil
+}
+
+// runResult is what the run command prints.
+type runResult struct {
+	Facts map[string]interface{} `json:"facts"`
+	Fired []string               `json:"fired"`
+}
+
+// runCommand executes the GRL files against the facts decoded from JSON files,
+// and prints the resulting facts and the fired rules, in execution order, as JSON.
+func runCommand(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("run", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	facts := make(factFlags, 0)
+	flags.Var(&facts, "fact", "a fact in the Name=file.json form, may be repeated")
+	maxCycle := flags.Uint64("max-cycle", 5000, "the maximum number of cycles")
+
+	// the flags may come before or after the GRL files.
+	files := make([]string, 0)
+	for {
+		if err := flags.Parse(args); err != nil {
+			return 2
+		}
+		if flags.NArg() == 0 {
+			break
+		}
+		files = append(files, flags.Arg(0))
+		args = flags.Args()[1:]
+	}
+	if len(files) == 0 {
+		fmt.Fprintln(stderr, "grool run: no GRL file to run")
+		return 2
+	}
+
+	knowledgeBase := model.NewKnowledgeBase()
+	ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+	for _, file := range files {
+		if err := ruleBuilder.BuildRuleFromResource(pkg.NewFileResource(file)); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+	}
+
+	dataContext := context.NewDataContext()
+	result := &runResult{
+		Facts: make(map[string]interface{}),
+		Fired: make([]string, 0),
+	}
+	for _, fact := range facts {
+		name := fact[:strings.Index(fact, "=")]
+		obj, err := loadFact(fact[len(name)+1:])
+		if err != nil {
+			fmt.Fprintf(stderr, "grool run: fact %s : %v\n", name, err)
+			return 1
+		}
+		if err := dataContext.Add(name, obj); err != nil {
+			fmt.Fprintf(stderr, "grool run: fact %s : %v\n", name, err)
+			return 1
+		}
+		result.Facts[name] = obj
+	}
+
+	groolEngine := engine.NewGroolEngine()
+	groolEngine.MaxCycle = *maxCycle
+	groolEngine.OnRuleExecuted = func(entry *model.RuleEntry) {
+		result.Fired = append(result.Fired, entry.RuleName)
+	}
+	if err := groolEngine.Execute(dataContext, knowledgeBase); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	encoder := json.NewEncoder(stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	return 0
+}
+
+// loadFact decodes a JSON object file into a pointer to a struct built from the object.
+func loadFact(file string) (interface{}, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if _, ok := decoded.(map[string]interface{}); !ok {
+		return nil, errors.Errorf("%s does not hold a JSON object", file)
+	}
+	val, err := dynamicValue(decoded)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return val.Interface(), nil
+}
+
+// dynamicValue converts a decoded JSON value into a value the rules can work with.
+// An object becomes a pointer to a struct which fields are the object keys with the first letter in upper case,
+// a number becomes float64, so a rule may assign any number to it, and an array a slice of its element type.
+func dynamicValue(decoded interface{}) (reflect.Value, error) {
+	switch v := decoded.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		fields := make([]reflect.StructField, len(keys))
+		values := make([]reflect.Value, len(keys))
+		names := make(map[string]string)
+		for i, key := range keys {
+			name, err := fieldName(key)
+			if err != nil {
+				return reflect.Value{}, errors.Trace(err)
+			}
+			if other, ok := names[name]; ok {
+				return reflect.Value{}, errors.Errorf("keys %s and %s are both attribute %s", other, key, name)
+			}
+			names[name] = key
+			val, err := dynamicValue(v[key])
+			if err != nil {
+				return reflect.Value{}, errors.Annotatef(err, "key %s", key)
+			}
+			fields[i] = reflect.StructField{Name: name, Type: val.Type(), Tag: reflect.StructTag(fmt.Sprintf(`json:"%s"`, key))}
+			values[i] = val
+		}
+		obj := reflect.New(reflect.StructOf(fields))
+		for i, val := range values {
+			obj.Elem().Field(i).Set(val)
+		}
+		return obj, nil
+	case []interface{}:
+		items := make([]reflect.Value, len(v))
+		var elemType reflect.Type
+		for i, item := range v {
+			val, err := dynamicValue(item)
+			if err != nil {
+				return reflect.Value{}, errors.Annotatef(err, "index %d", i)
+			}
+			items[i] = val
+			if i == 0 {
+				elemType = val.Type()
+			} else if elemType != val.Type() {
+				elemType = reflect.TypeOf((*interface{})(nil)).Elem()
+			}
+		}
+		if elemType == nil {
+			elemType = reflect.TypeOf((*interface{})(nil)).Elem()
+		}
+		slice := reflect.MakeSlice(reflect.SliceOf(elemType), len(items), len(items))
+		for i, item := range items {
+			slice.Index(i).Set(item)
+		}
+		return slice, nil
+	case float64:
+		return reflect.ValueOf(v), nil
+	case nil:
+		return reflect.Zero(reflect.TypeOf((*interface{})(nil)).Elem()), nil
+	default:
+		return reflect.ValueOf(v), nil
+	}
+}
+
+// fieldName returns the struct field name of a JSON object key.
+func fieldName(key string) (string, error) {
+	runes := []rune(key)
+	if len(runes) == 0 {
+		return "", errors.Errorf("empty key can not be an attribute")
+	}
+	runes[0] = unicode.ToUpper(runes[0])
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && r != '_' && (i == 0 || !unicode.IsDigit(r)) {
+			return "", errors.Errorf("key %s can not be an attribute", key)
+		}
+	}
+	if !unicode.IsUpper(runes[0]) {
+		return "", errors.Errorf("key %s can not be an attribute", key)
+	}
+	return string(runes), nil
+}
@@ -18,14 +18,23 @@ func NewDataContext() *DataContext {
 	return &DataContext{
 		ObjectStore: make(map[string]interface{}),
 		Retracted:   make([]string, 0),
+		ReadOnly:    make(map[string]bool),
 	}
 }
 
 // DataContext holds all structs instance to be used in rule execution environment.
+// ReadOnly holds the keys of the facts that can not be assigned by the rules,
+// and Journal, if set, records every assignment made by SetValue.
+// JSONTags makes the json struct tag name a fact field, when the field has no grool tag.
 type DataContext struct {
 	ObjectStore         map[string]interface{}
 	Retracted           []string
+	ReadOnly            map[string]bool
+	Journal             *Journal
+	JSONTags            bool
 	VariableChangeCount uint64
+
+	scopedPaths map[string][]string
 }
 
 // Retract temporary retract a fact from data context, making it unavailable for evaluation or modification.
@@ -33,16 +42,89 @@ func (ctx *DataContext) Retract(key string) {
 	ctx.Retracted = append(ctx.Retracted, key)
 }
 
-// Add will add struct instance into rule execution context
+// Add will add struct instance into rule execution context.
+// A map with string keys, such as a decoded JSON object, may be added as well, its keys are used like struct fields.
 func (ctx *DataContext) Add(key string, obj interface{}) error {
 	objVal := reflect.ValueOf(obj)
-	if objVal.Kind() != reflect.Ptr && objVal.Elem().Kind() != reflect.Struct {
-		return errors.New(fmt.Sprintf("you can only insert a pointer to struct as fact. objVal = %s", objVal.Kind().String()))
+	if pkg.IsMap(obj) {
+		if pkg.IsNilValue(objVal) || (objVal.Kind() == reflect.Ptr && objVal.Elem().IsNil()) {
+			return errors.Errorf("you can not insert a nil map as fact")
+		}
+	} else if objVal.Kind() != reflect.Ptr || objVal.Elem().Kind() != reflect.Struct {
+		return errors.New(fmt.Sprintf("you can only insert a pointer to struct or a map as fact. objVal = %s", objVal.Kind().String()))
+	}
+	ctx.ObjectStore[key] = obj
+	return nil
+}
+
+// AddReadOnly will add struct instance into rule execution context as a fact the rules can read but not assign.
+func (ctx *DataContext) AddReadOnly(key string, obj interface{}) error {
+	if err := ctx.Add(key, obj); err != nil {
+		return err
+	}
+	ctx.markReadOnly(key)
+	return nil
+}
+
+// IsReadOnly checks if a key fact can not be assigned by the rules.
+func (ctx *DataContext) IsReadOnly(key string) bool {
+	return ctx.ReadOnly[key]
+}
+
+func (ctx *DataContext) markReadOnly(key string) {
+	if ctx.ReadOnly == nil {
+		ctx.ReadOnly = make(map[string]bool)
+	}
+	ctx.ReadOnly[key] = true
+}
+
+// AddScopedVariable will add a variable that only lives within a scope of the rule, such as a loop variable.
+// Unlike Add, the variable may hold any value, but it may not shadow an existing fact.
+func (ctx *DataContext) AddScopedVariable(key string, obj interface{}) error {
+	if _, ok := ctx.ObjectStore[key]; ok {
+		return errors.Errorf("variable %s shadows an existing fact", key)
 	}
 	ctx.ObjectStore[key] = obj
 	return nil
 }
 
+// AddReadOnlyScopedVariable will add a scoped variable the rules can not assign, such as a loop variable
+// over a read-only fact.
+func (ctx *DataContext) AddReadOnlyScopedVariable(key string, obj interface{}) error {
+	if err := ctx.AddScopedVariable(key, obj); err != nil {
+		return err
+	}
+	ctx.markReadOnly(key)
+	return nil
+}
+
+// SetScopedVariablePath tells where a scoped variable is within a fact, like ["Cart", "Lines", "[0]"] for an item
+// of a loop over Cart.Lines, so the journal records the changes made through the variable by their path in the fact.
+func (ctx *DataContext) SetScopedVariablePath(key string, path []string) {
+	if ctx.scopedPaths == nil {
+		ctx.scopedPaths = make(map[string][]string)
+	}
+	ctx.scopedPaths[key] = path
+}
+
+// FactPath returns the path elements of a variable starting from its fact, resolving the scoped variables.
+func (ctx *DataContext) FactPath(variable string) []string {
+	varArray := strings.Split(strings.ReplaceAll(variable, "?", ""), ".")
+	if scoped, ok := ctx.scopedPaths[varArray[0]]; ok {
+		path := make([]string, 0, len(scoped)+len(varArray)-1)
+		path = append(path, scoped...)
+		return append(path, varArray[1:]...)
+	}
+	return varArray
+}
+
+// RemoveScopedVariable will remove a variable added using AddScopedVariable once its scope ended.
+func (ctx *DataContext) RemoveScopedVariable(key string) {
+	delete(ctx.ObjectStore, key)
+	delete(ctx.ReadOnly, key)
+	delete(ctx.scopedPaths, key)
+}
+
 // IsRestracted checks if a key fact is currently retracted.
 func (ctx *DataContext) IsRestracted(key string) bool {
 	for _, v := range ctx.Retracted {
@@ -60,22 +142,15 @@ func (ctx *DataContext) Reset() {
 
 // ExecMethod will execute instance member variable using the supplied arguments.
 func (ctx *DataContext) ExecMethod(methodName string, args []reflect.Value) (reflect.Value, error) {
-	varArray := strings.Split(methodName, ".")
-	if val, ok := ctx.ObjectStore[varArray[0]]; ok {
-		if !ctx.IsRestracted(varArray[0]) {
-			return traceMethod(val, varArray[1:], args)
-		}
-		return reflect.ValueOf(nil), FactRetractedError
-	}
-	return reflect.ValueOf(nil), FactNotFoundError
+	return ctx.ExecPathMethod(NewPath(methodName), args)
 }
 
 // GetType will extract type information of data in this context.
 func (ctx *DataContext) GetType(variable string) (reflect.Type, error) {
-	varArray := strings.Split(variable, ".")
+	varArray := strings.Split(strings.ReplaceAll(variable, "?", ""), ".")
 	if val, ok := ctx.ObjectStore[varArray[0]]; ok {
 		if !ctx.IsRestracted(varArray[0]) {
-			return traceType(val, varArray[1:])
+			return ctx.traceType(val, varArray[1:])
 		}
 		return nil, FactRetractedError
 	}
@@ -84,87 +159,110 @@ func (ctx *DataContext) GetType(variable string) (reflect.Type, error) {
 
 // GetValue will get member variables Value information.
 // Used by the rule execution to obtain variable value.
+// Path element followed by "?." is null-safe, if its value is nil the whole variable evaluates to nil.
 func (ctx *DataContext) GetValue(variable string) (reflect.Value, error) {
-	varArray := strings.Split(variable, ".")
-	if val, ok := ctx.ObjectStore[varArray[0]]; ok {
-		if !ctx.IsRestracted(varArray[0]) {
-			vval, err := traceValue(val, varArray[1:])
-			if err != nil {
-				fmt.Printf("blah %s = %v\n", variable, vval)
-			}
-			return vval, err
-		}
-		return reflect.ValueOf(nil), FactRetractedError
-	}
-	return reflect.ValueOf(nil), FactNotFoundError
+	return ctx.GetPathValue(NewPath(variable))
 }
 
 // SetValue will set variable value of an object instance in this data context, Used by rule script to set values.
+// If a null-safe path element is nil, the assignment is skipped.
 func (ctx *DataContext) SetValue(variable string, newValue reflect.Value) error {
-	varArray := strings.Split(variable, ".")
-	if val, ok := ctx.ObjectStore[varArray[0]]; ok {
-		if !ctx.IsRestracted(varArray[0]) {
-			err := traceSetValue(val, varArray[1:], newValue)
-			if err == nil {
-				ctx.VariableChangeCount++
-			}
-			return err
-		}
-		return FactRetractedError
+	return ctx.SetPathValue(NewPath(variable), newValue)
+}
+
+// errNullSafeSkip signals that an assignment were skipped because of a nil null-safe path element.
+var errNullSafeSkip = errors.New("null-safe path element is nil")
+
+// nullSafeName strips the null-safe marker from a path element and tells whether the element is null-safe.
+func nullSafeName(name string) (string, bool) {
+	if strings.HasSuffix(name, "?") {
+		return strings.TrimSuffix(name, "?"), true
 	}
-	return FactNotFoundError
+	return name, false
+}
+
+// naming tells how the rules name the fact fields.
+func (ctx *DataContext) naming() pkg.FieldNaming {
+	return pkg.FieldNaming{JSONTags: ctx.JSONTags}
 }
 
-func traceType(obj interface{}, path []string) (reflect.Type, error) {
+func (ctx *DataContext) traceType(obj interface{}, path []string) (reflect.Type, error) {
 	switch length := len(path); {
 	case length == 1:
-		return pkg.GetAttributeType(obj, path[0])
+		return ctx.naming().GetAttributeType(obj, path[0])
 	case length > 1:
-		objVal, err := pkg.GetAttributeValue(obj, path[0])
+		objVal, err := ctx.naming().GetAttributeValue(obj, path[0])
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
-		return traceType(pkg.ValueToInterface(objVal), path[1:])
+		return ctx.traceType(pkg.ValueToInterface(objVal), path[1:])
 	default:
 		return reflect.TypeOf(obj), nil
 	}
 }
 
-func traceValue(obj interface{}, path []string) (reflect.Value, error) {
+func (ctx *DataContext) traceValue(obj reflect.Value, path []string) (reflect.Value, error) {
 	switch length := len(path); {
 	case length == 1:
-		return pkg.GetAttributeValue(obj, path[0])
+		return ctx.naming().GetAttributeValue(receiverInterface(obj), path[0])
 	case length > 1:
-		objVal, err := pkg.GetAttributeValue(obj, path[0])
+		name, nullSafe := nullSafeName(path[0])
+		objVal, err := ctx.naming().GetAttributeValue(receiverInterface(obj), name)
 		if err != nil {
 			return objVal, errors.Trace(err)
 		}
-		return traceValue(pkg.ValueToInterface(objVal), path[1:])
+		if pkg.IsNilValue(objVal) {
+			if nullSafe {
+				return reflect.ValueOf(nil), nil
+			}
+			return reflect.ValueOf(nil), errors.Errorf("can not get %s from nil attribute %s", path[1], name)
+		}
+		return ctx.traceValue(objVal, path[1:])
 	default:
-		return reflect.ValueOf(obj), nil
+		return obj, nil
 	}
 }
 
-func traceSetValue(obj interface{}, path []string, newValue reflect.Value) error {
+func (ctx *DataContext) traceSetValue(variable *Path, obj interface{}, path []string, newValue reflect.Value) error {
 	switch length := len(path); {
 	case length == 1:
-		return pkg.SetAttributeValue(obj, path[0], newValue)
+		if ctx.Journal == nil {
+			return ctx.naming().SetAttributeValue(obj, path[0], newValue)
+		}
+		change := ctx.Journal.begin(ctx.naming(), variable.plain, ctx.FactPath(variable.Variable), obj, path[0])
+		if err := ctx.naming().SetAttributeValue(obj, path[0], newValue); err != nil {
+			return err
+		}
+		if change != nil {
+			ctx.Journal.commit(change)
+		}
+		return nil
 	case length > 1:
-		objVal, err := pkg.GetAttributeValue(obj, path[0])
+		name, nullSafe := nullSafeName(path[0])
+		objVal, err := ctx.naming().GetAttributeValue(obj, name)
 		if err != nil {
 			return errors.Trace(err)
 		}
-		return traceSetValue(objVal, path[1:], newValue)
+		if pkg.IsNilValue(objVal) {
+			if nullSafe {
+				return errNullSafeSkip
+			}
+			return errors.Errorf("can not set %s of nil attribute %s", path[1], name)
+		}
+		// struct member must be traced by its address, otherwise we would set a copy.
+		if objVal.Kind() == reflect.Struct && objVal.CanAddr() {
+			return ctx.traceSetValue(variable, objVal.Addr().Interface(), path[1:], newValue)
+		}
+		return ctx.traceSetValue(variable, objVal.Interface(), path[1:], newValue)
 	default:
 		return errors.Errorf("no attribute path specified")
 	}
 }
 
-func traceMethod(obj interface{}, path []string, args []reflect.Value) (reflect.Value, error) {
-
+func (ctx *DataContext) traceMethod(objVal reflect.Value, path []string, args []reflect.Value) (reflect.Value, error) {
 	switch length := len(path); {
 	case length == 1:
-		// this obj is reflect.Value... it should not.
+		obj := receiverInterface(objVal)
 		types, err := pkg.GetFunctionParameterTypes(obj, path[0])
 		if err != nil {
 			return reflect.ValueOf(nil),
@@ -176,7 +274,14 @@ func traceMethod(obj interface{}, path []string, args []reflect.Value) (reflect.
 		}
 		iargs := make([]interface{}, 0)
 		for i, t := range types {
-			if t.Kind() != args[i].Kind() {
+			if pkg.IsConvertibleKind(t, args[i]) {
+				converted, err := ctx.naming().ConvertValue(args[i], t)
+				if err != nil {
+					return reflect.ValueOf(nil),
+						errors.Errorf("invalid argument types for function %s(). argument #%d, got %v", path[0], i, err)
+				}
+				iargs = append(iargs, converted.Interface())
+			} else if t.Kind() != args[i].Kind() {
 				if t.Kind() == reflect.Interface {
 					iargs = append(iargs, pkg.ValueToInterface(args[i]))
 				} else {
@@ -184,7 +289,12 @@ func traceMethod(obj interface{}, path []string, args []reflect.Value) (reflect.
 						errors.Errorf("invalid argument types for function %s(). argument #%d, require %s but %s", path[0], i, t.Kind().String(), args[i].Kind().String())
 				}
 			} else {
-				iargs = append(iargs, pkg.ValueToInterface(args[i]))
+				iarg := pkg.ValueToInterface(args[i])
+				if iarg != nil && reflect.TypeOf(iarg) != t && reflect.TypeOf(iarg).ConvertibleTo(t) {
+					// same kind but named type, such as time.Duration.
+					iarg = reflect.ValueOf(iarg).Convert(t).Interface()
+				}
+				iargs = append(iargs, iarg)
 			}
 		}
 		rets, err := pkg.InvokeFunction(obj, path[0], iargs)
@@ -192,6 +302,12 @@ func traceMethod(obj interface{}, path []string, args []reflect.Value) (reflect.
 			return reflect.ValueOf(nil), err
 		}
 		switch retLen := len(rets); {
+		case retLen == 2 && isErrorReturn(rets[1]):
+			// a function may return an error as its last return value.
+			if rets[1] != nil {
+				return reflect.ValueOf(nil), errors.Annotatef(rets[1].(error), "function %s() returns error", path[0])
+			}
+			return reflect.ValueOf(rets[0]), nil
 		case retLen > 1:
 			return reflect.ValueOf(rets[0]), errors.Errorf("multiple return value for function %s(). ", path[0])
 		case retLen == 1:
@@ -200,12 +316,60 @@ func traceMethod(obj interface{}, path []string, args []reflect.Value) (reflect.
 			return reflect.ValueOf(nil), nil
 		}
 	case length > 1:
-		objVal, err := pkg.GetAttributeValue(obj, path[0])
+		name, nullSafe := nullSafeName(path[0])
+		attrVal, err := ctx.naming().GetAttributeValue(receiverInterface(objVal), name)
 		if err != nil {
 			return reflect.ValueOf(nil), errors.Trace(err)
 		}
-		return traceMethod(objVal, path[1:], args)
+		if pkg.IsNilValue(attrVal) {
+			if nullSafe {
+				return reflect.ValueOf(nil), nil
+			}
+			return reflect.ValueOf(nil), errors.Errorf("can not call %s on nil attribute %s", path[len(path)-1], name)
+		}
+		return ctx.traceMethod(attrVal, path[1:], args)
 	default:
 		return reflect.ValueOf(nil), errors.Errorf("no function path specified")
 	}
 }
+
+// GetValueFrom will get member variable value of an object that is not necessarily a fact, such as
+// a function call result. The variable is a path of member names separated by dot.
+func (ctx *DataContext) GetValueFrom(obj reflect.Value, variable string) (reflect.Value, error) {
+	if pkg.IsNilValue(obj) {
+		return reflect.ValueOf(nil), errors.Errorf("can not get %s from nil value", variable)
+	}
+	return ctx.traceValue(obj, strings.Split(variable, "."))
+}
+
+// ExecMethodOn will execute method of an object that is not necessarily a fact, such as a function call result.
+func (ctx *DataContext) ExecMethodOn(obj reflect.Value, methodName string, args []reflect.Value) (reflect.Value, error) {
+	if pkg.IsNilValue(obj) {
+		return reflect.ValueOf(nil), errors.Errorf("can not call %s on nil value", methodName)
+	}
+	return ctx.traceMethod(obj, strings.Split(methodName, "."), args)
+}
+
+// receiverInterface returns the interface of a value to trace its member or call its method. An addressable struct
+// is taken by its address, so methods with pointer receiver are available and a call does not work on a copy.
+func receiverInterface(val reflect.Value) interface{} {
+	for val.Kind() == reflect.Interface && !val.IsNil() {
+		val = val.Elem()
+	}
+	if val.Kind() == reflect.Struct && val.CanAddr() {
+		return val.Addr().Interface()
+	}
+	if !val.IsValid() || !val.CanInterface() {
+		return nil
+	}
+	return val.Interface()
+}
+
+// isErrorReturn checks if a function return value is an error or a nil error.
+func isErrorReturn(ret interface{}) bool {
+	if ret == nil {
+		return true
+	}
+	_, ok := ret.(error)
+	return ok
+}
@@ -63,3 +63,235 @@ func TestDataContext_GetValue(t *testing.T) {
 	}
 
 }
+
+func TestDataContext_AddScopedVariable(t *testing.T) {
+	ctx := NewDataContext()
+	err := ctx.Add("ta", &TestAStruct{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.AddScopedVariable("ta", &TestCStruct{}); err == nil {
+		t.Errorf("scoped variable should not shadow an existing fact")
+	}
+	if err := ctx.AddScopedVariable("tc", &TestCStruct{Str: "Scoped"}); err != nil {
+		t.Fatal(err)
+	}
+	val, err := ctx.GetValue("tc.Str")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if val.String() != "Scoped" {
+		t.Errorf("expect Scoped but %s", val.String())
+	}
+	ctx.RemoveScopedVariable("tc")
+	if _, err := ctx.GetValue("tc.Str"); err != FactNotFoundError {
+		t.Errorf("scoped variable should be removed")
+	}
+}
+
+func TestDataContext_SetValue(t *testing.T) {
+	TA := &TestAStruct{BStruct: &TestBStruct{CStruct: &TestCStruct{
+		Str: "TestValue",
+		It:  100,
+	}}}
+
+	ctx := NewDataContext()
+	err := ctx.Add("ta", TA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ctx.SetValue("ta.BStruct.CStruct.Str", reflect.ValueOf("NewValue"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if TA.BStruct.CStruct.Str != "NewValue" {
+		t.Errorf("Value is not correct, %s", TA.BStruct.CStruct.Str)
+	}
+	if ctx.VariableChangeCount != 1 {
+		t.Errorf("Variable change count should be 1 but %d", ctx.VariableChangeCount)
+	}
+}
+
+func TestDataContext_AddReadOnly(t *testing.T) {
+	TA := &TestAStruct{BStruct: &TestBStruct{CStruct: &TestCStruct{
+		Str: "TestValue",
+	}}}
+
+	ctx := NewDataContext()
+	err := ctx.AddReadOnly("ta", TA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ctx.IsReadOnly("ta") {
+		t.Errorf("ta should be read only")
+	}
+	val, err := ctx.GetValue("ta.BStruct.CStruct.Str")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if val.String() != "TestValue" {
+		t.Errorf("Value is not correct, %s", val.String())
+	}
+	err = ctx.SetValue("ta.BStruct.CStruct.Str", reflect.ValueOf("NewValue"))
+	if err == nil {
+		t.Errorf("read only fact should not be set")
+	}
+	if TA.BStruct.CStruct.Str != "TestValue" || ctx.VariableChangeCount != 0 {
+		t.Errorf("read only fact changed")
+	}
+
+	err = ctx.AddReadOnlyScopedVariable("item", TA.BStruct.CStruct)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ctx.SetValue("item.Str", reflect.ValueOf("NewValue")) == nil {
+		t.Errorf("read only scoped variable should not be set")
+	}
+	ctx.RemoveScopedVariable("item")
+	if ctx.IsReadOnly("item") {
+		t.Errorf("removed scoped variable should not be read only")
+	}
+}
+
+func TestDataContext_Journal(t *testing.T) {
+	TA := &TestAStruct{BStruct: &TestBStruct{CStruct: &TestCStruct{
+		Str: "TestValue",
+		It:  100,
+	}}}
+	attrs := map[string]interface{}{"Color": "red"}
+
+	ctx := NewDataContext()
+	if err := ctx.Add("ta", TA); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.Add("attrs", attrs); err != nil {
+		t.Fatal(err)
+	}
+	ctx.Journal = NewJournal()
+	for _, set := range []struct {
+		variable string
+		value    interface{}
+	}{
+		{"ta.BStruct.CStruct.It", 200},
+		{"ta.BStruct.CStruct.It", 300},
+		{"ta.BStruct?.CStruct.Str", "NewValue"},
+		{"attrs.Color", "blue"},
+		{"attrs.Size", "XL"},
+	} {
+		if err := ctx.SetValue(set.variable, reflect.ValueOf(set.value)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ctx.SetValue("ta.BStruct.CStruct.It", reflect.ValueOf("wrong type")); err == nil {
+		t.Fatal("expect error")
+	}
+	if len(ctx.Journal.Changes) != 5 {
+		t.Fatalf("expect 5 changes but %d", len(ctx.Journal.Changes))
+	}
+	change := ctx.Journal.Changes[2]
+	if change.Variable != "ta.BStruct.CStruct.Str" || change.OldValue != "TestValue" || change.NewValue != "NewValue" {
+		t.Errorf("unexpected change %s %v %v", change.Variable, change.OldValue, change.NewValue)
+	}
+	if change := ctx.Journal.Changes[4]; change.OldValue != nil || change.NewValue != "XL" {
+		t.Errorf("unexpected change %s %v %v", change.Variable, change.OldValue, change.NewValue)
+	}
+
+	ctx.Journal.RollbackTo(3)
+	if len(ctx.Journal.Changes) != 3 || TA.BStruct.CStruct.Str != "NewValue" || attrs["Color"] != "red" || len(attrs) != 1 {
+		t.Errorf("expect only the last 2 changes rolled back %+v %v", TA.BStruct.CStruct, attrs)
+	}
+	ctx.Journal.Rollback()
+	if TA.BStruct.CStruct.It != 100 || TA.BStruct.CStruct.Str != "TestValue" {
+		t.Errorf("struct not rolled back %+v", TA.BStruct.CStruct)
+	}
+	if len(attrs) != 1 || attrs["Color"] != "red" {
+		t.Errorf("map not rolled back %v", attrs)
+	}
+	if len(ctx.Journal.Changes) != 0 {
+		t.Errorf("expect journal cleared")
+	}
+}
+
+func TestDataContext_NullSafe(t *testing.T) {
+	TA := &TestAStruct{BStruct: &TestBStruct{}}
+
+	ctx := NewDataContext()
+	err := ctx.Add("ta", TA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ctx.GetValue("ta.BStruct.CStruct.Str"); err == nil {
+		t.Errorf("Getting value through nil attribute should fail")
+	}
+	val, err := ctx.GetValue("ta.BStruct.CStruct?.Str")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if val.IsValid() {
+		t.Errorf("Null-safe value should be nil but %v", val)
+	}
+
+	if err := ctx.SetValue("ta.BStruct.CStruct.Str", reflect.ValueOf("NewValue")); err == nil {
+		t.Errorf("Setting value through nil attribute should fail")
+	}
+	if err := ctx.SetValue("ta.BStruct.CStruct?.Str", reflect.ValueOf("NewValue")); err != nil {
+		t.Fatal(err)
+	}
+	if ctx.VariableChangeCount != 0 {
+		t.Errorf("Skipped null-safe assignment should not count as change")
+	}
+}
+
+func BenchmarkDataContext_GetValue(b *testing.B) {
+	ctx := NewDataContext()
+	if err := ctx.Add("ta", &TestAStruct{BStruct: &TestBStruct{CStruct: &TestCStruct{It: 100}}}); err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < b.N; i++ {
+		if _, err := ctx.GetValue("ta.BStruct.CStruct.It"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDataContext_SetValue(b *testing.B) {
+	ctx := NewDataContext()
+	if err := ctx.Add("ta", &TestAStruct{BStruct: &TestBStruct{CStruct: &TestCStruct{It: 100}}}); err != nil {
+		b.Fatal(err)
+	}
+	val := reflect.ValueOf(200)
+	for i := 0; i < b.N; i++ {
+		if err := ctx.SetValue("ta.BStruct.CStruct.It", val); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDataContext_GetPathValue(b *testing.B) {
+	ctx := NewDataContext()
+	if err := ctx.Add("ta", &TestAStruct{BStruct: &TestBStruct{CStruct: &TestCStruct{It: 100}}}); err != nil {
+		b.Fatal(err)
+	}
+	path := NewPath("ta.BStruct.CStruct.It")
+	for i := 0; i < b.N; i++ {
+		if _, err := ctx.GetPathValue(path); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDataContext_SetPathValue(b *testing.B) {
+	ctx := NewDataContext()
+	if err := ctx.Add("ta", &TestAStruct{BStruct: &TestBStruct{CStruct: &TestCStruct{It: 100}}}); err != nil {
+		b.Fatal(err)
+	}
+	path := NewPath("ta.BStruct.CStruct.It")
+	val := reflect.ValueOf(200)
+	for i := 0; i < b.N; i++ {
+		if err := ctx.SetPathValue(path, val); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
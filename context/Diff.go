@@ -0,0 +1,105 @@
+package context
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// FactDiff is the difference of a single fact before and after the changes recorded in a journal.
+type FactDiff struct {
+	Fact    string
+	Changes []*FieldDiff
+}
+
+// FieldDiff is the difference of a single member of a fact, from its value before the first change
+// to its value after the last change. Added is true if the member is a map key added by the changes.
+type FieldDiff struct {
+	Path     string
+	OldValue interface{}
+	NewValue interface{}
+	Added    bool
+
+	segments []string
+}
+
+// PatchOperation is a JSON Patch (RFC 6902) operation.
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// Diff returns the difference of each fact changed in the journal, in the order the facts and members
+// were first changed. A member changed back into its original value is not a difference.
+func (j *Journal) Diff() []*FactDiff {
+	facts := make([]*FactDiff, 0)
+	factIndex := make(map[string]*FactDiff)
+	fieldIndex := make(map[string]*FieldDiff)
+	for _, change := range j.Changes {
+		field, ok := fieldIndex[change.Path]
+		if !ok {
+			field = &FieldDiff{
+				Path:     change.Path,
+				OldValue: change.OldValue,
+				Added:    change.Added,
+				segments: change.segments,
+			}
+			fieldIndex[change.Path] = field
+			fact, ok := factIndex[change.segments[0]]
+			if !ok {
+				fact = &FactDiff{Fact: change.segments[0], Changes: make([]*FieldDiff, 0)}
+				factIndex[fact.Fact] = fact
+				facts = append(facts, fact)
+			}
+			fact.Changes = append(fact.Changes, field)
+		}
+		field.NewValue = change.NewValue
+	}
+
+	diffs := make([]*FactDiff, 0, len(facts))
+	for _, fact := range facts {
+		changes := make([]*FieldDiff, 0, len(fact.Changes))
+		for _, field := range fact.Changes {
+			if field.Added || !reflect.DeepEqual(field.OldValue, field.NewValue) {
+				changes = append(changes, field)
+			}
+		}
+		if len(changes) > 0 {
+			fact.Changes = changes
+			diffs = append(diffs, fact)
+		}
+	}
+	return diffs
+}
+
+// JSONPatch returns the JSON Patch turning the fact before the changes into the fact after them.
+// The paths use the member names as the rules refer to them.
+func (d *FactDiff) JSONPatch() []*PatchOperation {
+	ops := make([]*PatchOperation, len(d.Changes))
+	for i, field := range d.Changes {
+		op := "replace"
+		if field.Added {
+			op = "add"
+		}
+		ops[i] = &PatchOperation{Op: op, Path: jsonPointer(field.segments[1:]), Value: field.NewValue}
+	}
+	return ops
+}
+
+// jsonPointer converts path elements into a JSON Pointer (RFC 6901).
+func jsonPointer(path []string) string {
+	var buf strings.Builder
+	for _, elem := range path {
+		if strings.HasPrefix(elem, "[") {
+			elem = strings.TrimSuffix(strings.TrimPrefix(elem, "["), "]")
+			if unquoted, err := strconv.Unquote(elem); err == nil {
+				elem = unquoted
+			}
+		}
+		elem = strings.ReplaceAll(elem, "~", "~0")
+		elem = strings.ReplaceAll(elem, "/", "~1")
+		buf.WriteString("/" + elem)
+	}
+	return buf.String()
+}
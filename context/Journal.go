@@ -0,0 +1,130 @@
+package context
+
+import (
+	"github.com/newm4n/grool/pkg"
+	"reflect"
+	"strings"
+)
+
+// Change is a single successful assignment of a fact member by the rules.
+// Variable is the assigned path as written in the rule and Path is the same path starting from the fact,
+// like Cart.Lines[0].Price for line.Price inside a loop over Cart.Lines. OldValue and NewValue are the member value
+// before and after the assignment, OldValue is nil if the assignment added a new map key.
+// RuleName and Cycle tell which rule made the change, in which engine cycle.
+type Change struct {
+	RuleName string
+	Cycle    uint64
+	Variable string
+	Path     string
+	OldValue interface{}
+	NewValue interface{}
+	Added    bool
+
+	segments []string
+
+	field  reflect.Value
+	mapVal reflect.Value
+	key    reflect.Value
+	old    reflect.Value
+}
+
+// Journal records every assignment made through a DataContext which Journal is set, so they can be rolled back
+// or shown to the user. Assignments done inside the fact methods called by the rules are not recorded.
+// The engine sets RuleName and Cycle before executing each rule, they are recorded into the changes.
+type Journal struct {
+	Changes  []*Change
+	RuleName string
+	Cycle    uint64
+}
+
+// NewJournal creates an empty journal.
+func NewJournal() *Journal {
+	return &Journal{
+		Changes: make([]*Change, 0),
+	}
+}
+
+// Rollback restores every recorded member to its value before the first recorded change, and clears the journal.
+func (j *Journal) Rollback() {
+	j.RollbackTo(0)
+}
+
+// RollbackTo restores the members changed after the first mark changes, and removes those changes from the journal.
+func (j *Journal) RollbackTo(mark int) {
+	for i := len(j.Changes) - 1; i >= mark; i-- {
+		change := j.Changes[i]
+		switch {
+		case change.field.IsValid():
+			change.field.Set(change.old)
+		case change.Added:
+			change.mapVal.SetMapIndex(change.key, reflect.Value{})
+		default:
+			change.mapVal.SetMapIndex(change.key, change.old)
+		}
+	}
+	j.Changes = j.Changes[:mark]
+}
+
+// begin captures the current value of the member of obj, named by the naming, before it is assigned.
+// It returns nil if the member can not be assigned, then the assignment fails anyway.
+func (j *Journal) begin(naming pkg.FieldNaming, variable string, path []string, obj interface{}, name string) *Change {
+	change := &Change{
+		RuleName: j.RuleName,
+		Cycle:    j.Cycle,
+		Variable: variable,
+		Path:     joinPath(path),
+		segments: path,
+	}
+	if pkg.IsMap(obj) {
+		mapVal := reflect.ValueOf(obj)
+		if mapVal.Kind() == reflect.Ptr {
+			mapVal = mapVal.Elem()
+		}
+		if mapVal.IsNil() {
+			return nil
+		}
+		change.mapVal = mapVal
+		change.key = reflect.ValueOf(name).Convert(mapVal.Type().Key())
+		change.old = mapVal.MapIndex(change.key)
+		change.Added = !change.old.IsValid()
+	} else {
+		field, err := naming.GetAttributeValue(obj, name)
+		if err != nil || !field.CanSet() {
+			return nil
+		}
+		change.field = field
+		change.old = reflect.New(field.Type()).Elem()
+		change.old.Set(field)
+	}
+	change.OldValue = valueInterface(change.old)
+	return change
+}
+
+// commit records the change once the assignment succeeded.
+func (j *Journal) commit(change *Change) {
+	if change.field.IsValid() {
+		change.NewValue = valueInterface(change.field)
+	} else {
+		change.NewValue = valueInterface(change.mapVal.MapIndex(change.key))
+	}
+	j.Changes = append(j.Changes, change)
+}
+
+func valueInterface(val reflect.Value) interface{} {
+	if !val.IsValid() || !val.CanInterface() {
+		return nil
+	}
+	return val.Interface()
+}
+
+// joinPath joins path elements into a variable path, an element like [0] is an index and not preceded by a dot.
+func joinPath(path []string) string {
+	var buf strings.Builder
+	for i, elem := range path {
+		if i > 0 && !strings.HasPrefix(elem, "[") {
+			buf.WriteString(".")
+		}
+		buf.WriteString(elem)
+	}
+	return buf.String()
+}
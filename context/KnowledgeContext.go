@@ -1,4 +1,16 @@
 package context
 
+const (
+	// DefaultMaxLoopIteration is the maximum number of iteration a single loop in the rule may take
+	// if the engine did not specify one.
+	DefaultMaxLoopIteration = 10000
+)
+
+// KnowledgeContext holds the knowledge wide settings used while executing the rule graph.
 type KnowledgeContext struct {
+	MaxLoopIteration uint64
+	// DecimalMode makes real literals evaluate into exact decimals (*big.Rat) instead of float64.
+	DecimalMode bool
+	// StrictMath refuses math operation between signed and unsigned integer.
+	StrictMath bool
 }
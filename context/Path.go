@@ -0,0 +1,99 @@
+package context
+
+import (
+	"github.com/juju/errors"
+	"github.com/newm4n/grool/pkg"
+	"reflect"
+	"strings"
+)
+
+// Path is a variable path, like Cart.Customer?.Name, parsed once so accessing the variable does not parse it again.
+// The rule graph keeps one per variable.
+type Path struct {
+	Variable string
+	root     string
+	nullSafe bool
+	elements []string
+	plain    string
+}
+
+// NewPath parses a variable path.
+func NewPath(variable string) *Path {
+	elements := strings.Split(variable, ".")
+	root, nullSafe := nullSafeName(elements[0])
+	return &Path{
+		Variable: variable,
+		root:     root,
+		nullSafe: nullSafe,
+		elements: elements[1:],
+		plain:    strings.ReplaceAll(variable, "?", ""),
+	}
+}
+
+// GetPathValue will get member variables Value information of a parsed variable path, same as GetValue.
+func (ctx *DataContext) GetPathValue(path *Path) (reflect.Value, error) {
+	if val, ok := ctx.ObjectStore[path.root]; ok {
+		if !ctx.IsRestracted(path.root) {
+			if len(path.elements) > 0 && pkg.IsNilValue(reflect.ValueOf(val)) {
+				if path.nullSafe {
+					return reflect.ValueOf(nil), nil
+				}
+				return reflect.ValueOf(nil), errors.Errorf("can not get %s from nil fact %s", path.elements[0], path.root)
+			}
+			return ctx.traceValue(reflect.ValueOf(val), path.elements)
+		}
+		return reflect.ValueOf(nil), FactRetractedError
+	}
+	return reflect.ValueOf(nil), FactNotFoundError
+}
+
+// SetPathValue will set variable value of a parsed variable path, same as SetValue.
+func (ctx *DataContext) SetPathValue(path *Path, newValue reflect.Value) error {
+	if val, ok := ctx.ObjectStore[path.root]; ok {
+		if !ctx.IsRestracted(path.root) {
+			if ctx.IsReadOnly(path.root) {
+				return errors.Errorf("can not set %s, fact %s is read only", path.plain, path.root)
+			}
+			if len(path.elements) > 0 && pkg.IsNilValue(reflect.ValueOf(val)) {
+				if path.nullSafe {
+					return nil
+				}
+				return errors.Errorf("can not set %s of nil fact %s", path.elements[0], path.root)
+			}
+			err := ctx.traceSetValue(path, val, path.elements, newValue)
+			if err == errNullSafeSkip {
+				return nil
+			}
+			if err == nil {
+				ctx.VariableChangeCount++
+			}
+			return err
+		}
+		return FactRetractedError
+	}
+	return FactNotFoundError
+}
+
+// ExecPathMethod will execute instance member method of a parsed path using the supplied arguments, same as ExecMethod.
+func (ctx *DataContext) ExecPathMethod(path *Path, args []reflect.Value) (reflect.Value, error) {
+	if val, ok := ctx.ObjectStore[path.root]; ok {
+		if !ctx.IsRestracted(path.root) {
+			if pkg.IsNilValue(reflect.ValueOf(val)) {
+				if path.nullSafe {
+					return reflect.ValueOf(nil), nil
+				}
+				return reflect.ValueOf(nil), errors.Errorf("can not call %s on nil fact %s", path.last(), path.root)
+			}
+			return ctx.traceMethod(reflect.ValueOf(val), path.elements, args)
+		}
+		return reflect.ValueOf(nil), FactRetractedError
+	}
+	return reflect.ValueOf(nil), FactNotFoundError
+}
+
+func (path *Path) last() string {
+	if len(path.elements) == 0 {
+		return path.root
+	}
+	return path.elements[len(path.elements)-1]
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/juju/errors"
 	"github.com/newm4n/grool/context"
 	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/vm"
 	log "github.com/sirupsen/logrus"
 	"sort"
 )
@@ -12,22 +13,65 @@ import (
 // It will set the max cycle to 5000
 func NewGroolEngine() *Grool {
 	return &Grool{
-		MaxCycle: 5000,
+		MaxCycle:         5000,
+		MaxLoopIteration: context.DefaultMaxLoopIteration,
 	}
 }
 
 // Grool is the engine structure. It has the Execute method to start the engine to work.
 type Grool struct {
 	MaxCycle uint64
+	// MaxLoopIteration limits the number of iteration of a single loop in the then scope. Zero means default.
+	MaxLoopIteration uint64
+	// DecimalMode makes real literals in the rules exact decimals, so the arithmetic with them has no binary rounding error.
+	DecimalMode bool
+	// StrictMath refuses implicit mixing of signed and unsigned integer in math operation.
+	StrictMath bool
+	// Transactional records every assignment into the data context journal, and rolls back those of the execution
+	// if it fails. Without a journal set by the caller, a new one is set. On success the journal holds the changes,
+	// the caller may still roll them back.
+	Transactional bool
+	// OnRuleExecuted, if set, is called after each successful execution of a rule.
+	OnRuleExecuted func(entry *model.RuleEntry)
+	// Bytecode runs the rules compiled using package vm instead of walking the rule graph. The knowledge base is
+	// compiled on its first execution, see vm.ProgramOf.
+	Bytecode bool
 }
 
 // Execute function will execute a knowledge evaluation and action against data context.
 // The engine also do conflict resolution of which rule to execute.
+// In transactional mode a failed execution leaves the facts as they were before, as far as the rules assigned them.
 func (g *Grool) Execute(dataCtx *context.DataContext, knowledge *model.KnowledgeBase) error {
+	if !g.Transactional {
+		return g.execute(dataCtx, knowledge)
+	}
+	journal := dataCtx.Journal
+	if journal == nil {
+		journal = context.NewJournal()
+		dataCtx.Journal = journal
+	}
+	mark := len(journal.Changes)
+	err := g.execute(dataCtx, knowledge)
+	if err != nil {
+		log.Infof("Rolling back %d changes", len(journal.Changes)-mark)
+		journal.RollbackTo(mark)
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+func (g *Grool) execute(dataCtx *context.DataContext, knowledge *model.KnowledgeBase) error {
 	defunc := &model.GroolFunctions{
 		Knowledge: knowledge,
 	}
-	kctx := &context.KnowledgeContext{}
+	kctx := &context.KnowledgeContext{
+		MaxLoopIteration: g.MaxLoopIteration,
+		DecimalMode:      g.DecimalMode,
+		StrictMath:       g.StrictMath,
+	}
+	if kctx.MaxLoopIteration == 0 {
+		kctx.MaxLoopIteration = context.DefaultMaxLoopIteration
+	}
 	rctx := &context.RuleContext{}
 	dataCtx.Add("DEFUNC", defunc)
 
@@ -37,6 +81,22 @@ func (g *Grool) Execute(dataCtx *context.DataContext, knowledge *model.Knowledge
 		v.Initialize(kctx, rctx, dataCtx)
 	}
 
+	canExecute := (*model.RuleEntry).CanExecute
+	executeRule := (*model.RuleEntry).Execute
+	if g.Bytecode {
+		program, err := vm.ProgramOf(knowledge)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		machine := vm.NewMachine(kctx, dataCtx)
+		canExecute = func(entry *model.RuleEntry) (bool, error) {
+			return machine.CanExecute(program.Rules[entry.RuleName])
+		}
+		executeRule = func(entry *model.RuleEntry) error {
+			return machine.Execute(program.Rules[entry.RuleName])
+		}
+	}
+
 	var cycle uint64
 
 	/*
@@ -55,7 +115,7 @@ func (g *Grool) Execute(dataCtx *context.DataContext, knowledge *model.Knowledge
 		runnable := make([]*model.RuleEntry, 0)
 		for _, v := range knowledge.RuleEntries {
 			// test if this rule entry v can execute.
-			can, err := v.CanExecute()
+			can, err := canExecute(v)
 			if err != nil {
 				log.Errorf("Failed testing condition for rule : %s. Got error %v", v.RuleName, err)
 				// No longer return error, since unavailability of variable or fact in context might be intentional.
@@ -81,11 +141,18 @@ func (g *Grool) Execute(dataCtx *context.DataContext, knowledge *model.Knowledge
 				// reset the counter to 0 to detect if there are variable change.
 				dataCtx.VariableChangeCount = 0
 				log.Infof("Executing rule : %s. Salience %d", r.RuleName, r.Salience)
-				err := r.Execute()
+				if dataCtx.Journal != nil {
+					dataCtx.Journal.RuleName = r.RuleName
+					dataCtx.Journal.Cycle = cycle
+				}
+				err := executeRule(r)
 				if err != nil {
 					log.Errorf("Failed execution rule : %s. Got error %v", r.RuleName, err)
 					return errors.Trace(err)
 				}
+				if g.OnRuleExecuted != nil {
+					g.OnRuleExecuted(r)
+				}
 				//if there is a variable change, restart the cycle.
 				if dataCtx.VariableChangeCount > 0 {
 					cycleDone = false
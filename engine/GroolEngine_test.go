@@ -5,6 +5,9 @@ import (
 	"github.com/newm4n/grool/context"
 	"github.com/newm4n/grool/model"
 	"github.com/newm4n/grool/pkg"
+	log "github.com/sirupsen/logrus"
+	"io/ioutil"
+	"os"
 	"reflect"
 	"sort"
 	"testing"
@@ -143,3 +146,107 @@ func TestEmptyValueEquality(t *testing.T) {
 		t.FailNow()
 	}
 }
+
+func TestGrool_OnRuleExecuted(t *testing.T) {
+	dctx := context.NewDataContext()
+	if err := dctx.Add("TestCar", &TestCar{SpeedUp: true, MaxSpeed: 4, SpeedIncrement: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := dctx.Add("DistanceRecord", &DistanceRecorder{}); err != nil {
+		t.Fatal(err)
+	}
+	kb := model.NewKnowledgeBase()
+	if err := builder.NewRuleBuilder(kb).BuildRuleFromResource(pkg.NewBytesResource([]byte(rules))); err != nil {
+		t.Fatal(err)
+	}
+	fired := make(map[string]int)
+	engine := NewGroolEngine()
+	engine.OnRuleExecuted = func(entry *model.RuleEntry) {
+		fired[entry.RuleName]++
+	}
+	if err := engine.Execute(dctx, kb); err != nil {
+		t.Fatal(err)
+	}
+	if fired["SpeedUp"] != 2 || fired["StartSpeedDown"] != 1 || fired["SlowDown"] != 2 || fired["SetTime"] != 1 {
+		t.Errorf("unexpected fired rules %v", fired)
+	}
+}
+
+func BenchmarkGrool_Execute(b *testing.B) {
+	benchmarkExecute(b, NewGroolEngine(), rules)
+}
+
+func BenchmarkGrool_ExecuteBytecode(b *testing.B) {
+	engine := NewGroolEngine()
+	engine.Bytecode = true
+	benchmarkExecute(b, engine, rules)
+}
+
+func BenchmarkGrool_ExecuteMath(b *testing.B) {
+	benchmarkExecute(b, NewGroolEngine(), mathRules)
+}
+
+func BenchmarkGrool_ExecuteMathBytecode(b *testing.B) {
+	engine := NewGroolEngine()
+	engine.Bytecode = true
+	benchmarkExecute(b, engine, mathRules)
+}
+
+const (
+	mathRules = `
+rule SpeedUp "Same as the SpeedUp rule, computed using more math." salience 10 {
+    when
+        TestCar.SpeedUp == true && (TestCar.Speed * 3) + (TestCar.SpeedIncrement * 2) - 4 < (TestCar.MaxSpeed * 3) - 4 + (TestCar.SpeedIncrement * 2)
+    then
+        TestCar.Speed = TestCar.Speed + (TestCar.SpeedIncrement * 6 / 3) - TestCar.SpeedIncrement;
+		DistanceRecord.TotalDistance = DistanceRecord.TotalDistance + (TestCar.Speed * 3 / 3) + TestCar.SpeedIncrement - TestCar.SpeedIncrement;
+}
+
+rule StartSpeedDown "Same as the StartSpeedDown rule, computed using more math." salience 10 {
+    when
+        TestCar.SpeedUp == true && (TestCar.Speed * 3) + (TestCar.SpeedIncrement * 2) - 4 >= (TestCar.MaxSpeed * 3) - 4 + (TestCar.SpeedIncrement * 2)
+    then
+        TestCar.SpeedUp = false;
+}
+
+rule SlowDown "Same as the SlowDown rule, computed using more math." salience 10 {
+    when
+        TestCar.SpeedUp == false && (TestCar.Speed * 2) - (TestCar.SpeedIncrement * 2) + 2 > 2 - (TestCar.SpeedIncrement * 2)
+    then
+        TestCar.Speed = TestCar.Speed - (TestCar.SpeedIncrement * 6 / 3) + TestCar.SpeedIncrement;
+		DistanceRecord.TotalDistance = DistanceRecord.TotalDistance + (TestCar.Speed * 3 / 3) + TestCar.SpeedIncrement - TestCar.SpeedIncrement;
+}
+`
+)
+
+func benchmarkExecute(b *testing.B, engine *Grool, rules string) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	// not formatting the discarded logs either, so the execution of the rules is measured.
+	log.SetLevel(log.WarnLevel)
+	defer log.SetLevel(log.InfoLevel)
+	kb := model.NewKnowledgeBase()
+	// the declared facts make the bytecode use the typed opcodes.
+	if err := kb.DeclareFact("TestCar", &TestCar{}); err != nil {
+		b.Fatal(err)
+	}
+	if err := kb.DeclareFact("DistanceRecord", &DistanceRecorder{}); err != nil {
+		b.Fatal(err)
+	}
+	if err := builder.NewRuleBuilder(kb).BuildRuleFromResource(pkg.NewBytesResource([]byte(rules))); err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dctx := context.NewDataContext()
+		if err := dctx.Add("TestCar", &TestCar{SpeedUp: true, MaxSpeed: 100, SpeedIncrement: 2}); err != nil {
+			b.Fatal(err)
+		}
+		if err := dctx.Add("DistanceRecord", &DistanceRecorder{}); err != nil {
+			b.Fatal(err)
+		}
+		if err := engine.Execute(dctx, kb); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
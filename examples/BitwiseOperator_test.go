@@ -0,0 +1,80 @@
+package examples
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"testing"
+)
+
+const (
+	bitwiseRule = `
+rule EnableBeta "Enable beta feature for premium users" {
+	when
+		Account.Flags & 1 == 1 && Account.Flags & 4 == 0
+	then
+		Account.Flags = Account.Flags | (1 << 2);
+}
+
+rule ToggleNewsletter "Toggle the newsletter flag once" {
+	when
+		Account.Toggled == false
+	then
+		Account.Flags = Account.Flags ^ 8;
+		Account.Toggled = true;
+}
+
+rule Greeting "Greet users in the first half of the alphabet" {
+	when
+		Account.Name < "N" && Account.Name >= "A" && Account.Greeting == ""
+	then
+		Account.Greeting = "Hi" + ("!" * 3);
+}
+`
+)
+
+type BitwiseAccount struct {
+	Name     string
+	Flags    uint64
+	Toggled  bool
+	Greeting string
+}
+
+func TestBitwiseOperator(t *testing.T) {
+	testData := []struct {
+		account  *BitwiseAccount
+		flags    uint64
+		greeting string
+	}{
+		{account: &BitwiseAccount{Name: "Alice", Flags: 1}, flags: 13, greeting: "Hi!!!"},
+		{account: &BitwiseAccount{Name: "Zed", Flags: 8}, flags: 0, greeting: ""},
+	}
+	for _, td := range testData {
+		dataContext := context.NewDataContext()
+		err := dataContext.Add("Account", td.account)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		knowledgeBase := model.NewKnowledgeBase()
+		ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+		err = ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(bitwiseRule)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		eng := &engine.Grool{MaxCycle: 10}
+		err = eng.Execute(dataContext, knowledgeBase)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if td.account.Flags != td.flags {
+			t.Errorf("%s flags should be %d but %d", td.account.Name, td.flags, td.account.Flags)
+		}
+		if td.account.Greeting != td.greeting {
+			t.Errorf("%s greeting should be %s but %s", td.account.Name, td.greeting, td.account.Greeting)
+		}
+	}
+}
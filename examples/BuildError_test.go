@@ -0,0 +1,74 @@
+package examples
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"strings"
+	"testing"
+)
+
+func TestBuildError_SyntaxErrors(t *testing.T) {
+	rule := `rule First "first rule" {
+	when
+		Purchase.Price > 10 &&
+	then
+		Purchase.Discount = 10;
+}
+
+rule Second "second rule" {
+	when
+		Purchase.Price > 10
+	then
+		Purchase.Discount = 10 # 2;
+}
+`
+	ruleBuilder := builder.NewRuleBuilder(model.NewKnowledgeBase())
+	err := ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(rule)))
+	buildErrors, ok := err.(builder.BuildErrors)
+	if !ok {
+		t.Fatalf("should fail with build errors but %v", err)
+	}
+	if len(buildErrors) < 2 {
+		t.Fatalf("every syntax error should be reported, but %d. %v", len(buildErrors), buildErrors)
+	}
+	first := buildErrors[0]
+	if first.Line != 3 || first.Column != 23 || first.OffendingToken != "&&" {
+		t.Errorf("expect offending token && at line 3 column 23, but %s at line %d column %d", first.OffendingToken, first.Line, first.Column)
+	}
+	if len(first.ExpectedTokens) == 0 {
+		t.Errorf("expected tokens should be reported")
+	}
+	if first.Snippet != "\t\tPurchase.Price > 10 &&\n\t\t                    ^" {
+		t.Errorf("unexpected snippet %q", first.Snippet)
+	}
+	if !strings.Contains(first.Resource, "Byte array resources") {
+		t.Errorf("resource name should be reported, but %s", first.Resource)
+	}
+	found := false
+	for _, buildError := range buildErrors {
+		if buildError.Line == 12 && buildError.Column == 26 && strings.Contains(buildError.Message, "token recognition error") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expect unknown token error at line 12 column 26. %v", buildErrors)
+	}
+}
+
+func TestBuildError_EndOfFile(t *testing.T) {
+	rule := `rule Unfinished "unfinished rule" {
+	when
+		Purchase.Price > 10
+	then
+		Purchase.Discount = 10;`
+	ruleBuilder := builder.NewRuleBuilder(model.NewKnowledgeBase())
+	err := ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(rule)))
+	buildErrors, ok := err.(builder.BuildErrors)
+	if !ok {
+		t.Fatalf("should fail with build errors but %v", err)
+	}
+	if buildErrors[0].OffendingToken != "<EOF>" || buildErrors[0].Line != 5 {
+		t.Errorf("expect error at the end of file, but %v", buildErrors[0])
+	}
+}
@@ -0,0 +1,289 @@
+package examples
+
+import (
+	"encoding/json"
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+// differentialCase is an example rule set and its facts, executed once by walking the rule graph
+// and once using the bytecode machine, or once as built and once optimized. The facts may be declared
+// once the rules are built, so the bytecode uses the typed opcodes.
+type differentialCase struct {
+	name     string
+	rules    string
+	engine   engine.Grool
+	jsonTags bool
+	readOnly []string
+	facts    func() map[string]interface{}
+}
+
+// differentialResult is everything an execution changes or reports.
+type differentialResult struct {
+	Facts   map[string]interface{}
+	Fired   []string
+	Err     string
+	Changes []context.Change
+}
+
+func (tc *differentialCase) run(t *testing.T, bytecode, optimize, declare bool) *differentialResult {
+	result := &differentialResult{
+		Facts: tc.facts(),
+		Fired: make([]string, 0),
+	}
+	dataContext := context.NewDataContext()
+	dataContext.JSONTags = tc.jsonTags
+	// the changes of rules executed in no particular order are compared in transactional cases only.
+	if tc.engine.Transactional {
+		dataContext.Journal = context.NewJournal()
+	}
+	for name, fact := range result.Facts {
+		var err error
+		if contains(tc.readOnly, name) {
+			err = dataContext.AddReadOnly(name, fact)
+		} else {
+			err = dataContext.Add(name, fact)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	knowledgeBase := model.NewKnowledgeBase()
+	knowledgeBase.JSONTags = tc.jsonTags
+	ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+	ruleBuilder.Optimize = optimize
+	if err := ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(tc.rules))); err != nil {
+		t.Fatal(err)
+	}
+	// declared after the build, as some examples would not type check. A map fact can not be declared.
+	if declare {
+		for name, fact := range result.Facts {
+			_ = knowledgeBase.DeclareFact(name, fact)
+		}
+	}
+
+	eng := tc.engine
+	eng.Bytecode = bytecode
+	eng.OnRuleExecuted = func(entry *model.RuleEntry) {
+		result.Fired = append(result.Fired, entry.RuleName)
+	}
+	if err := eng.Execute(dataContext, knowledgeBase); err != nil {
+		result.Err = err.Error()
+	}
+	// rules of the same salience are selected in no particular order.
+	sort.Strings(result.Fired)
+	if dataContext.Journal != nil {
+		for _, change := range dataContext.Journal.Changes {
+			result.Changes = append(result.Changes, context.Change{
+				RuleName: change.RuleName,
+				Cycle:    change.Cycle,
+				Variable: change.Variable,
+				Path:     change.Path,
+				OldValue: change.OldValue,
+				NewValue: change.NewValue,
+				Added:    change.Added,
+			})
+		}
+	}
+	return result
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// differentialCases returns the example rule sets with the facts covering their paths.
+func differentialCases(t *testing.T) []*differentialCase {
+	cashFlowRule, err := ioutil.ReadFile("CashFlowRule.grl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := func() map[string]interface{} {
+		return map[string]interface{}{"User": &User{Name: "Calo", Male: true}, "Pogo": &MyPoGo{}}
+	}
+	account := func(name string, flags uint64) func() map[string]interface{} {
+		return func() map[string]interface{} {
+			return map[string]interface{}{"Account": &BitwiseAccount{Name: name, Flags: flags}}
+		}
+	}
+	stock := func(count int) func() map[string]interface{} {
+		return func() map[string]interface{} {
+			return map[string]interface{}{"Stock": &CheckedStock{Total: 100, Count: count, Capacity: 10}}
+		}
+	}
+	tax := func(member bool, amount int) func() map[string]interface{} {
+		return func() map[string]interface{} {
+			return map[string]interface{}{"Tax": &TaxCalc{Member: member, Amount: amount}}
+		}
+	}
+	invoice := func() map[string]interface{} {
+		return map[string]interface{}{"Invoice": &DecimalInvoice{Price: 10.25}}
+	}
+	order := func() map[string]interface{} {
+		return map[string]interface{}{"Order": &Order{Lines: make([]OrderLine, 3)}}
+	}
+	shipment := func(weight int, member bool) func() map[string]interface{} {
+		return func() map[string]interface{} {
+			return map[string]interface{}{"Shipment": &Shipment{Weight: weight, Member: member}}
+		}
+	}
+	purchase := func(p *MembershipPurchase) func() map[string]interface{} {
+		return func() map[string]interface{} {
+			copied := *p
+			return map[string]interface{}{"Purchase": &copied}
+		}
+	}
+	nullSafe := func(address *CustomerAddress) func() map[string]interface{} {
+		return func() map[string]interface{} {
+			return map[string]interface{}{"Customer": &NullSafeCustomer{Address: address}}
+		}
+	}
+	transfer := func() map[string]interface{} {
+		return map[string]interface{}{
+			"Regulation": &Regulation{MaxAmount: 100, Limits: []*RegulationLimit{{Amount: 100}}},
+			"Transfer":   &Transfer{Amount: 150, Country: "ID"},
+		}
+	}
+	taggedItem := func() map[string]interface{} {
+		return map[string]interface{}{"item": &TaggedItem{Price: 100, TaxRate: 0.5, Cost: 70}}
+	}
+	subscription := func(start time.Time) func() map[string]interface{} {
+		return func() map[string]interface{} {
+			return map[string]interface{}{"Subscription": &TimeSubscription{Start: start}}
+		}
+	}
+	cart := func(count int) func() map[string]interface{} {
+		return func() map[string]interface{} {
+			return map[string]interface{}{
+				"Cart":    &TransactionalCart{Total: 100, Shipping: 10, Count: count, Lines: []*TransactionalLine{{Price: 60}, {Price: 40}}},
+				"Payment": map[string]interface{}{"status": "NEW"},
+			}
+		}
+	}
+	itemCart := func() map[string]interface{} {
+		return map[string]interface{}{"Cart": &ItemCart{Items: []*Item{
+			{Name: "Honda", Price: 80},
+			{Name: "Bugatti", Price: 200},
+			{Name: "Mazda", Price: 110},
+		}}}
+	}
+
+	testData := []*differentialCase{
+		{name: "AgeCheck/GetStringLength", rules: rule2, engine: engine.Grool{MaxCycle: 1}, facts: user},
+		{name: "AgeCheck/Compare", rules: rule3, engine: engine.Grool{MaxCycle: 100}, facts: user},
+		{name: "Bitwise/Alice", rules: bitwiseRule, engine: engine.Grool{MaxCycle: 10}, facts: account("Alice", 1)},
+		{name: "Bitwise/Zed", rules: bitwiseRule, engine: engine.Grool{MaxCycle: 10}, facts: account("Zed", 8)},
+		{name: "ChangeJournal", rules: changeJournalRule, engine: engine.Grool{MaxCycle: 5, Transactional: true}, facts: func() map[string]interface{} {
+			return map[string]interface{}{
+				"Order":    &JournalOrder{Status: "NEW", Lines: []*JournalLine{{Price: 20}, {Price: 30}}},
+				"Shipment": map[string]interface{}{"note": "fragile"},
+			}
+		}},
+		{name: "CheckedMath/Valid", rules: checkedMathRule, engine: engine.Grool{MaxCycle: 5}, facts: stock(4)},
+		{name: "CheckedMath/DivisionByZero", rules: checkedMathRule, engine: engine.Grool{MaxCycle: 5}, facts: stock(0)},
+		{name: "CheckedMath/Strict", rules: checkedMathRule, engine: engine.Grool{MaxCycle: 5, StrictMath: true}, facts: stock(4)},
+		{name: "Conditional/MemberBig", rules: conditionalRule, engine: engine.Grool{MaxCycle: 5}, facts: tax(true, 200)},
+		{name: "Conditional/Member", rules: conditionalRule, engine: engine.Grool{MaxCycle: 5}, facts: tax(true, 50)},
+		{name: "Conditional/Normal", rules: conditionalRule, engine: engine.Grool{MaxCycle: 5}, facts: tax(false, 200)},
+		{name: "DecimalMode/Decimal", rules: decimalRule, engine: engine.Grool{MaxCycle: 5, DecimalMode: true}, facts: invoice},
+		{name: "DecimalMode/Float", rules: decimalRule, engine: engine.Grool{MaxCycle: 5}, facts: invoice},
+		{name: "DecimalMode/Assignment", rules: exactTaxRule, engine: engine.Grool{MaxCycle: 5, DecimalMode: true}, facts: invoice},
+		{name: "For/Cart", rules: forLoopRule, engine: engine.Grool{MaxCycle: 5}, facts: itemCart},
+		{name: "For/ValueSlice", rules: forLoopValueRule, engine: engine.Grool{MaxCycle: 5}, facts: order},
+		{name: "For/MaxLoopIteration", rules: forLoopValueRule, engine: engine.Grool{MaxCycle: 5, MaxLoopIteration: 2}, facts: order},
+		{name: "IfElse/HeavyMember", rules: ifElseRule, engine: engine.Grool{MaxCycle: 5}, facts: shipment(12, true)},
+		{name: "IfElse/Heavy", rules: ifElseRule, engine: engine.Grool{MaxCycle: 5}, facts: shipment(12, false)},
+		{name: "IfElse/Medium", rules: ifElseRule, engine: engine.Grool{MaxCycle: 5}, facts: shipment(7, true)},
+		{name: "IfElse/Light", rules: ifElseRule, engine: engine.Grool{MaxCycle: 5}, facts: shipment(2, false)},
+		{name: "Issue4", rules: Rule4, engine: engine.Grool{MaxCycle: 3}, facts: func() map[string]interface{} {
+			return map[string]interface{}{"User": &UserWithAuth{Auth: &UserAuth{Email: "watson@test.com"}}}
+		}},
+		{name: "Issue5", rules: Rule, engine: engine.Grool{MaxCycle: 5}, facts: func() map[string]interface{} {
+			return map[string]interface{}{"User": &AUser{Name: "Watson"}}
+		}},
+		{name: "Issue7", rules: Rule7, engine: engine.Grool{MaxCycle: 5}, facts: func() map[string]interface{} {
+			return map[string]interface{}{"User": &AUserIssue7{Name: "Watson", Age: 7}}
+		}},
+		{name: "ItemArray/Item", rules: PriceCheckRule, engine: engine.Grool{MaxCycle: 5}, facts: func() map[string]interface{} {
+			return map[string]interface{}{"Item": &Item{Name: "Bugatti", Price: 200}}
+		}},
+		{name: "ItemArray/Cart", rules: PriceCheckRule, engine: engine.Grool{MaxCycle: 5}, facts: itemCart},
+		{name: "Literal", rules: literalRule, engine: engine.Grool{MaxCycle: 5}, facts: func() map[string]interface{} {
+			return map[string]interface{}{"Profile": &LiteralProfile{}}
+		}},
+		{name: "Literal/UnknownField", rules: unknownFieldRule, engine: engine.Grool{MaxCycle: 5}, facts: func() map[string]interface{} {
+			return map[string]interface{}{"Profile": &LiteralProfile{}}
+		}},
+		{name: "MapFact", rules: mapFactRule, engine: engine.Grool{MaxCycle: 10}, facts: func() map[string]interface{} {
+			purchase := make(map[string]interface{})
+			if err := json.Unmarshal([]byte(mapFactPurchase), &purchase); err != nil {
+				t.Fatal(err)
+			}
+			return map[string]interface{}{
+				"Purchase": purchase,
+				"Order":    &MapFactOrder{Shipping: map[string]interface{}{"Address": map[string]interface{}{"City": "Jakarta"}}},
+			}
+		}},
+		{name: "Membership/Match", rules: membershipRule, engine: engine.Grool{MaxCycle: 10}, facts: purchase(&MembershipPurchase{
+			ItemType: "LUXURY", Category: 5, Tags: []string{"gift", "sale"},
+			Attributes: map[string]string{"wrap": "red"}, Note: "for birthday party", PromoCode: "PROMO-1234"})},
+		{name: "Membership/NoMatch", rules: membershipRule, engine: engine.Grool{MaxCycle: 10}, facts: purchase(&MembershipPurchase{
+			ItemType: "SPECIAL", Category: 2, Tags: []string{"sale"},
+			Attributes: map[string]string{"wrap": "red"}, Note: "for birthday party", PromoCode: "PROMO-12345"})},
+		{name: "MethodChaining", rules: methodChainingRule, engine: engine.Grool{MaxCycle: 5}, facts: func() map[string]interface{} {
+			return map[string]interface{}{"Customer": &ChainCustomer{Address: &ChainAddress{City: "Jakarta"}, Period: 24 * time.Hour}}
+		}},
+		{name: "NullSafe/Address", rules: nullSafeRule, engine: engine.Grool{MaxCycle: 5}, facts: nullSafe(&CustomerAddress{City: "Jakarta"})},
+		{name: "NullSafe/NoAddress", rules: nullSafeRule, engine: engine.Grool{MaxCycle: 5}, facts: nullSafe(nil)},
+		{name: "ReadOnly/Valid", rules: readOnlyValidRule, engine: engine.Grool{MaxCycle: 5}, readOnly: []string{"Regulation"}, facts: transfer},
+		{name: "ReadOnly/Fact", rules: readOnlyFactRule, engine: engine.Grool{MaxCycle: 5}, readOnly: []string{"Regulation"}, facts: transfer},
+		{name: "ReadOnly/Loop", rules: readOnlyLoopRule, engine: engine.Grool{MaxCycle: 5}, readOnly: []string{"Regulation"}, facts: transfer},
+		{name: "ReadOnly/Field", rules: readOnlyFieldRule, engine: engine.Grool{MaxCycle: 5}, readOnly: []string{"Regulation"}, facts: transfer},
+		{name: "StructTag/Grool", rules: structTagRule, engine: engine.Grool{MaxCycle: 5}, facts: taggedItem},
+		{name: "StructTag/JSON", rules: jsonTagRule, engine: engine.Grool{MaxCycle: 5}, jsonTags: true, facts: taggedItem},
+		{name: "StructTag/Hidden", rules: hiddenFieldRule, engine: engine.Grool{MaxCycle: 5}, facts: taggedItem},
+		{name: "TimeLiteral/January", rules: timeLiteralRule, engine: engine.Grool{MaxCycle: 5}, facts: subscription(time.Date(2019, 1, 15, 10, 0, 0, 0, time.Local))},
+		{name: "TimeLiteral/NewYear", rules: timeLiteralRule, engine: engine.Grool{MaxCycle: 5}, facts: subscription(time.Date(2019, 1, 1, 0, 0, 0, 0, time.Local))},
+		{name: "TimeLiteral/February", rules: timeLiteralRule, engine: engine.Grool{MaxCycle: 5}, facts: subscription(time.Date(2019, 2, 1, 0, 0, 0, 0, time.Local))},
+		{name: "Transactional/Commit", rules: transactionalRule, engine: engine.Grool{MaxCycle: 5, Transactional: true}, facts: cart(2)},
+		{name: "Transactional/Rollback", rules: transactionalRule, engine: engine.Grool{MaxCycle: 5, Transactional: true}, facts: cart(0)},
+	}
+	for i, p := range Purchases {
+		p := *p
+		testData = append(testData, &differentialCase{name: "CashFlow/" + string(rune('A'+i)), rules: string(cashFlowRule), engine: engine.Grool{MaxCycle: 5000},
+			facts: func() map[string]interface{} {
+				copied := p
+				return map[string]interface{}{"CashFlow": &CashFlow{}, "Purchase": &copied}
+			}})
+	}
+	return testData
+}
+
+func TestBytecode_Differential(t *testing.T) {
+	for _, tc := range differentialCases(t) {
+		t.Run(tc.name, func(t *testing.T) {
+			interpreted := tc.run(t, false, false, false)
+			compiled := tc.run(t, true, false, false)
+			if !reflect.DeepEqual(interpreted, compiled) {
+				t.Errorf("bytecode execution differs\ninterpreted %+v\ncompiled    %+v", interpreted, compiled)
+			}
+			typed := tc.run(t, true, false, true)
+			if !reflect.DeepEqual(interpreted, typed) {
+				t.Errorf("typed bytecode execution differs\ninterpreted %+v\ntyped       %+v", interpreted, typed)
+			}
+		})
+	}
+}
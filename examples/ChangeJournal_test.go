@@ -0,0 +1,102 @@
+package examples
+
+import (
+	"encoding/json"
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"testing"
+)
+
+const (
+	changeJournalRule = `
+rule Discount "Discount every line" salience 10 {
+	when
+		Order.Discounted == false
+	then
+		for line in Order.Lines {
+			line.Price = line.Price - 5;
+		}
+		Order.Discounted = true;
+}
+
+rule Ship "Ship the discounted order" {
+	when
+		Order.Discounted == true && Order.Status == "NEW"
+	then
+		Order.Status = "PENDING";
+		Order.Status = "SHIPPED";
+		Shipment.carrier = "JNE";
+		Shipment.note = Shipment.note;
+}
+`
+)
+
+type JournalLine struct {
+	Price int
+}
+
+type JournalOrder struct {
+	Discounted bool
+	Status     string
+	Lines      []*JournalLine
+}
+
+func TestChangeJournal(t *testing.T) {
+	order := &JournalOrder{Status: "NEW", Lines: []*JournalLine{{Price: 20}, {Price: 30}}}
+	shipment := map[string]interface{}{"note": "fragile"}
+	dataContext := context.NewDataContext()
+	if err := dataContext.Add("Order", order); err != nil {
+		t.Fatal(err)
+	}
+	if err := dataContext.Add("Shipment", shipment); err != nil {
+		t.Fatal(err)
+	}
+	dataContext.Journal = context.NewJournal()
+
+	knowledgeBase := model.NewKnowledgeBase()
+	if err := builder.NewRuleBuilder(knowledgeBase).BuildRuleFromResource(pkg.NewBytesResource([]byte(changeJournalRule))); err != nil {
+		t.Fatal(err)
+	}
+	eng := &engine.Grool{MaxCycle: 5}
+	if err := eng.Execute(dataContext, knowledgeBase); err != nil {
+		t.Fatal(err)
+	}
+
+	changes := dataContext.Journal.Changes
+	if len(changes) != 7 {
+		t.Fatalf("expect 7 changes but %d", len(changes))
+	}
+	line := changes[1]
+	if line.RuleName != "Discount" || line.Cycle != 1 || line.Variable != "line.Price" || line.Path != "Order.Lines[1].Price" ||
+		line.OldValue != 30 || line.NewValue != 25 {
+		t.Errorf("unexpected change %+v", line)
+	}
+	if status := changes[3]; status.RuleName != "Ship" || status.Cycle != 2 {
+		t.Errorf("unexpected change %+v", status)
+	}
+
+	diffs := dataContext.Journal.Diff()
+	if len(diffs) != 2 || diffs[0].Fact != "Order" || diffs[1].Fact != "Shipment" {
+		t.Fatalf("unexpected diff %+v", diffs)
+	}
+	patches := make(map[string][]*context.PatchOperation)
+	for _, diff := range diffs {
+		patches[diff.Fact] = diff.JSONPatch()
+	}
+	patch, err := json.Marshal(patches)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := `{"Order":[{"op":"replace","path":"/Lines/0/Price","value":15},{"op":"replace","path":"/Lines/1/Price","value":25},` +
+		`{"op":"replace","path":"/Discounted","value":true},{"op":"replace","path":"/Status","value":"SHIPPED"}],` +
+		`"Shipment":[{"op":"add","path":"/carrier","value":"JNE"}]}`
+	if string(patch) != expect {
+		t.Errorf("expect\n%s\nbut\n%s", expect, patch)
+	}
+	if status := diffs[0].Changes[3]; status.OldValue != "NEW" || status.NewValue != "SHIPPED" {
+		t.Errorf("unexpected status diff %+v", status)
+	}
+}
@@ -0,0 +1,75 @@
+package examples
+
+import (
+	"github.com/juju/errors"
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"testing"
+)
+
+const (
+	checkedMathRule = `
+rule ComputeAverage "Compute average price per item" {
+	when
+		Stock.Computed == false
+	then
+		Stock.Average = Stock.Total / Stock.Count;
+		Stock.Remaining = Stock.Capacity - Stock.Count;
+		Stock.Computed = true;
+}
+`
+)
+
+type CheckedStock struct {
+	Total     int
+	Count     int
+	Capacity  uint
+	Average   int
+	Remaining int
+	Computed  bool
+}
+
+func TestCheckedMath(t *testing.T) {
+	testData := []struct {
+		stock  *CheckedStock
+		strict bool
+		reason string
+	}{
+		{stock: &CheckedStock{Total: 100, Count: 4, Capacity: 10}, strict: false, reason: ""},
+		{stock: &CheckedStock{Total: 100, Count: 0, Capacity: 10}, strict: false, reason: pkg.ReasonDivisionByZero},
+		{stock: &CheckedStock{Total: 100, Count: 4, Capacity: 10}, strict: true, reason: pkg.ReasonSignedness},
+	}
+	for _, td := range testData {
+		dataContext := context.NewDataContext()
+		err := dataContext.Add("Stock", td.stock)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		knowledgeBase := model.NewKnowledgeBase()
+		ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+		err = ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(checkedMathRule)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		eng := &engine.Grool{MaxCycle: 5, StrictMath: td.strict}
+		err = eng.Execute(dataContext, knowledgeBase)
+		if td.reason == "" {
+			if err != nil {
+				t.Fatal(err)
+			}
+			if td.stock.Average != 25 || td.stock.Remaining != 6 {
+				t.Errorf("average should be 25 and remaining 6 but %d and %d", td.stock.Average, td.stock.Remaining)
+			}
+			continue
+		}
+		aerr, ok := errors.Cause(err).(*pkg.ArithmeticError)
+		if !ok || aerr.Reason != td.reason {
+			t.Errorf("execution should fail with %s but %v", td.reason, err)
+		}
+	}
+}
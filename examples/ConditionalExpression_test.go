@@ -0,0 +1,96 @@
+package examples
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"testing"
+)
+
+const (
+	conditionalRule = `
+rule ChooseRate "Choose the tax rate by membership" {
+	when
+		Tax.Rate == 0
+	then
+		Tax.Rate = Tax.Member ? Tax.MemberRate() : Tax.NormalRate();
+		Tax.Label = Tax.Member && Tax.Amount > 100 ? "MEMBER-BIG" : Tax.Member ? "MEMBER" : "NORMAL";
+		Tax.SetNote(Tax.Amount > 100 ? "big" : "small");
+}
+`
+)
+
+type TaxCalc struct {
+	Member      bool
+	Amount      int
+	Rate        float64
+	Label       string
+	Note        string
+	RateCounter int
+}
+
+func (tc *TaxCalc) MemberRate() float64 {
+	tc.RateCounter++
+	return 0.05
+}
+
+func (tc *TaxCalc) NormalRate() float64 {
+	tc.RateCounter++
+	return 0.1
+}
+
+func (tc *TaxCalc) SetNote(note string) {
+	tc.Note = note
+}
+
+func TestConditionalExpression(t *testing.T) {
+	testData := []struct {
+		member bool
+		amount int
+		rate   float64
+		label  string
+		note   string
+	}{
+		{member: true, amount: 200, rate: 0.05, label: "MEMBER-BIG", note: "big"},
+		{member: true, amount: 50, rate: 0.05, label: "MEMBER", note: "small"},
+		{member: false, amount: 200, rate: 0.1, label: "NORMAL", note: "big"},
+	}
+	for _, td := range testData {
+		tax := &TaxCalc{
+			Member: td.member,
+			Amount: td.amount,
+		}
+		dataContext := context.NewDataContext()
+		err := dataContext.Add("Tax", tax)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		knowledgeBase := model.NewKnowledgeBase()
+		ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+		err = ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(conditionalRule)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		eng := &engine.Grool{MaxCycle: 5}
+		err = eng.Execute(dataContext, knowledgeBase)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tax.Rate != td.rate {
+			t.Errorf("expect rate %f but %f", td.rate, tax.Rate)
+		}
+		if tax.Label != td.label {
+			t.Errorf("expect label %s but %s", td.label, tax.Label)
+		}
+		if tax.Note != td.note {
+			t.Errorf("expect note %s but %s", td.note, tax.Note)
+		}
+		if tax.RateCounter != 1 {
+			t.Errorf("only the chosen branch should be evaluated, but evaluated %d times", tax.RateCounter)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package examples
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"math/big"
+	"testing"
+)
+
+const (
+	decimalRule = `
+rule ComputeTax "Compute tax of the purchase" {
+	when
+		Invoice.Computed == false
+	then
+		Invoice.Exact = 0.1 + 0.2 == 0.3;
+		Invoice.Tax = Round(Invoice.Price * 0.1, 2, "HALF_UP");
+		Invoice.Computed = true;
+}
+`
+	exactTaxRule = `
+rule ComputeExactTax "Compute exact tax of the purchase" {
+	when
+		Invoice.ExactTax == null
+	then
+		Invoice.ExactTax = Invoice.Price * 0.1;
+		Invoice.Quantity = Invoice.Price * 0.1;
+}
+`
+)
+
+type DecimalInvoice struct {
+	Price    float64
+	Tax      float64
+	ExactTax *big.Rat
+	Quantity int
+	Exact    bool
+	Computed bool
+}
+
+func TestDecimalMode(t *testing.T) {
+	testData := []struct {
+		decimalMode bool
+		exact       bool
+		tax         float64
+	}{
+		{decimalMode: true, exact: true, tax: 1.03},
+		{decimalMode: false, exact: false, tax: 1.03},
+	}
+	for _, td := range testData {
+		invoice := &DecimalInvoice{Price: 10.25}
+		dataContext := context.NewDataContext()
+		err := dataContext.Add("Invoice", invoice)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		knowledgeBase := model.NewKnowledgeBase()
+		ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+		err = ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(decimalRule)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		eng := &engine.Grool{MaxCycle: 5, DecimalMode: td.decimalMode}
+		err = eng.Execute(dataContext, knowledgeBase)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if invoice.Exact != td.exact {
+			t.Errorf("decimal mode %v, 0.1 + 0.2 == 0.3 should be %v", td.decimalMode, td.exact)
+		}
+		if invoice.Tax != td.tax {
+			t.Errorf("decimal mode %v, tax should be %v but %v", td.decimalMode, td.tax, invoice.Tax)
+		}
+	}
+}
+
+func TestDecimalMode_Assignment(t *testing.T) {
+	invoice := &DecimalInvoice{Price: 10.25}
+	dataContext := context.NewDataContext()
+	err := dataContext.Add("Invoice", invoice)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	knowledgeBase := model.NewKnowledgeBase()
+	ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+	err = ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(exactTaxRule)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eng := &engine.Grool{MaxCycle: 5, DecimalMode: true}
+	err = eng.Execute(dataContext, knowledgeBase)
+	if err == nil {
+		t.Errorf("decimal with fraction should not be assignable into integer")
+	}
+	if invoice.ExactTax == nil || invoice.ExactTax.RatString() != "41/40" {
+		t.Errorf("exact tax should be 41/40 but %v", invoice.ExactTax)
+	}
+}
+
+func TestDecimalMode_RoundNegativePlaces(t *testing.T) {
+	rule := `
+rule RoundHundreds "Round into hundreds" {
+	when
+		Invoice.Computed == false
+	then
+		Invoice.Tax = Round(Invoice.Price * 100, -2, "HALF_UP");
+		Invoice.Quantity = Round(1250, -2, "HALF_EVEN");
+		Invoice.Computed = true;
+}
+`
+	for _, decimalMode := range []bool{true, false} {
+		invoice := &DecimalInvoice{Price: 12.5}
+		dataContext := context.NewDataContext()
+		err := dataContext.Add("Invoice", invoice)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		knowledgeBase := model.NewKnowledgeBase()
+		ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+		err = ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(rule)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		eng := &engine.Grool{MaxCycle: 5, DecimalMode: decimalMode}
+		err = eng.Execute(dataContext, knowledgeBase)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if invoice.Tax != 1300 || invoice.Quantity != 1200 {
+			t.Errorf("decimal mode %v, expect 1300 and 1200 but %v and %v", decimalMode, invoice.Tax, invoice.Quantity)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package examples
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"testing"
+)
+
+const (
+	forLoopRule = `
+rule DiscountCartItems "Give discount to every expensive item in the cart" {
+	when
+		Cart.CountItemWithPriceAboveWithNoDiscount(100) > 0
+	then
+		for item in Cart.Items {
+			if (item.Price > 100) {
+				item.Discount = 10;
+			}
+		}
+}
+`
+	forLoopValueRule = `
+rule MarkLines "Mark every order line as processed" {
+	when
+		Order.Processed == false
+	then
+		for line in Order.Lines {
+			line.Processed = true;
+		}
+		Order.Processed = true;
+}
+`
+)
+
+type OrderLine struct {
+	Processed bool
+}
+
+type Order struct {
+	Processed bool
+	Lines     []OrderLine
+}
+
+func TestForStatement(t *testing.T) {
+	cart := &ItemCart{Items: []*Item{
+		{Name: "Honda", Price: 80},
+		{Name: "Bugatti", Price: 200},
+		{Name: "Mazda", Price: 110},
+	}}
+	dataContext := context.NewDataContext()
+	err := dataContext.Add("Cart", cart)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	knowledgeBase := model.NewKnowledgeBase()
+	ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+	err = ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(forLoopRule)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eng := &engine.Grool{MaxCycle: 5}
+	err = eng.Execute(dataContext, knowledgeBase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, item := range cart.Items {
+		if item.Price > 100 && item.Discount != 10 {
+			t.Errorf("%s should get 10 discount but %d", item.Name, item.Discount)
+		}
+		if item.Price <= 100 && item.Discount != 0 {
+			t.Errorf("%s should get no discount but %d", item.Name, item.Discount)
+		}
+	}
+}
+
+func TestForStatement_ValueSlice(t *testing.T) {
+	order := &Order{Lines: make([]OrderLine, 3)}
+	dataContext := context.NewDataContext()
+	err := dataContext.Add("Order", order)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	knowledgeBase := model.NewKnowledgeBase()
+	ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+	err = ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(forLoopValueRule)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eng := &engine.Grool{MaxCycle: 5}
+	err = eng.Execute(dataContext, knowledgeBase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, line := range order.Lines {
+		if !line.Processed {
+			t.Errorf("line #%d should be processed", i)
+		}
+	}
+}
+
+func TestForStatement_MaxLoopIteration(t *testing.T) {
+	order := &Order{Lines: make([]OrderLine, 3)}
+	dataContext := context.NewDataContext()
+	err := dataContext.Add("Order", order)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	knowledgeBase := model.NewKnowledgeBase()
+	ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+	err = ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(forLoopValueRule)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eng := &engine.Grool{MaxCycle: 5, MaxLoopIteration: 2}
+	err = eng.Execute(dataContext, knowledgeBase)
+	if err == nil {
+		t.Fatal("loop with more items than MaxLoopIteration should fail")
+	}
+	if order.Processed {
+		t.Errorf("order should not be processed")
+	}
+}
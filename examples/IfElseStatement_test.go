@@ -0,0 +1,78 @@
+package examples
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"testing"
+)
+
+const (
+	ifElseRule = `
+rule ShippingCost "Decide the shipping cost by weight and member status" {
+	when
+		Shipment.Cost == 0
+	then
+		if (Shipment.Weight > 10) {
+			if (Shipment.Member == true) {
+				Shipment.Cost = 15;
+			} else {
+				Shipment.Cost = 20;
+			}
+		} else if (Shipment.Weight > 5) {
+			Shipment.Cost = 10;
+		} else {
+			Shipment.Cost = 5;
+		}
+		Retract("ShippingCost");
+}
+`
+)
+
+type Shipment struct {
+	Weight int
+	Member bool
+	Cost   int
+}
+
+func TestIfElseStatement(t *testing.T) {
+	testData := []struct {
+		weight int
+		member bool
+		cost   int
+	}{
+		{weight: 12, member: true, cost: 15},
+		{weight: 12, member: false, cost: 20},
+		{weight: 7, member: true, cost: 10},
+		{weight: 2, member: false, cost: 5},
+	}
+	for _, td := range testData {
+		shipment := &Shipment{
+			Weight: td.weight,
+			Member: td.member,
+		}
+		dataContext := context.NewDataContext()
+		err := dataContext.Add("Shipment", shipment)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		knowledgeBase := model.NewKnowledgeBase()
+		ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+		err = ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(ifElseRule)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		eng := &engine.Grool{MaxCycle: 5}
+		err = eng.Execute(dataContext, knowledgeBase)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if shipment.Cost != td.cost {
+			t.Errorf("weight %d member %v should cost %d but %d", td.weight, td.member, td.cost, shipment.Cost)
+		}
+	}
+}
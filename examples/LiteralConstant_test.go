@@ -0,0 +1,117 @@
+package examples
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"strings"
+	"testing"
+)
+
+const (
+	literalRule = `
+rule SetupProfile "Setup a new profile using literals" {
+	when
+		Profile.Ready == false
+	then
+		Profile.Tags = ["new", "unverified"];
+		Profile.Scores = [10, 20, 30];
+		Profile.Limits = {"daily": 100, "monthly": 2000};
+		Profile.Address = {Street: "Main Street", Number: 12};
+		Profile.Summary = Profile.Join([1, 2, 3]);
+		Profile.Ready = true;
+}
+`
+	unknownFieldRule = `
+rule InvalidAddress "Address with unknown field" {
+	when
+		Profile.Ready == false
+	then
+		Profile.Address = {City: "Jakarta"};
+		Profile.Ready = true;
+}
+`
+)
+
+type LiteralAddress struct {
+	Street string
+	Number int
+}
+
+type LiteralProfile struct {
+	Ready   bool
+	Tags    []string
+	Scores  []int
+	Limits  map[string]int
+	Address *LiteralAddress
+	Summary string
+}
+
+func (p *LiteralProfile) Join(numbers []int) string {
+	strs := make([]string, len(numbers))
+	for i, n := range numbers {
+		strs[i] = string(rune('0' + n))
+	}
+	return strings.Join(strs, "-")
+}
+
+func TestLiteralConstant(t *testing.T) {
+	profile := &LiteralProfile{}
+	dataContext := context.NewDataContext()
+	err := dataContext.Add("Profile", profile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	knowledgeBase := model.NewKnowledgeBase()
+	ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+	err = ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(literalRule)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eng := &engine.Grool{MaxCycle: 5}
+	err = eng.Execute(dataContext, knowledgeBase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(profile.Tags) != 2 || profile.Tags[0] != "new" || profile.Tags[1] != "unverified" {
+		t.Errorf("tags not set correctly %v", profile.Tags)
+	}
+	if len(profile.Scores) != 3 || profile.Scores[2] != 30 {
+		t.Errorf("scores not set correctly %v", profile.Scores)
+	}
+	if profile.Limits["daily"] != 100 || profile.Limits["monthly"] != 2000 {
+		t.Errorf("limits not set correctly %v", profile.Limits)
+	}
+	if profile.Address == nil || profile.Address.Street != "Main Street" || profile.Address.Number != 12 {
+		t.Errorf("address not set correctly %v", profile.Address)
+	}
+	if profile.Summary != "1-2-3" {
+		t.Errorf("summary should be 1-2-3 but %s", profile.Summary)
+	}
+}
+
+func TestLiteralConstant_UnknownField(t *testing.T) {
+	profile := &LiteralProfile{}
+	dataContext := context.NewDataContext()
+	err := dataContext.Add("Profile", profile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	knowledgeBase := model.NewKnowledgeBase()
+	ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+	err = ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(unknownFieldRule)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eng := &engine.Grool{MaxCycle: 5}
+	err = eng.Execute(dataContext, knowledgeBase)
+	if err == nil {
+		t.Errorf("assigning unknown struct field should fail")
+	}
+}
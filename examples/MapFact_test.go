@@ -0,0 +1,109 @@
+package examples
+
+import (
+	"encoding/json"
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"testing"
+)
+
+const (
+	mapFactRule = `
+rule MemberDiscount "Members with big purchase get discount" salience 10 {
+	when
+		Purchase.Customer.Member == true && Purchase.Total > 100 && Purchase.Discount == 0
+	then
+		Purchase.Discount = Purchase.Total * 0.1;
+		Purchase.Customer.Level = "GOLD";
+}
+
+rule ItemTax "Tax every item once" {
+	when
+		Purchase.Taxed == false
+	then
+		for item in Purchase.Items {
+			item.Tax = item.Price * 0.2;
+		}
+		Purchase.Taxed = true;
+}
+
+rule ShippingCity "Copy the shipping city into the order" {
+	when
+		Order.City == ""
+	then
+		Order.City = Order.Shipping.Address?.City ?? "UNKNOWN";
+}
+`
+	mapFactPurchase = `{
+	"Total": 150,
+	"Discount": 0,
+	"Taxed": false,
+	"Customer": {"Name": "Ann", "Member": true},
+	"Items": [{"Price": 10}, {"Price": 20}]
+}`
+)
+
+type MapFactOrder struct {
+	City     string
+	Shipping map[string]interface{}
+}
+
+func TestMapFact(t *testing.T) {
+	purchase := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(mapFactPurchase), &purchase); err != nil {
+		t.Fatal(err)
+	}
+	order := &MapFactOrder{Shipping: map[string]interface{}{"Address": map[string]interface{}{"City": "Jakarta"}}}
+
+	dataContext := context.NewDataContext()
+	if err := dataContext.Add("Purchase", purchase); err != nil {
+		t.Fatal(err)
+	}
+	if err := dataContext.Add("Order", order); err != nil {
+		t.Fatal(err)
+	}
+
+	knowledgeBase := model.NewKnowledgeBase()
+	ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+	if err := ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(mapFactRule))); err != nil {
+		t.Fatal(err)
+	}
+	eng := &engine.Grool{MaxCycle: 10}
+	if err := eng.Execute(dataContext, knowledgeBase); err != nil {
+		t.Fatal(err)
+	}
+
+	if purchase["Discount"] != float64(15) {
+		t.Errorf("expect discount 15 but %v", purchase["Discount"])
+	}
+	customer := purchase["Customer"].(map[string]interface{})
+	if customer["Level"] != "GOLD" {
+		t.Errorf("expect level GOLD but %v", customer["Level"])
+	}
+	for i, tax := range []float64{2, 4} {
+		item := purchase["Items"].([]interface{})[i].(map[string]interface{})
+		if item["Tax"] != tax {
+			t.Errorf("expect item %d tax %v but %v", i, tax, item["Tax"])
+		}
+	}
+	if order.City != "Jakarta" {
+		t.Errorf("expect city Jakarta but %s", order.City)
+	}
+
+	typ, err := dataContext.GetType("Purchase.Customer.Name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if typ.String() != "string" {
+		t.Errorf("expect string type but %s", typ)
+	}
+	if _, err := dataContext.GetValue("Purchase.Customer.Age"); err == nil {
+		t.Error("expect error getting missing key")
+	}
+	if err := dataContext.Add("Nil", map[string]interface{}(nil)); err == nil {
+		t.Error("expect error adding nil map")
+	}
+}
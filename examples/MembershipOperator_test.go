@@ -0,0 +1,124 @@
+package examples
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"testing"
+)
+
+const (
+	membershipRule = `
+rule CheckItemType "Regular item type" {
+	when
+		Purchase.ItemType in ("NORMAL", "LUXURY") && Purchase.Regular == false
+	then
+		Purchase.Regular = true;
+}
+
+rule CheckCategory "Category is not restricted" {
+	when
+		Purchase.Category not in (1, 2, 3) && Purchase.Allowed == false
+	then
+		Purchase.Allowed = true;
+}
+
+rule CheckTag "Purchase is a gift" {
+	when
+		Purchase.Tags contains "gift" && Purchase.Attributes contains "wrap" && Purchase.Note contains "birthday" && Purchase.Gift == false
+	then
+		Purchase.Gift = true;
+}
+
+rule CheckCode "Promo code is valid" {
+	when
+		Purchase.PromoCode matches "^PROMO-[0-9]{4}$" && Purchase.ValidPromo == false
+	then
+		Purchase.ValidPromo = true;
+}
+`
+)
+
+type MembershipPurchase struct {
+	ItemType   string
+	Category   int
+	Tags       []string
+	Attributes map[string]string
+	Note       string
+	PromoCode  string
+	Regular    bool
+	Allowed    bool
+	Gift       bool
+	ValidPromo bool
+}
+
+func TestMembershipOperator(t *testing.T) {
+	testData := []struct {
+		purchase *MembershipPurchase
+		regular  bool
+		allowed  bool
+		gift     bool
+		promo    bool
+	}{
+		{
+			purchase: &MembershipPurchase{ItemType: "LUXURY", Category: 5, Tags: []string{"gift", "sale"},
+				Attributes: map[string]string{"wrap": "red"}, Note: "for birthday party", PromoCode: "PROMO-1234"},
+			regular: true, allowed: true, gift: true, promo: true,
+		},
+		{
+			purchase: &MembershipPurchase{ItemType: "SPECIAL", Category: 2, Tags: []string{"sale"},
+				Attributes: map[string]string{"wrap": "red"}, Note: "for birthday party", PromoCode: "PROMO-12345"},
+			regular: false, allowed: false, gift: false, promo: false,
+		},
+	}
+	for _, td := range testData {
+		dataContext := context.NewDataContext()
+		err := dataContext.Add("Purchase", td.purchase)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		knowledgeBase := model.NewKnowledgeBase()
+		ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+		err = ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(membershipRule)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		eng := &engine.Grool{MaxCycle: 10}
+		err = eng.Execute(dataContext, knowledgeBase)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if td.purchase.Regular != td.regular {
+			t.Errorf("%s regular should be %v", td.purchase.ItemType, td.regular)
+		}
+		if td.purchase.Allowed != td.allowed {
+			t.Errorf("category %d allowed should be %v", td.purchase.Category, td.allowed)
+		}
+		if td.purchase.Gift != td.gift {
+			t.Errorf("gift should be %v", td.gift)
+		}
+		if td.purchase.ValidPromo != td.promo {
+			t.Errorf("promo code %s valid should be %v", td.purchase.PromoCode, td.promo)
+		}
+	}
+}
+
+func TestMembershipOperator_InvalidPattern(t *testing.T) {
+	knowledgeBase := model.NewKnowledgeBase()
+	ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+	err := ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(`
+rule InvalidPattern "Invalid regular expression" {
+	when
+		Purchase.PromoCode matches "PROMO-[0-9"
+	then
+		Purchase.ValidPromo = true;
+}
+`)))
+	if err == nil {
+		t.Errorf("invalid regular expression should fail the build")
+	}
+}
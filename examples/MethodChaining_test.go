@@ -0,0 +1,112 @@
+package examples
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"testing"
+	"time"
+)
+
+const (
+	methodChainingRule = `
+rule JakartaCustomer "Label customer living in Jakarta" {
+	when
+		Customer.GetAddress().City == "Jakarta" && Customer.Label == ""
+	then
+		Customer.Label = Customer.GetAddress().Label("ID-");
+		Customer.Expiry = MakeTime(2019, 1, 1, 0, 0, 0).Add(Customer.Period);
+		Customer.BillingCity = Customer.GetBilling()?.City ?? "NONE";
+		Customer.GetAddress().Location.SetZip("10110");
+}
+`
+)
+
+type ChainLocation struct {
+	Zip string
+}
+
+func (l *ChainLocation) SetZip(zip string) {
+	l.Zip = zip
+}
+
+type ChainAddress struct {
+	City     string
+	Location ChainLocation
+}
+
+func (a *ChainAddress) Label(prefix string) string {
+	return prefix + a.City
+}
+
+type ChainCustomer struct {
+	Address     *ChainAddress
+	Billing     *ChainAddress
+	Period      time.Duration
+	Label       string
+	BillingCity string
+	Expiry      time.Time
+}
+
+func (c *ChainCustomer) GetAddress() *ChainAddress {
+	return c.Address
+}
+
+func (c *ChainCustomer) GetBilling() *ChainAddress {
+	return c.Billing
+}
+
+func TestMethodChaining(t *testing.T) {
+	customer := &ChainCustomer{
+		Address: &ChainAddress{City: "Jakarta"},
+		Period:  24 * time.Hour,
+	}
+	dataContext := context.NewDataContext()
+	err := dataContext.Add("Customer", customer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	knowledgeBase := model.NewKnowledgeBase()
+	ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+	err = ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(methodChainingRule)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eng := &engine.Grool{MaxCycle: 5}
+	err = eng.Execute(dataContext, knowledgeBase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if customer.Label != "ID-Jakarta" {
+		t.Errorf("label should be ID-Jakarta but %s", customer.Label)
+	}
+	if !customer.Expiry.Equal(time.Date(2019, 1, 2, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("expiry should be a day after new year but %v", customer.Expiry)
+	}
+	if customer.BillingCity != "NONE" {
+		t.Errorf("billing city should be NONE but %s", customer.BillingCity)
+	}
+	if customer.Address.Location.Zip != "10110" {
+		t.Errorf("zip should be set through chained call but %s", customer.Address.Location.Zip)
+	}
+}
+
+func TestMethodChaining_MemberStatement(t *testing.T) {
+	knowledgeBase := model.NewKnowledgeBase()
+	ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+	err := ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(`
+rule UnusedMember "Accessing member as a statement" {
+	when
+		Customer.Label == ""
+	then
+		Customer.GetAddress().City;
+}
+`)))
+	if err == nil {
+		t.Errorf("member access should not be a statement")
+	}
+}
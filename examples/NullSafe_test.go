@@ -0,0 +1,75 @@
+package examples
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"testing"
+)
+
+const (
+	nullSafeRule = `
+rule CustomerCity "Copy the customer city from the address" {
+	when
+		Customer.City == ""
+	then
+		Customer.City = Customer.Address?.City ?? "UNKNOWN";
+}
+
+rule CustomerWithoutAddress "Flag customer without address" {
+	when
+		Customer.Address == null && Customer.NoAddress == false
+	then
+		Customer.NoAddress = true;
+}
+`
+)
+
+type CustomerAddress struct {
+	City string
+}
+
+type NullSafeCustomer struct {
+	Address   *CustomerAddress
+	City      string
+	NoAddress bool
+}
+
+func TestNullSafe(t *testing.T) {
+	testData := []struct {
+		customer  *NullSafeCustomer
+		city      string
+		noAddress bool
+	}{
+		{customer: &NullSafeCustomer{Address: &CustomerAddress{City: "Jakarta"}}, city: "Jakarta", noAddress: false},
+		{customer: &NullSafeCustomer{}, city: "UNKNOWN", noAddress: true},
+	}
+	for _, td := range testData {
+		dataContext := context.NewDataContext()
+		err := dataContext.Add("Customer", td.customer)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		knowledgeBase := model.NewKnowledgeBase()
+		ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+		err = ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(nullSafeRule)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		eng := &engine.Grool{MaxCycle: 5}
+		err = eng.Execute(dataContext, knowledgeBase)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if td.customer.City != td.city {
+			t.Errorf("expect city %s but %s", td.city, td.customer.City)
+		}
+		if td.customer.NoAddress != td.noAddress {
+			t.Errorf("expect no address %v but %v", td.noAddress, td.customer.NoAddress)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package examples
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"reflect"
+	"testing"
+)
+
+const (
+	optimizeRule = `
+rule Discount "Apply the discount of the purchase" {
+	when
+		Purchase.Member != false && true && Purchase.Discount == 0
+	then
+		Purchase.Total = Purchase.Price * (1 + 0.15);
+		Purchase.Year = GetTimeYear(MakeTime(2019, 1, 1, 0, 0, 0));
+		Purchase.Exact = 0.1 + 0.2 == 0.3;
+		Purchase.Label = 10 > 20 ? "big" : "small" + "-" + "member";
+		if (2 * 3 == 6) {
+			Purchase.Discount = 100 / (2 + 3);
+		} else {
+			Purchase.Discount = 1;
+		}
+}
+`
+	optimizeErrorRule = `
+rule Broken "Division by zero is left to the execution" {
+	when
+		Purchase.Discount == 0
+	then
+		Purchase.Discount = 10 / (5 - 5);
+}
+`
+)
+
+type OptimizedPurchase struct {
+	Price    float64
+	Total    float64
+	Year     int
+	Exact    bool
+	Label    string
+	Member   bool
+	Discount int
+}
+
+func executeOptimized(t *testing.T, rule string, eng *engine.Grool, optimize bool) (*OptimizedPurchase, *model.KnowledgeBase, error) {
+	knowledgeBase := model.NewKnowledgeBase()
+	if err := knowledgeBase.DeclareFact("Purchase", &OptimizedPurchase{}); err != nil {
+		t.Fatal(err)
+	}
+	ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+	ruleBuilder.Optimize = optimize
+	if err := ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(rule))); err != nil {
+		t.Fatal(err)
+	}
+	purchase := &OptimizedPurchase{Price: 100, Member: true}
+	dataContext := context.NewDataContext()
+	if err := dataContext.Add("Purchase", purchase); err != nil {
+		t.Fatal(err)
+	}
+	return purchase, knowledgeBase, eng.Execute(dataContext, knowledgeBase)
+}
+
+func TestOptimize(t *testing.T) {
+	testData := []struct {
+		name   string
+		engine engine.Grool
+		exact  bool
+	}{
+		{name: "Float", engine: engine.Grool{MaxCycle: 5}, exact: false},
+		{name: "Decimal", engine: engine.Grool{MaxCycle: 5, DecimalMode: true}, exact: true},
+		{name: "Bytecode", engine: engine.Grool{MaxCycle: 5, Bytecode: true}, exact: false},
+		{name: "Bytecode/Decimal", engine: engine.Grool{MaxCycle: 5, Bytecode: true, DecimalMode: true}, exact: true},
+	}
+	for _, td := range testData {
+		t.Run(td.name, func(t *testing.T) {
+			eng := td.engine
+			optimized, knowledgeBase, err := executeOptimized(t, optimizeRule, &eng, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			eng = td.engine
+			plain, _, err := executeOptimized(t, optimizeRule, &eng, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(optimized, plain) {
+				t.Errorf("optimized execution differs\noptimized %+v\nplain     %+v", optimized, plain)
+			}
+			if optimized.Year != 2019 || optimized.Label != "small-member" || optimized.Discount != 20 || optimized.Exact != td.exact {
+				t.Errorf("unexpected result %+v", optimized)
+			}
+
+			member := knowledgeBase.RuleEntries["Discount"].WhenScope.Expression.LeftExpression
+			if member.Predicate == nil || member.Predicate.ExpressionAtomRight != nil || member.Predicate.ExpressionAtomLeft.Variable != "Purchase.Member" {
+				t.Errorf("Purchase.Member != false && true should be simplified into Purchase.Member")
+			}
+			then := knowledgeBase.RuleEntries["Discount"].ThenScope.AssignExpressions.ExpressionList
+			if len(then) != 5 || then[4].Assignment == nil {
+				t.Fatalf("if statement with a constant condition should be replaced by its branch")
+			}
+			for _, i := range []int{1, 3, 4} {
+				if then[i].Assignment.Expression.Predicate.ExpressionAtomLeft.Constant == nil {
+					t.Errorf("%s should be folded", then[i].Assignment.Variable)
+				}
+			}
+			total := then[0].Assignment.Expression.Predicate.ExpressionAtomLeft
+			if total.ExpressionAtomRight.Constant == nil || total.ExpressionAtomRight.Constant.Decimal().RatString() != "23/20" {
+				t.Errorf("1 + 0.15 should be folded into the exact 1.15")
+			}
+		})
+	}
+}
+
+func TestOptimize_Error(t *testing.T) {
+	eng := &engine.Grool{MaxCycle: 5}
+	_, _, optimizedErr := executeOptimized(t, optimizeErrorRule, eng, true)
+	_, _, plainErr := executeOptimized(t, optimizeErrorRule, eng, false)
+	if optimizedErr == nil || plainErr == nil || optimizedErr.Error() != plainErr.Error() {
+		t.Errorf("division by zero should fail the execution, optimized %v, plain %v", optimizedErr, plainErr)
+	}
+}
+
+func TestOptimize_Differential(t *testing.T) {
+	for _, tc := range differentialCases(t) {
+		t.Run(tc.name, func(t *testing.T) {
+			plain := tc.run(t, false, false, false)
+			optimized := tc.run(t, false, true, false)
+			if !reflect.DeepEqual(plain, optimized) {
+				t.Errorf("optimized execution differs\nplain     %+v\noptimized %+v", plain, optimized)
+			}
+			compiled := tc.run(t, true, true, false)
+			if !reflect.DeepEqual(plain, compiled) {
+				t.Errorf("optimized bytecode execution differs\nplain     %+v\ncompiled  %+v", plain, compiled)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package examples
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"strings"
+	"testing"
+)
+
+type Regulation struct {
+	MaxAmount float64
+	Limits    []*RegulationLimit
+}
+
+type RegulationLimit struct {
+	Amount float64
+}
+
+type Transfer struct {
+	Amount   float64
+	Approved bool
+	Country  string `grool:",readonly"`
+}
+
+const (
+	readOnlyValidRule = `
+rule Approve "Approve transfer within the regulation" {
+	when
+		Transfer.Approved == false && Transfer.Amount <= Regulation.MaxAmount
+	then
+		Transfer.Approved = true;
+}
+`
+	readOnlyFactRule = `
+rule RaiseLimit "Rules can not change the regulation" {
+	when
+		Transfer.Amount > Regulation.MaxAmount
+	then
+		Regulation.MaxAmount = Transfer.Amount;
+}
+`
+	readOnlyLoopRule = `
+rule RaiseLimits "Nor the items of the regulation" {
+	when
+		Transfer.Approved == false
+	then
+		for limit in Regulation.Limits {
+			limit.Amount = Transfer.Amount;
+		}
+}
+`
+	readOnlyFieldRule = `
+rule ChangeCountry "Rules can not change a read only field" {
+	when
+		Transfer.Country == "ID"
+	then
+		Transfer.Country = "SG";
+}
+`
+)
+
+func TestReadOnlyFact(t *testing.T) {
+	testData := []struct {
+		rule     string
+		approved bool
+		err      string
+	}{
+		{rule: readOnlyValidRule, approved: true},
+		{rule: readOnlyFactRule, err: "Regulation is read only"},
+		{rule: readOnlyLoopRule, err: "read only"},
+		{rule: readOnlyFieldRule, err: "read only"},
+	}
+	for _, td := range testData {
+		for _, declared := range []bool{true, false} {
+			regulation := &Regulation{MaxAmount: 100, Limits: []*RegulationLimit{{Amount: 100}}}
+			transfer := &Transfer{Amount: 150, Country: "ID"}
+			if td.approved {
+				transfer.Amount = 50
+			}
+			dataContext := context.NewDataContext()
+			if err := dataContext.AddReadOnly("Regulation", regulation); err != nil {
+				t.Fatal(err)
+			}
+			if err := dataContext.Add("Transfer", transfer); err != nil {
+				t.Fatal(err)
+			}
+
+			knowledgeBase := model.NewKnowledgeBase()
+			if declared {
+				if err := knowledgeBase.DeclareReadOnlyFact("Regulation", &Regulation{}); err != nil {
+					t.Fatal(err)
+				}
+				if err := knowledgeBase.DeclareFact("Transfer", &Transfer{}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			// with the fact schemas known the rule fails to build, otherwise it fails to execute.
+			err := builder.NewRuleBuilder(knowledgeBase).BuildRuleFromResource(pkg.NewBytesResource([]byte(td.rule)))
+			if declared && len(td.err) > 0 {
+				if _, ok := err.(model.TypeErrors); !ok || !strings.Contains(err.Error(), td.err) {
+					t.Errorf("expect type error about %s but %v", td.err, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			eng := &engine.Grool{MaxCycle: 5}
+			err = eng.Execute(dataContext, knowledgeBase)
+			if len(td.err) > 0 {
+				if err == nil || !strings.Contains(err.Error(), td.err) {
+					t.Errorf("expect error about %s but %v", td.err, err)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if regulation.MaxAmount != 100 || regulation.Limits[0].Amount != 100 || transfer.Country != "ID" {
+				t.Errorf("read only values changed")
+			}
+			if transfer.Approved != td.approved {
+				t.Errorf("expect approved %v but %v", td.approved, transfer.Approved)
+			}
+		}
+	}
+}
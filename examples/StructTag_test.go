@@ -0,0 +1,129 @@
+package examples
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const (
+	structTagRule = `
+rule PriceAfterTax "Compute the price after tax" {
+	when
+		item.price_after_tax == 0
+	then
+		item.price_after_tax = item.price * (1 + item.TaxRate);
+}
+`
+	hiddenFieldRule = `
+rule ReadCost "Hidden field can not be used" {
+	when
+		item.Cost > 0
+	then
+		item.price = item.Cost;
+}
+`
+	jsonTagRule = `
+rule Discount "Use the json names" {
+	when
+		item.discount == 0
+	then
+		item.discount = item.price / 10;
+}
+`
+)
+
+type TaggedItem struct {
+	Price         float64 `grool:"price" json:"price"`
+	PriceAfterTax float64 `grool:"price_after_tax"`
+	TaxRate       float64
+	Discount      float64 `json:"discount,omitempty"`
+	Cost          float64 `grool:"-"`
+}
+
+func TestStructTag(t *testing.T) {
+	testData := []struct {
+		rule          string
+		jsonTags      bool
+		priceAfterTax float64
+		discount      float64
+		err           string
+	}{
+		{rule: structTagRule, priceAfterTax: 150},
+		{rule: jsonTagRule, jsonTags: true, discount: 10},
+		{rule: hiddenFieldRule, err: "Cost"},
+	}
+	for _, td := range testData {
+		item := &TaggedItem{Price: 100, TaxRate: 0.5, Cost: 70}
+		dataContext := context.NewDataContext()
+		dataContext.JSONTags = td.jsonTags
+		if err := dataContext.Add("item", item); err != nil {
+			t.Fatal(err)
+		}
+
+		knowledgeBase := model.NewKnowledgeBase()
+		knowledgeBase.JSONTags = td.jsonTags
+		if err := knowledgeBase.DeclareFact("item", &TaggedItem{}); err != nil {
+			t.Fatal(err)
+		}
+		err := builder.NewRuleBuilder(knowledgeBase).BuildRuleFromResource(pkg.NewBytesResource([]byte(td.rule)))
+		if len(td.err) > 0 {
+			if err == nil || !strings.Contains(err.Error(), td.err) {
+				t.Errorf("expect error about %s but %v", td.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		eng := &engine.Grool{MaxCycle: 5}
+		if err := eng.Execute(dataContext, knowledgeBase); err != nil {
+			t.Fatal(err)
+		}
+		if item.PriceAfterTax != td.priceAfterTax {
+			t.Errorf("expect price after tax %v but %v", td.priceAfterTax, item.PriceAfterTax)
+		}
+		if item.Discount != td.discount {
+			t.Errorf("expect discount %v but %v", td.discount, item.Discount)
+		}
+	}
+
+	// without type checking, the hidden field is not found at run time either.
+	if _, err := context.NewDataContext().GetValue("item.Cost"); err == nil {
+		t.Error("expect error")
+	}
+	dataContext := context.NewDataContext()
+	if err := dataContext.Add("item", &TaggedItem{Cost: 70}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dataContext.GetValue("item.Cost"); err == nil {
+		t.Error("expect hidden field not found")
+	}
+}
+
+func TestStructTag_JSONTagsPerDataContext(t *testing.T) {
+	t.Parallel()
+	for _, jsonTags := range []bool{true, false} {
+		jsonTags := jsonTags
+		t.Run(strconv.FormatBool(jsonTags), func(t *testing.T) {
+			t.Parallel()
+			dataContext := context.NewDataContext()
+			dataContext.JSONTags = jsonTags
+			if err := dataContext.Add("item", &TaggedItem{Discount: 5}); err != nil {
+				t.Fatal(err)
+			}
+			val, err := dataContext.GetValue("item.discount")
+			if jsonTags && (err != nil || val.Float() != 5) {
+				t.Errorf("expect the json name resolved, got %v %v", val, err)
+			}
+			if !jsonTags && err == nil {
+				t.Errorf("expect the json name not resolved without json tags")
+			}
+		})
+	}
+}
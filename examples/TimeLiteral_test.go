@@ -0,0 +1,107 @@
+package examples
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"testing"
+	"time"
+)
+
+const (
+	timeLiteralRule = `
+rule JanuarySubscription "Subscription started in January gets 30 days" {
+	when
+		Subscription.Start >= 2019-01-01 && Subscription.Start < 2019-02-01T00:00:00 && Subscription.Active == false
+	then
+		Subscription.End = Subscription.Start + 30d;
+		Subscription.Grace = 2h15m;
+		Subscription.Length = Subscription.End - Subscription.Start;
+		Subscription.Active = true;
+}
+
+rule NewYearSubscription "Subscription started exactly at new year" {
+	when
+		Subscription.Start == 2019-01-01T00:00:00 && Subscription.Active && Subscription.Bonus == false
+	then
+		Subscription.End = Subscription.End + 1d - 12h;
+		Subscription.Bonus = true;
+}
+`
+)
+
+type TimeSubscription struct {
+	Start  time.Time
+	End    time.Time
+	Grace  time.Duration
+	Length time.Duration
+	Active bool
+	Bonus  bool
+}
+
+func TestTimeLiteral(t *testing.T) {
+	testData := []struct {
+		start  time.Time
+		active bool
+		end    time.Time
+		bonus  bool
+	}{
+		{
+			start:  time.Date(2019, 1, 15, 10, 0, 0, 0, time.Local),
+			active: true,
+			end:    time.Date(2019, 2, 14, 10, 0, 0, 0, time.Local),
+			bonus:  false,
+		},
+		{
+			start:  time.Date(2019, 1, 1, 0, 0, 0, 0, time.Local),
+			active: true,
+			end:    time.Date(2019, 1, 31, 12, 0, 0, 0, time.Local),
+			bonus:  true,
+		},
+		{
+			start:  time.Date(2019, 2, 1, 0, 0, 0, 0, time.Local),
+			active: false,
+		},
+	}
+	for _, td := range testData {
+		subscription := &TimeSubscription{Start: td.start}
+		dataContext := context.NewDataContext()
+		err := dataContext.Add("Subscription", subscription)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		knowledgeBase := model.NewKnowledgeBase()
+		ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+		err = ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(timeLiteralRule)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		eng := &engine.Grool{MaxCycle: 5}
+		err = eng.Execute(dataContext, knowledgeBase)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if subscription.Active != td.active {
+			t.Errorf("subscription starting %v active should be %v", td.start, td.active)
+		}
+		if subscription.Bonus != td.bonus {
+			t.Errorf("subscription starting %v bonus should be %v", td.start, td.bonus)
+		}
+		if !td.active {
+			continue
+		}
+		if !subscription.End.Equal(td.end) {
+			t.Errorf("subscription starting %v should end at %v but %v", td.start, td.end, subscription.End)
+		}
+		if subscription.Grace != 2*time.Hour+15*time.Minute {
+			t.Errorf("grace should be 2h15m but %v", subscription.Grace)
+		}
+		if subscription.Length != 30*24*time.Hour {
+			t.Errorf("length should be 30 days but %v", subscription.Length)
+		}
+	}
+}
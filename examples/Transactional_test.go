@@ -0,0 +1,127 @@
+package examples
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"reflect"
+	"testing"
+)
+
+const (
+	transactionalRule = `
+rule Checkout "Checkout the cart" {
+	when
+		Cart.CheckedOut == false
+	then
+		Cart.Total = Cart.Total + Cart.Shipping;
+		for line in Cart.Lines {
+			line.Price = line.Price - 1;
+		}
+		Payment.status = "PAID";
+		Payment.reference = "R-1";
+		Cart.Average = Cart.Total / Cart.Count;
+		Cart.CheckedOut = true;
+}
+`
+)
+
+type TransactionalLine struct {
+	Price int
+}
+
+type TransactionalCart struct {
+	Total      int
+	Shipping   int
+	Count      int
+	Average    int
+	CheckedOut bool
+	Lines      []*TransactionalLine
+}
+
+func newTransactionalContext(t *testing.T, cart *TransactionalCart, payment map[string]interface{}) (*context.DataContext, *model.KnowledgeBase) {
+	dataContext := context.NewDataContext()
+	if err := dataContext.Add("Cart", cart); err != nil {
+		t.Fatal(err)
+	}
+	if err := dataContext.Add("Payment", payment); err != nil {
+		t.Fatal(err)
+	}
+	knowledgeBase := model.NewKnowledgeBase()
+	if err := builder.NewRuleBuilder(knowledgeBase).BuildRuleFromResource(pkg.NewBytesResource([]byte(transactionalRule))); err != nil {
+		t.Fatal(err)
+	}
+	return dataContext, knowledgeBase
+}
+
+func TestTransactional(t *testing.T) {
+	testData := []struct {
+		count   int
+		fail    bool
+		changes int
+	}{
+		{count: 2, changes: 7},
+		// division by zero fails the rule halfway, every change is rolled back.
+		{count: 0, fail: true},
+	}
+	for _, td := range testData {
+		cart := &TransactionalCart{Total: 100, Shipping: 10, Count: td.count, Lines: []*TransactionalLine{{Price: 60}, {Price: 40}}}
+		payment := map[string]interface{}{"status": "NEW"}
+		dataContext, knowledgeBase := newTransactionalContext(t, cart, payment)
+
+		eng := &engine.Grool{MaxCycle: 5, Transactional: true}
+		err := eng.Execute(dataContext, knowledgeBase)
+		if td.fail {
+			if err == nil {
+				t.Fatal("expect error")
+			}
+		} else {
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(dataContext.Journal.Changes) != td.changes {
+				t.Fatalf("expect %d changes but %d", td.changes, len(dataContext.Journal.Changes))
+			}
+			first := dataContext.Journal.Changes[0]
+			if first.Variable != "Cart.Total" || first.OldValue != 100 || first.NewValue != 110 {
+				t.Errorf("unexpected first change %s %v %v", first.Variable, first.OldValue, first.NewValue)
+			}
+			if cart.Total != 110 || cart.Lines[0].Price != 59 || payment["reference"] != "R-1" || !cart.CheckedOut {
+				t.Errorf("expect changes applied")
+			}
+			// the caller aborts after a successful execution.
+			dataContext.Journal.Rollback()
+		}
+		if cart.Total != 100 || cart.Lines[0].Price != 60 || cart.Lines[1].Price != 40 || cart.CheckedOut {
+			t.Errorf("expect cart rolled back but %+v", cart)
+		}
+		if _, ok := payment["reference"]; ok || payment["status"] != "NEW" {
+			t.Errorf("expect payment rolled back but %v", payment)
+		}
+	}
+}
+
+func TestTransactional_CallerJournal(t *testing.T) {
+	cart := &TransactionalCart{Total: 100, Shipping: 10, Lines: []*TransactionalLine{{Price: 60}}}
+	payment := map[string]interface{}{"status": "NEW"}
+	dataContext, knowledgeBase := newTransactionalContext(t, cart, payment)
+	journal := context.NewJournal()
+	dataContext.Journal = journal
+	// a change recorded by the caller before the execution.
+	if err := dataContext.SetValue("Payment.status", reflect.ValueOf("PENDING")); err != nil {
+		t.Fatal(err)
+	}
+
+	eng := &engine.Grool{MaxCycle: 5, Transactional: true}
+	if err := eng.Execute(dataContext, knowledgeBase); err == nil {
+		t.Fatal("expect error")
+	}
+	if dataContext.Journal != journal || len(journal.Changes) != 1 || journal.Changes[0].NewValue != "PENDING" {
+		t.Fatalf("expect only the changes of the execution rolled back from the journal of the caller, got %v", journal.Changes)
+	}
+	if cart.Total != 100 || cart.Lines[0].Price != 60 || payment["status"] != "PENDING" {
+		t.Errorf("expect the facts as before the execution but %+v %v", cart, payment)
+	}
+}
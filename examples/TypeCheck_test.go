@@ -0,0 +1,253 @@
+package examples
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"strings"
+	"testing"
+	"time"
+)
+
+type CheckedItem struct {
+	Name  string
+	Price float64
+}
+
+type CheckedPurchase struct {
+	Price    float64
+	Quantity int
+	Code     string
+	Items    []CheckedItem
+	Tags     map[string]string
+	Created  time.Time
+	Expiry   time.Time
+	Discount float64
+	Approved bool
+	Any      interface{}
+}
+
+func (p *CheckedPurchase) Total(tax float64) float64 {
+	return p.Price*float64(p.Quantity) + tax
+}
+
+func (p *CheckedPurchase) FirstItem() *CheckedItem {
+	if len(p.Items) == 0 {
+		return nil
+	}
+	return &p.Items[0]
+}
+
+func newCheckedKnowledgeBase(t *testing.T) *model.KnowledgeBase {
+	knowledgeBase := model.NewKnowledgeBase()
+	err := knowledgeBase.DeclareFact("Purchase", &CheckedPurchase{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return knowledgeBase
+}
+
+func TestTypeCheck_ValidRule(t *testing.T) {
+	rule := `
+rule ValidPurchase "A rule that type checks" salience 10 {
+	when
+		Purchase.Price * Purchase.Quantity > 100 && Purchase.Code in ("A", "B") &&
+		Purchase.Code matches "^[A-Z]$" && Purchase.Tags contains "vip" && Purchase.Any == 1 &&
+		Purchase.Created < Now() && Purchase.FirstItem()?.Name != "free" && Purchase.Approved == false
+	then
+		Purchase.Discount = Purchase.Total(1.5) / 10.0;
+		Purchase.Expiry = Purchase.Created + 30d;
+		Purchase.Code = Purchase.Approved ? "X" : "Y";
+		for item in Purchase.Items {
+			item.Price = item.Price * 0.9;
+		}
+		if (Purchase.Quantity > 1) {
+			Purchase.Approved = true;
+		}
+		Log(Purchase.Code);
+		Retract("ValidPurchase");
+}
+`
+	ruleBuilder := builder.NewRuleBuilder(newCheckedKnowledgeBase(t))
+	err := ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(rule)))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTypeCheck_Errors(t *testing.T) {
+	testData := []struct {
+		rule     string
+		line     int
+		contains string
+	}{
+		{"when\n Purchase.Prise > 10\n then\n Purchase.Approved = true;", 3, "has no member Prise"},
+		{"when\n Purchse.Price > 10\n then\n Purchase.Approved = true;", 3, "fact Purchse is not declared"},
+		{"when\n Purchase.Code == 10\n then\n Purchase.Approved = true;", 3, "can not compare string with int64"},
+		{"when\n Purchase.Price\n then\n Purchase.Approved = true;", 3, "when scope must be a boolean expression"},
+		{"when\n Purchase.Approved\n then\n Purchase.Quantity = \"many\";", 5, "can not assign string to Purchase.Quantity"},
+		{"when\n Purchase.Approved\n then\n Purchase.Code = Purchase.Code - 1;", 5, "can not apply - between string and int64"},
+		{"when\n Purchase.Approved\n then\n Purchase.Discount = Purchase.Total(\"tax\");", 5, "argument #0 of method Total() require float64 but string"},
+		{"when\n Purchase.Approved\n then\n Purchase.Discount = Purchase.Total();", 5, "method Total() need 1 argument while there are 0"},
+		{"when\n Purchase.Approved\n then\n Purchase.Refund(1.0);", 5, "has no method Refund()"},
+		{"when\n Purchase.Approved\n then\n Logg(\"x\");", 5, "has no method Logg()"},
+		{"when\n Purchase.Approved\n then\n for item in Purchase.Items {\n item.Cost = 1.0;\n }", 6, "has no member Cost"},
+		{"when\n Purchase.Approved\n then\n for item in Purchase.Price {\n Log(\"x\");\n }", 5, "can not iterate over Purchase.Price"},
+		{"when\n Purchase.Approved\n then\n if (Purchase.Quantity) {\n Log(\"x\");\n }", 5, "if statement condition must be a boolean expression"},
+	}
+	for i, td := range testData {
+		rule := "rule Checked \"checked rule\" {\n" + td.rule + "\n}"
+		ruleBuilder := builder.NewRuleBuilder(newCheckedKnowledgeBase(t))
+		err := ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(rule)))
+		if err == nil {
+			t.Errorf("test #%d should fail to build", i)
+			continue
+		}
+		typeErrors, ok := err.(model.TypeErrors)
+		if !ok {
+			t.Errorf("test #%d should fail with type errors but %v", i, err)
+			continue
+		}
+		if typeErrors[0].Line != td.line || !strings.Contains(typeErrors[0].Message, td.contains) {
+			t.Errorf("test #%d expect error at line %d containing \"%s\" but %v", i, td.line, td.contains, typeErrors[0])
+		}
+	}
+}
+
+func TestTypeCheck_AllErrorsReported(t *testing.T) {
+	rule := `
+rule Misspelled "A rule with many typos" {
+	when
+		Purchase.Prise > 10 && Purchase.Qty > 1
+	then
+		Purchase.Discont = 10.0;
+}
+`
+	ruleBuilder := builder.NewRuleBuilder(newCheckedKnowledgeBase(t))
+	err := ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(rule)))
+	typeErrors, ok := err.(model.TypeErrors)
+	if !ok {
+		t.Fatalf("should fail with type errors but %v", err)
+	}
+	if len(typeErrors) != 3 {
+		t.Fatalf("expect 3 errors but %d. %v", len(typeErrors), typeErrors)
+	}
+	if typeErrors[0].Line != 4 || typeErrors[0].Column != 3 || typeErrors[1].Line != 4 || typeErrors[2].Line != 6 {
+		t.Errorf("errors are not sorted by their position. %v", typeErrors)
+	}
+}
+
+func TestTypeCheck_FailedBuildKeepsRules(t *testing.T) {
+	kept := `
+rule Kept "A rule that type checks" {
+	when
+		Purchase.Price > 10
+	then
+		Purchase.Discount = 10.0;
+}
+`
+	testData := []struct {
+		name string
+		rule string
+	}{
+		{name: "TypeError", rule: `
+rule Added "A rule that type checks, in a resource that does not" {
+	when
+		Purchase.Price > 20
+	then
+		Purchase.Discount = 30.0;
+}
+rule Misspelled "A rule that does not type check" {
+	when
+		Purchase.Prise > 10
+	then
+		Purchase.Discount = 20.0;
+}
+`},
+		{name: "DuplicateRule", rule: `
+rule Added "A rule that type checks, in a resource that does not build" {
+	when
+		Purchase.Price > 20
+	then
+		Purchase.Discount = 30.0;
+}
+rule Kept "A rule already in the knowledge base" {
+	when
+		Purchase.Price > 10
+	then
+		Purchase.Discount = 20.0;
+}
+`},
+	}
+	for _, td := range testData {
+		t.Run(td.name, func(t *testing.T) {
+			knowledgeBase := newCheckedKnowledgeBase(t)
+			ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+			if err := ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(kept))); err != nil {
+				t.Fatal(err)
+			}
+			entry := knowledgeBase.RuleEntries["Kept"]
+			if err := ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(td.rule))); err == nil {
+				t.Fatal("rule should fail to build")
+			}
+			if len(knowledgeBase.RuleEntries) != 1 || knowledgeBase.RuleEntries["Kept"] != entry {
+				t.Errorf("failed build should leave the rule entries unchanged, got %v", knowledgeBase.RuleEntries)
+			}
+		})
+	}
+}
+
+type CheckedShipment struct {
+	Options map[string]interface{}
+	Limits  map[string]int
+}
+
+func TestTypeCheck_MapMember(t *testing.T) {
+	rule := `
+rule MapMember "A rule using the members of map facts" {
+	when
+		Shipment.Options.color == "red" && Shipment.Options.box.size > 10 && Shipment.Limits.weight > 5
+	then
+		Shipment.Options.wrap = true;
+		Shipment.Limits.weight = 5;
+}
+`
+	knowledgeBase := model.NewKnowledgeBase()
+	if err := knowledgeBase.DeclareFact("Shipment", &CheckedShipment{}); err != nil {
+		t.Fatal(err)
+	}
+	ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+	if err := ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(rule))); err != nil {
+		t.Fatalf("rule using map members should type check. got %v", err)
+	}
+
+	invalid := `
+rule MapMemberKind "A rule assigning a string to an int map" {
+	when
+		Shipment.Limits.weight > 5
+	then
+		Shipment.Limits.weight = "heavy";
+}
+`
+	if err := ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(invalid))); err == nil {
+		t.Errorf("assigning a string into a map of int should fail the type check")
+	} else if !strings.Contains(err.Error(), "can not assign string to Shipment.Limits.weight") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestTypeCheck_WithoutDeclaredFact(t *testing.T) {
+	rule := `
+rule Unchecked "Not checked without declared facts" {
+	when
+		Purchase.Prise > 10
+	then
+		Purchase.Discont = 10.0;
+}
+`
+	ruleBuilder := builder.NewRuleBuilder(model.NewKnowledgeBase())
+	err := ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(rule)))
+	if err != nil {
+		t.Errorf("rule should build without declared facts. got %v", err)
+	}
+}
@@ -0,0 +1,85 @@
+// Package codegen shows the rules of Rules.grl compiled into Go code by grool gen, see Rules.go.
+package codegen
+
+import (
+	"fmt"
+	"time"
+)
+
+//go:generate go run github.com/newm4n/grool/cmd/grool gen --fact Order=Order -o Rules.go Rules.grl
+
+// Customer is the buyer of an order.
+type Customer struct {
+	Name   string
+	Age    int
+	Member bool
+	Tier   string `grool:"tier"`
+	Region string `json:"region"`
+	Points int64
+	Since  time.Time
+}
+
+// Item is a product in an order.
+type Item struct {
+	Name     string
+	Category string
+	Price    float64
+	Quantity int
+	Discount float64
+}
+
+// Line is a packing line of an order.
+type Line struct {
+	Item   string
+	Amount uint32
+	Packed bool
+}
+
+// Order is the fact the rules work on.
+type Order struct {
+	ID        string `grool:",readonly"`
+	Customer  *Customer
+	Items     []*Item
+	Lines     []Line
+	Placed    time.Time
+	Window    time.Duration
+	Due       time.Time
+	Processed bool
+	Count     int
+	Units     uint64
+	Subtotal  float64
+	Discount  float64
+	Shipping  float64
+	Total     float64
+	Average   float64
+	Status    string
+	Label     string
+	Note      string
+}
+
+// Owner returns the customer of the order.
+func (o *Order) Owner() *Customer {
+	return o.Customer
+}
+
+// AddNote appends the note to the notes of the order.
+func (o *Order) AddNote(note string) {
+	if len(o.Note) > 0 {
+		o.Note += "; "
+	}
+	o.Note += note
+}
+
+// TaxRate returns the tax rate of the region, it fails if the region is unknown.
+func (o *Order) TaxRate(region string) (float64, error) {
+	switch region {
+	case "ID":
+		return 0.1, nil
+	case "SG":
+		return 0.07, nil
+	case "US":
+		return 0.05, nil
+	default:
+		return 0, fmt.Errorf("unknown region %q", region)
+	}
+}
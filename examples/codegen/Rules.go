@@ -0,0 +1,858 @@
+// Code generated by grool gen. DO NOT EDIT.
+
+package codegen
+
+import (
+	"errors"
+	"fmt"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	log "github.com/sirupsen/logrus"
+	"sort"
+	"time"
+)
+
+// RuleFacts holds the facts the rules work on.
+type RuleFacts struct {
+	Order *Order
+}
+
+// RuleEngine executes the rules compiled into Go code, following the salience and cycle semantics of engine.Grool.
+type RuleEngine struct {
+	MaxCycle uint64
+	// MaxLoopIteration limits the number of iteration of a single loop in the then scope. Zero means default.
+	MaxLoopIteration uint64
+	// OnRuleExecuted, if set, is called after each successful execution of a rule.
+	OnRuleExecuted func(ruleName string)
+}
+
+// NewRuleEngine creates a rule engine with a max cycle of 5000.
+func NewRuleEngine() *RuleEngine {
+	return &RuleEngine{
+		MaxCycle:         5000,
+		MaxLoopIteration: 10000,
+	}
+}
+
+// Execute executes the rules against the facts, until no rule can be executed or an executed rule changes nothing.
+func (e *RuleEngine) Execute(facts *RuleFacts) error {
+	s := &groolSession{
+		facts:            facts,
+		maxLoopIteration: e.MaxLoopIteration,
+		retracted:        make(map[string]bool),
+	}
+	if s.maxLoopIteration == 0 {
+		s.maxLoopIteration = 10000
+	}
+	runnable := make([]*groolRule, 0, len(groolRules))
+	var cycle uint64
+	for {
+		cycle++
+		if cycle > e.MaxCycle {
+			return fmt.Errorf("Grool successfully selected rule candidate for execution after %d cycles, this could possibly caused by rule entry(s) that keep added into execution pool but when executed it does not change any data in context. Please evaluate your rule entries \"When\" and \"Then\" scope. You can adjust the maximum cycle using RuleEngine.MaxCycle variable.", e.MaxCycle)
+		}
+		runnable = runnable[:0]
+		for _, rule := range groolRules {
+			if s.retracted[rule.name] {
+				continue
+			}
+			can, err := rule.when(s)
+			if err != nil {
+				log.Errorf("Failed testing condition for rule : %s. Got error %v", rule.name, err)
+			}
+			if can {
+				runnable = append(runnable, rule)
+			}
+		}
+		if len(runnable) == 0 {
+			return nil
+		}
+		sort.SliceStable(runnable, func(i, j int) bool {
+			return runnable[i].salience > runnable[j].salience
+		})
+		cycleDone := true
+		for _, rule := range runnable {
+			s.changes = 0
+			if err := rule.then(s); err != nil {
+				log.Errorf("Failed execution rule : %s. Got error %v", rule.name, err)
+				return err
+			}
+			if e.OnRuleExecuted != nil {
+				e.OnRuleExecuted(rule.name)
+			}
+			if s.changes > 0 {
+				cycleDone = false
+				break
+			}
+		}
+		if cycleDone {
+			return nil
+		}
+	}
+}
+
+// groolSession is the state of a single execution.
+type groolSession struct {
+	facts            *RuleFacts
+	maxLoopIteration uint64
+	retracted        map[string]bool
+	changes          uint64
+}
+
+// groolRule is a rule compiled into Go code.
+type groolRule struct {
+	name     string
+	salience int64
+	when     func(s *groolSession) (bool, error)
+	then     func(s *groolSession) error
+}
+
+var groolFunctions = &model.GroolFunctions{}
+
+var groolRules = []*groolRule{
+	{name: "Average", salience: 10, when: groolWhenAverage, then: groolThenAverage},
+	{name: "Describe", salience: 30, when: groolWhenDescribe, then: groolThenDescribe},
+	{name: "Late", salience: 20, when: groolWhenLate, then: groolThenLate},
+	{name: "LoyaltyPoints", salience: 70, when: groolWhenLoyaltyPoints, then: groolThenLoyaltyPoints},
+	{name: "MemberDiscount", salience: 80, when: groolWhenMemberDiscount, then: groolThenMemberDiscount},
+	{name: "Multiplier", salience: 40, when: groolWhenMultiplier, then: groolThenMultiplier},
+	{name: "RejectMinor", salience: 100, when: groolWhenRejectMinor, then: groolThenRejectMinor},
+	{name: "Shipping", salience: 60, when: groolWhenShipping, then: groolThenShipping},
+	{name: "SumItems", salience: 90, when: groolWhenSumItems, then: groolThenSumItems},
+	{name: "Total", salience: 50, when: groolWhenTotal, then: groolThenTotal},
+}
+
+// groolWhenAverage is the when scope of rule Average.
+func groolWhenAverage(s *groolSession) (bool, error) {
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Processed from nil fact Order")
+	}
+	v1 := s.facts.Order.Processed
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Average from nil fact Order")
+	}
+	v2 := s.facts.Order.Average
+	v3 := v2 == float64(int64(0))
+	v4 := v1 && v3
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Status from nil fact Order")
+	}
+	v5 := s.facts.Order.Status
+	v6 := v5 != "REJECTED"
+	v7 := v4 && v6
+	return v7, nil
+}
+
+// groolThenAverage is the then scope of rule Average.
+func groolThenAverage(s *groolSession) error {
+	if s.facts.Order == nil {
+		return errors.New("can not get Subtotal from nil fact Order")
+	}
+	v8 := s.facts.Order.Subtotal
+	if s.facts.Order == nil {
+		return errors.New("can not get Count from nil fact Order")
+	}
+	v9 := s.facts.Order.Count
+	v10, err := pkg.CheckedFloat("/", v8, float64(v9))
+	if err != nil {
+		return err
+	}
+	if s.facts.Order == nil {
+		return errors.New("can not set Average of nil fact Order")
+	}
+	s.facts.Order.Average = v10
+	s.changes++
+	return nil
+}
+
+// groolWhenDescribe is the when scope of rule Describe.
+func groolWhenDescribe(s *groolSession) (bool, error) {
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Note from nil fact Order")
+	}
+	v1 := s.facts.Order.Note
+	v2 := v1 == ""
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Status from nil fact Order")
+	}
+	v3 := s.facts.Order.Status
+	v4 := v3 != ""
+	v5 := v2 && v4
+	return v5, nil
+}
+
+// groolThenDescribe is the then scope of rule Describe.
+func groolThenDescribe(s *groolSession) error {
+	if s.facts.Order == nil {
+		return errors.New("can not get Placed from nil fact Order")
+	}
+	v6 := s.facts.Order.Placed
+	v7 := groolFunctions.GetTimeYear(v6)
+	v8 := fmt.Sprintf("%s%d", "placed in ", int64(v7))
+	v9 := v8 + " by "
+	if s.facts.Order == nil {
+		return errors.New("can not call Owner on nil fact Order")
+	}
+	v10 := s.facts.Order.Owner()
+	if v10 == nil {
+		return errors.New("can not get Name from nil value")
+	}
+	v11 := v10.Name
+	v12 := v9 + v11
+	v13 := v12 + " with "
+	if s.facts.Order == nil {
+		return errors.New("can not get Count from nil fact Order")
+	}
+	v14 := s.facts.Order.Count
+	v15 := fmt.Sprintf("%s%d", v13, int64(v14))
+	v16 := v15 + " items"
+	if s.facts.Order == nil {
+		return errors.New("can not call AddNote on nil fact Order")
+	}
+	s.facts.Order.AddNote(v16)
+	return nil
+}
+
+// groolWhenLate is the when scope of rule Late.
+func groolWhenLate(s *groolSession) (bool, error) {
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Due from nil fact Order")
+	}
+	v1 := s.facts.Order.Due
+	v2 := groolFunctions.MakeTime(int64(2020), int64(1), int64(1), int64(0), int64(0), int64(0))
+	v3 := v1.After(v2) || v1.Equal(v2)
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Status from nil fact Order")
+	}
+	v4 := s.facts.Order.Status
+	v5 := v4 != "LATE"
+	v6 := v3 && v5
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Status from nil fact Order")
+	}
+	v7 := s.facts.Order.Status
+	v8 := v7 != "REJECTED"
+	v9 := v6 && v8
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Status from nil fact Order")
+	}
+	v10 := s.facts.Order.Status
+	v11 := v10 != ""
+	v12 := v9 && v11
+	return v12, nil
+}
+
+// groolThenLate is the then scope of rule Late.
+func groolThenLate(s *groolSession) error {
+	if s.facts.Order == nil {
+		return errors.New("can not set Status of nil fact Order")
+	}
+	s.facts.Order.Status = "LATE"
+	s.changes++
+	return nil
+}
+
+// groolWhenLoyaltyPoints is the when scope of rule LoyaltyPoints.
+func groolWhenLoyaltyPoints(s *groolSession) (bool, error) {
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Processed from nil fact Order")
+	}
+	v1 := s.facts.Order.Processed
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Customer from nil fact Order")
+	}
+	if s.facts.Order.Customer == nil {
+		return false, errors.New("can not get Points from nil attribute Customer")
+	}
+	v2 := s.facts.Order.Customer.Points
+	v3 := float64(v2) < float64(int64(1000))
+	v4 := v1 && v3
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Customer from nil fact Order")
+	}
+	if s.facts.Order.Customer == nil {
+		return false, errors.New("can not get Since from nil attribute Customer")
+	}
+	v5 := s.facts.Order.Customer.Since
+	v6 := v5.Before(time.Date(2018, 1, 1, 0, 0, 0, 0, time.Local))
+	v7 := v4 && v6
+	return v7, nil
+}
+
+// groolThenLoyaltyPoints is the then scope of rule LoyaltyPoints.
+func groolThenLoyaltyPoints(s *groolSession) error {
+	if s.facts.Order == nil {
+		return errors.New("can not get Customer from nil fact Order")
+	}
+	if s.facts.Order.Customer == nil {
+		return errors.New("can not get Points from nil attribute Customer")
+	}
+	v8 := s.facts.Order.Customer.Points
+	if s.facts.Order == nil {
+		return errors.New("can not get Count from nil fact Order")
+	}
+	v9 := s.facts.Order.Count
+	v10, err := pkg.CheckedInt("+", v8, int64(v9))
+	if err != nil {
+		return err
+	}
+	v11, err := pkg.CheckedInt("*", v10, int64(10))
+	if err != nil {
+		return err
+	}
+	v12, err := pkg.CheckedInt("+", v11, int64(5))
+	if err != nil {
+		return err
+	}
+	if s.facts.Order == nil {
+		return errors.New("can not set Customer of nil fact Order")
+	}
+	if s.facts.Order.Customer == nil {
+		return errors.New("can not set Points of nil attribute Customer")
+	}
+	s.facts.Order.Customer.Points = v12
+	s.changes++
+	s.retracted["LoyaltyPoints"] = true
+	return nil
+}
+
+// groolWhenMemberDiscount is the when scope of rule MemberDiscount.
+func groolWhenMemberDiscount(s *groolSession) (bool, error) {
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Processed from nil fact Order")
+	}
+	v1 := s.facts.Order.Processed
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Discount from nil fact Order")
+	}
+	v2 := s.facts.Order.Discount
+	v3 := v2 == float64(int64(0))
+	v4 := v1 && v3
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Customer from nil fact Order")
+	}
+	if s.facts.Order.Customer == nil {
+		return false, errors.New("can not get Member from nil attribute Customer")
+	}
+	v5 := s.facts.Order.Customer.Member
+	v6 := v5 == true
+	v7 := v4 && v6
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Subtotal from nil fact Order")
+	}
+	v8 := s.facts.Order.Subtotal
+	v9 := v8 > float64(int64(0))
+	v10 := v7 && v9
+	return v10, nil
+}
+
+// groolThenMemberDiscount is the then scope of rule MemberDiscount.
+func groolThenMemberDiscount(s *groolSession) error {
+	if s.facts.Order == nil {
+		return errors.New("can not get Customer from nil fact Order")
+	}
+	if s.facts.Order.Customer == nil {
+		return errors.New("can not get tier from nil attribute Customer")
+	}
+	v11 := s.facts.Order.Customer.Tier
+	v12 := v11 == "GOLD"
+	var v17 float64
+	if v12 {
+		if s.facts.Order == nil {
+			return errors.New("can not get Subtotal from nil fact Order")
+		}
+		v13 := s.facts.Order.Subtotal
+		v14, err := pkg.CheckedFloat("*", v13, float64(0.1))
+		if err != nil {
+			return err
+		}
+		v17 = v14
+	} else {
+		if s.facts.Order == nil {
+			return errors.New("can not get Subtotal from nil fact Order")
+		}
+		v15 := s.facts.Order.Subtotal
+		v16, err := pkg.CheckedFloat("*", v15, float64(0.05))
+		if err != nil {
+			return err
+		}
+		v17 = v16
+	}
+	if s.facts.Order == nil {
+		return errors.New("can not set Discount of nil fact Order")
+	}
+	s.facts.Order.Discount = v17
+	s.changes++
+	if s.facts.Order == nil {
+		return errors.New("can not get Customer from nil fact Order")
+	}
+	if s.facts.Order.Customer == nil {
+		return errors.New("can not get tier from nil attribute Customer")
+	}
+	v18 := s.facts.Order.Customer.Tier
+	v19 := "MEMBER-" + v18
+	if s.facts.Order == nil {
+		return errors.New("can not set Label of nil fact Order")
+	}
+	s.facts.Order.Label = v19
+	s.changes++
+	return nil
+}
+
+// groolWhenMultiplier is the when scope of rule Multiplier.
+func groolWhenMultiplier(s *groolSession) (bool, error) {
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Status from nil fact Order")
+	}
+	v1 := s.facts.Order.Status
+	v2 := v1 == "PRICED"
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Customer from nil fact Order")
+	}
+	if s.facts.Order.Customer == nil {
+		return false, errors.New("can not get Points from nil attribute Customer")
+	}
+	v3 := s.facts.Order.Customer.Points
+	v4 := float64(v3) > float64(int64(0))
+	v5 := v2 && v4
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Units from nil fact Order")
+	}
+	v6 := s.facts.Order.Units
+	v7 := float64(v6) > float64(int64(0))
+	v8 := v5 && v7
+	return v8, nil
+}
+
+// groolThenMultiplier is the then scope of rule Multiplier.
+func groolThenMultiplier(s *groolSession) error {
+	if s.facts.Order == nil {
+		return errors.New("can not get Customer from nil fact Order")
+	}
+	if s.facts.Order.Customer == nil {
+		return errors.New("can not get Points from nil attribute Customer")
+	}
+	v9 := s.facts.Order.Customer.Points
+	if s.facts.Order == nil {
+		return errors.New("can not get Units from nil fact Order")
+	}
+	v10 := s.facts.Order.Units
+	v11, err := pkg.CheckedIntUint("*", v9, v10)
+	if err != nil {
+		return err
+	}
+	if s.facts.Order == nil {
+		return errors.New("can not set Customer of nil fact Order")
+	}
+	if s.facts.Order.Customer == nil {
+		return errors.New("can not set Points of nil attribute Customer")
+	}
+	s.facts.Order.Customer.Points = v11
+	s.changes++
+	if s.facts.Order == nil {
+		return errors.New("can not set Status of nil fact Order")
+	}
+	s.facts.Order.Status = "DONE"
+	s.changes++
+	return nil
+}
+
+// groolWhenRejectMinor is the when scope of rule RejectMinor.
+func groolWhenRejectMinor(s *groolSession) (bool, error) {
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Status from nil fact Order")
+	}
+	v1 := s.facts.Order.Status
+	v2 := v1 == ""
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Customer from nil fact Order")
+	}
+	if s.facts.Order.Customer == nil {
+		return false, errors.New("can not get Age from nil attribute Customer")
+	}
+	v3 := s.facts.Order.Customer.Age
+	v4 := float64(v3) < float64(int64(18))
+	v5 := v2 && v4
+	return v5, nil
+}
+
+// groolThenRejectMinor is the then scope of rule RejectMinor.
+func groolThenRejectMinor(s *groolSession) error {
+	if s.facts.Order == nil {
+		return errors.New("can not set Status of nil fact Order")
+	}
+	s.facts.Order.Status = "REJECTED"
+	s.changes++
+	if s.facts.Order == nil {
+		return errors.New("can not get Customer from nil fact Order")
+	}
+	if s.facts.Order.Customer == nil {
+		return errors.New("can not get Name from nil attribute Customer")
+	}
+	v6 := s.facts.Order.Customer.Name
+	v7 := "customer " + v6
+	v8 := v7 + " is "
+	if s.facts.Order == nil {
+		return errors.New("can not get Customer from nil fact Order")
+	}
+	if s.facts.Order.Customer == nil {
+		return errors.New("can not get Age from nil attribute Customer")
+	}
+	v9 := s.facts.Order.Customer.Age
+	v10 := fmt.Sprintf("%s%d", v8, int64(v9))
+	if s.facts.Order == nil {
+		return errors.New("can not call AddNote on nil fact Order")
+	}
+	s.facts.Order.AddNote(v10)
+	return nil
+}
+
+// groolWhenShipping is the when scope of rule Shipping.
+func groolWhenShipping(s *groolSession) (bool, error) {
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Processed from nil fact Order")
+	}
+	v1 := s.facts.Order.Processed
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Shipping from nil fact Order")
+	}
+	v2 := s.facts.Order.Shipping
+	v3 := v2 == float64(int64(0))
+	v4 := v1 && v3
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Status from nil fact Order")
+	}
+	v5 := s.facts.Order.Status
+	v6 := v5 == ""
+	v7 := v4 && v6
+	return v7, nil
+}
+
+// groolThenShipping is the then scope of rule Shipping.
+func groolThenShipping(s *groolSession) error {
+	if s.facts.Order == nil {
+		return errors.New("can not get Subtotal from nil fact Order")
+	}
+	v8 := s.facts.Order.Subtotal
+	if s.facts.Order == nil {
+		return errors.New("can not get Discount from nil fact Order")
+	}
+	v9 := s.facts.Order.Discount
+	v10, err := pkg.CheckedFloat("-", v8, v9)
+	if err != nil {
+		return err
+	}
+	v11 := v10 >= float64(int64(500))
+	if v11 {
+		if s.facts.Order == nil {
+			return errors.New("can not set Shipping of nil fact Order")
+		}
+		s.facts.Order.Shipping = float64(0.01)
+		s.changes++
+	} else {
+		if s.facts.Order == nil {
+			return errors.New("can not get Count from nil fact Order")
+		}
+		v12 := s.facts.Order.Count
+		v13 := float64(v12) > float64(int64(20))
+		if v13 {
+			if s.facts.Order == nil {
+				return errors.New("can not set Shipping of nil fact Order")
+			}
+			s.facts.Order.Shipping = float64(15)
+			s.changes++
+		} else {
+			if s.facts.Order == nil {
+				return errors.New("can not get Count from nil fact Order")
+			}
+			v14 := s.facts.Order.Count
+			v15, err := pkg.CheckedFloat("+", float64(7.5), float64(v14))
+			if err != nil {
+				return err
+			}
+			v16, err := pkg.CheckedFloat("*", v15, float64(0.5))
+			if err != nil {
+				return err
+			}
+			if s.facts.Order == nil {
+				return errors.New("can not set Shipping of nil fact Order")
+			}
+			s.facts.Order.Shipping = v16
+			s.changes++
+		}
+	}
+	if s.facts.Order == nil {
+		return errors.New("can not get Placed from nil fact Order")
+	}
+	v17 := s.facts.Order.Placed
+	if s.facts.Order == nil {
+		return errors.New("can not get Window from nil fact Order")
+	}
+	v18 := s.facts.Order.Window
+	v19 := v17.Add(time.Duration(v18))
+	if s.facts.Order == nil {
+		return errors.New("can not set Due of nil fact Order")
+	}
+	s.facts.Order.Due = v19
+	s.changes++
+	if s.facts.Order == nil {
+		return errors.New("can not get Window from nil fact Order")
+	}
+	v20 := s.facts.Order.Window
+	v21 := time.Duration(int64(v20) + int64(time.Duration(86400000000000)))
+	if s.facts.Order == nil {
+		return errors.New("can not set Window of nil fact Order")
+	}
+	s.facts.Order.Window = v21
+	s.changes++
+	return nil
+}
+
+// groolWhenSumItems is the when scope of rule SumItems.
+func groolWhenSumItems(s *groolSession) (bool, error) {
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Status from nil fact Order")
+	}
+	v1 := s.facts.Order.Status
+	v2 := v1 == ""
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Processed from nil fact Order")
+	}
+	v3 := s.facts.Order.Processed
+	v4 := v3 == false
+	v5 := v2 && v4
+	return v5, nil
+}
+
+// groolThenSumItems is the then scope of rule SumItems.
+func groolThenSumItems(s *groolSession) error {
+	if s.facts.Order == nil {
+		return errors.New("can not get Items from nil fact Order")
+	}
+	v6 := s.facts.Order.Items
+	if uint64(len(v6)) > s.maxLoopIteration {
+		return fmt.Errorf("loop over Order.Items have %d items, exceeding the maximum of %d iterations", len(v6), s.maxLoopIteration)
+	}
+	for _, v8 := range v6 {
+		if s.facts.Order == nil {
+			return errors.New("can not get Subtotal from nil fact Order")
+		}
+		v9 := s.facts.Order.Subtotal
+		if v8 == nil {
+			return errors.New("can not get Price from nil fact item")
+		}
+		v10 := v8.Price
+		v11, err := pkg.CheckedFloat("+", v9, v10)
+		if err != nil {
+			return err
+		}
+		if v8 == nil {
+			return errors.New("can not get Quantity from nil fact item")
+		}
+		v12 := v8.Quantity
+		v13, err := pkg.CheckedFloat("*", v11, float64(v12))
+		if err != nil {
+			return err
+		}
+		if s.facts.Order == nil {
+			return errors.New("can not set Subtotal of nil fact Order")
+		}
+		s.facts.Order.Subtotal = v13
+		s.changes++
+		if s.facts.Order == nil {
+			return errors.New("can not get Count from nil fact Order")
+		}
+		v14 := s.facts.Order.Count
+		if v8 == nil {
+			return errors.New("can not get Quantity from nil fact item")
+		}
+		v15 := v8.Quantity
+		v16, err := pkg.CheckedInt("+", int64(v14), int64(v15))
+		if err != nil {
+			return err
+		}
+		if s.facts.Order == nil {
+			return errors.New("can not set Count of nil fact Order")
+		}
+		s.facts.Order.Count = int(v16)
+		s.changes++
+		if v8 == nil {
+			return errors.New("can not get Category from nil fact item")
+		}
+		v17 := v8.Category
+		v18 := v17 == "LUXURY"
+		if v8 == nil {
+			return errors.New("can not get Price from nil fact item")
+		}
+		v19 := v8.Price
+		v20 := v19 > float64(int64(1000))
+		v21 := v18 && v20
+		if v21 {
+			if v8 == nil {
+				return errors.New("can not get Price from nil fact item")
+			}
+			v22 := v8.Price
+			v23, err := pkg.CheckedFloat("*", v22, float64(0.05))
+			if err != nil {
+				return err
+			}
+			if v8 == nil {
+				return errors.New("can not set Discount of nil fact item")
+			}
+			v8.Discount = v23
+			s.changes++
+		} else {
+			if v8 == nil {
+				return errors.New("can not get Category from nil fact item")
+			}
+			v24 := v8.Category
+			v25 := v24 == "FOOD"
+			if v25 {
+				if v8 == nil {
+					return errors.New("can not set Discount of nil fact item")
+				}
+				v8.Discount = float64(0)
+				s.changes++
+			} else {
+				if v8 == nil {
+					return errors.New("can not get Quantity from nil fact item")
+				}
+				v26 := v8.Quantity
+				v27 := float64(v26) > float64(int64(10))
+				var v28 float64
+				if v27 {
+					v28 = float64(2.5)
+				} else {
+					v28 = float64(0)
+				}
+				if v8 == nil {
+					return errors.New("can not set Discount of nil fact item")
+				}
+				v8.Discount = v28
+				s.changes++
+			}
+		}
+	}
+	if s.facts.Order == nil {
+		return errors.New("can not get Lines from nil fact Order")
+	}
+	v29 := s.facts.Order.Lines
+	if uint64(len(v29)) > s.maxLoopIteration {
+		return fmt.Errorf("loop over Order.Lines have %d items, exceeding the maximum of %d iterations", len(v29), s.maxLoopIteration)
+	}
+	for v30 := range v29 {
+		v31 := &v29[v30]
+		if v31 == nil {
+			return errors.New("can not set Packed of nil fact line")
+		}
+		v31.Packed = true
+		s.changes++
+		if s.facts.Order == nil {
+			return errors.New("can not get Units from nil fact Order")
+		}
+		v32 := s.facts.Order.Units
+		if v31 == nil {
+			return errors.New("can not get Amount from nil fact line")
+		}
+		v33 := v31.Amount
+		v34, err := pkg.CheckedUint("+", v32, uint64(v33))
+		if err != nil {
+			return err
+		}
+		if s.facts.Order == nil {
+			return errors.New("can not set Units of nil fact Order")
+		}
+		s.facts.Order.Units = v34
+		s.changes++
+	}
+	if s.facts.Order == nil {
+		return errors.New("can not set Processed of nil fact Order")
+	}
+	s.facts.Order.Processed = true
+	s.changes++
+	return nil
+}
+
+// groolWhenTotal is the when scope of rule Total.
+func groolWhenTotal(s *groolSession) (bool, error) {
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Processed from nil fact Order")
+	}
+	v1 := s.facts.Order.Processed
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Total from nil fact Order")
+	}
+	v2 := s.facts.Order.Total
+	v3 := v2 == float64(int64(0))
+	v4 := v1 && v3
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Status from nil fact Order")
+	}
+	v5 := s.facts.Order.Status
+	v6 := v5 == ""
+	v7 := v4 && v6
+	if s.facts.Order == nil {
+		return false, errors.New("can not get Shipping from nil fact Order")
+	}
+	v8 := s.facts.Order.Shipping
+	v9 := v8 > float64(int64(0))
+	v10 := v7 && v9
+	return v10, nil
+}
+
+// groolThenTotal is the then scope of rule Total.
+func groolThenTotal(s *groolSession) error {
+	if s.facts.Order == nil {
+		return errors.New("can not get Subtotal from nil fact Order")
+	}
+	v11 := s.facts.Order.Subtotal
+	if s.facts.Order == nil {
+		return errors.New("can not get Discount from nil fact Order")
+	}
+	v12 := s.facts.Order.Discount
+	v13, err := pkg.CheckedFloat("-", v11, v12)
+	if err != nil {
+		return err
+	}
+	if s.facts.Order == nil {
+		return errors.New("can not get Customer from nil fact Order")
+	}
+	if s.facts.Order.Customer == nil {
+		return errors.New("can not get Region from nil attribute Customer")
+	}
+	v14 := s.facts.Order.Customer.Region
+	if s.facts.Order == nil {
+		return errors.New("can not call TaxRate on nil fact Order")
+	}
+	v15, err := s.facts.Order.TaxRate(v14)
+	if err != nil {
+		return fmt.Errorf("function TaxRate() returns error: %v", err)
+	}
+	v16, err := pkg.CheckedFloat("+", float64(int64(1)), v15)
+	if err != nil {
+		return err
+	}
+	v17, err := pkg.CheckedFloat("*", v13, v16)
+	if err != nil {
+		return err
+	}
+	if s.facts.Order == nil {
+		return errors.New("can not get Shipping from nil fact Order")
+	}
+	v18 := s.facts.Order.Shipping
+	v19, err := pkg.CheckedFloat("+", v17, v18)
+	if err != nil {
+		return err
+	}
+	if s.facts.Order == nil {
+		return errors.New("can not set Total of nil fact Order")
+	}
+	s.facts.Order.Total = v19
+	s.changes++
+	if s.facts.Order == nil {
+		return errors.New("can not set Status of nil fact Order")
+	}
+	s.facts.Order.Status = "PRICED"
+	s.changes++
+	return nil
+}
@@ -0,0 +1,187 @@
+package codegen
+
+import (
+	"bytes"
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/engine"
+	"github.com/newm4n/grool/gen"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	log "github.com/sirupsen/logrus"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func buildRules(t testing.TB) *model.KnowledgeBase {
+	knowledgeBase := model.NewKnowledgeBase()
+	if err := builder.NewRuleBuilder(knowledgeBase).BuildRuleFromResource(pkg.NewFileResource("Rules.grl")); err != nil {
+		t.Fatal(err)
+	}
+	return knowledgeBase
+}
+
+func TestGenerate_UpToDate(t *testing.T) {
+	factPackage, err := gen.LoadPackage(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err := gen.Generate(buildRules(t), &gen.Config{
+		Package: factPackage,
+		Facts:   map[string]string{"Order": "Order"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	generated, err := ioutil.ReadFile("Rules.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(code, generated) {
+		t.Errorf("Rules.go is not up to date with Rules.grl, run go generate")
+	}
+}
+
+// randomOrder creates an order covering the paths of the rules, including the failing ones.
+func randomOrder(r *rand.Rand) *Order {
+	pick := func(values ...string) string {
+		return values[r.Intn(len(values))]
+	}
+	order := &Order{
+		ID:     pick("A", "B", "C"),
+		Placed: time.Date(2019, time.Month(1+r.Intn(12)), 1+r.Intn(28), r.Intn(24), 0, 0, 0, time.Local),
+		Window: time.Duration(r.Intn(24*30)) * time.Hour,
+	}
+	if r.Intn(20) > 0 {
+		order.Customer = &Customer{
+			Name:   pick("Alice", "Bob", "Calo"),
+			Age:    10 + r.Intn(60),
+			Member: r.Intn(2) == 0,
+			Tier:   pick("GOLD", "SILVER", ""),
+			Region: pick("ID", "SG", "US", "XX"),
+			Points: r.Int63n(2000),
+			Since:  time.Date(2015+r.Intn(6), time.Month(1+r.Intn(12)), 1, 0, 0, 0, 0, time.Local),
+		}
+		if r.Intn(10) == 0 {
+			// big enough for the multiplier to overflow.
+			order.Customer.Points = 1<<62 + r.Int63n(1000)
+		}
+	}
+	for i := r.Intn(5); i > 0; i-- {
+		order.Items = append(order.Items, &Item{
+			Name:     pick("Bag", "Rice", "Ball"),
+			Category: pick("LUXURY", "FOOD", "TOY"),
+			Price:    float64(1+r.Intn(200000)) / 100,
+			Quantity: r.Intn(16),
+		})
+	}
+	for i := r.Intn(4); i > 0; i-- {
+		order.Lines = append(order.Lines, Line{Item: pick("Bag", "Rice"), Amount: uint32(r.Intn(100))})
+	}
+	return order
+}
+
+// copyOrder copies the order deeply, so both engines work on the same facts.
+func copyOrder(order *Order) *Order {
+	copied := *order
+	if order.Customer != nil {
+		customer := *order.Customer
+		copied.Customer = &customer
+	}
+	copied.Items = nil
+	for _, item := range order.Items {
+		itemCopy := *item
+		copied.Items = append(copied.Items, &itemCopy)
+	}
+	copied.Lines = append([]Line(nil), order.Lines...)
+	return &copied
+}
+
+type executionResult struct {
+	Order *Order
+	Fired []string
+	Err   string
+}
+
+func TestRuleEngine_Equivalence(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	knowledgeBase := buildRules(t)
+	r := rand.New(rand.NewSource(49))
+	failures := 0
+	for i := 0; i < 500; i++ {
+		order := randomOrder(r)
+
+		interpreted := &executionResult{Order: copyOrder(order)}
+		dataContext := context.NewDataContext()
+		if err := dataContext.Add("Order", interpreted.Order); err != nil {
+			t.Fatal(err)
+		}
+		groolEngine := engine.NewGroolEngine()
+		groolEngine.MaxCycle = 100
+		groolEngine.OnRuleExecuted = func(entry *model.RuleEntry) {
+			interpreted.Fired = append(interpreted.Fired, entry.RuleName)
+		}
+		if err := groolEngine.Execute(dataContext, knowledgeBase); err != nil {
+			interpreted.Err = err.Error()
+		}
+
+		generated := &executionResult{Order: copyOrder(order)}
+		ruleEngine := NewRuleEngine()
+		ruleEngine.MaxCycle = 100
+		ruleEngine.OnRuleExecuted = func(ruleName string) {
+			generated.Fired = append(generated.Fired, ruleName)
+		}
+		if err := ruleEngine.Execute(&RuleFacts{Order: generated.Order}); err != nil {
+			generated.Err = err.Error()
+		}
+
+		// the generated engine names its own max cycle variable.
+		generated.Err = strings.Replace(generated.Err, "RuleEngine.MaxCycle", "Grool.MaxCycle", 1)
+		if interpreted.Err != generated.Err {
+			t.Errorf("sample #%d: engine error %q, generated code error %q", i, interpreted.Err, generated.Err)
+		}
+		if !reflect.DeepEqual(interpreted, generated) {
+			t.Errorf("sample #%d: engine got %+v %+v, generated code got %+v %+v", i, interpreted, interpreted.Order, generated, generated.Order)
+		}
+		if interpreted.Err != "" {
+			failures++
+		}
+	}
+	// the samples must cover both the successful and the failing executions.
+	if failures == 0 || failures == 500 {
+		t.Errorf("%d of 500 samples failed", failures)
+	}
+}
+
+func BenchmarkGrool_Execute(b *testing.B) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	knowledgeBase := buildRules(b)
+	order := randomOrder(rand.New(rand.NewSource(1)))
+	groolEngine := engine.NewGroolEngine()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dataContext := context.NewDataContext()
+		if err := dataContext.Add("Order", copyOrder(order)); err != nil {
+			b.Fatal(err)
+		}
+		groolEngine.Execute(dataContext, knowledgeBase)
+	}
+}
+
+func BenchmarkRuleEngine_Execute(b *testing.B) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	order := randomOrder(rand.New(rand.NewSource(1)))
+	ruleEngine := NewRuleEngine()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ruleEngine.Execute(&RuleFacts{Order: copyOrder(order)})
+	}
+}
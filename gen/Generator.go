@@ -0,0 +1,1128 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/juju/errors"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"go/format"
+	"go/types"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Header is the first line of every generated file.
+const Header = "// Code generated by grool gen. DO NOT EDIT."
+
+// Config tells the generator the package the generated code belongs to and the fact types the rules work on.
+type Config struct {
+	// Package is the package of the fact types, as loaded by LoadPackage.
+	Package *types.Package
+	// Facts maps each fact name used by the rules to the name of its struct type in the package.
+	Facts map[string]string
+}
+
+// Generate turns the rule entries of the knowledge base into Go code of the configured package.
+// The generated RuleEngine executes the rules against a RuleFacts holding the facts, without reflection, following
+// the same salience and cycle semantics, the same math and comparison rules and the same errors as engine.Grool.
+// Rule constructs the generator does not support, or that would always fail at runtime, are returned as model.TypeErrors.
+func Generate(knowledge *model.KnowledgeBase, config *Config) ([]byte, error) {
+	g := &generator{
+		config:  config,
+		naming:  pkg.FieldNaming{JSONTags: knowledge.JSONTags},
+		facts:   make(map[string]types.Type, len(config.Facts)),
+		imports: make(map[string]string),
+	}
+	factNames := make([]string, 0, len(config.Facts))
+	for name := range config.Facts {
+		factNames = append(factNames, name)
+	}
+	sort.Strings(factNames)
+	for _, name := range factNames {
+		typeName, ok := config.Package.Scope().Lookup(config.Facts[name]).(*types.TypeName)
+		if !ok {
+			return nil, errors.Errorf("type %s of fact %s is not found in package %s", config.Facts[name], name, config.Package.Name())
+		}
+		if _, ok := typeName.Type().Underlying().(*types.Struct); !ok {
+			return nil, errors.Errorf("type %s of fact %s is not a struct", config.Facts[name], name)
+		}
+		g.facts[name] = types.NewPointer(typeName.Type())
+	}
+
+	entries := make([]*model.RuleEntry, 0, len(knowledge.RuleEntries))
+	for _, entry := range knowledge.RuleEntries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].RuleName < entries[j].RuleName
+	})
+	rules := &bytes.Buffer{}
+	for _, entry := range entries {
+		g.rule(rules, entry)
+	}
+	if len(g.errors) > 0 {
+		return nil, g.errors
+	}
+
+	body := &bytes.Buffer{}
+	fmt.Fprintf(body, "// RuleFacts holds the facts the rules work on.\ntype RuleFacts struct {\n")
+	for _, name := range factNames {
+		fmt.Fprintf(body, "%s %s\n", name, g.typeString(g.facts[name]))
+	}
+	fmt.Fprintf(body, "}\n")
+	fmt.Fprintf(body, runtimeTemplate, context.DefaultMaxLoopIteration, context.DefaultMaxLoopIteration)
+	if g.functions {
+		fmt.Fprintf(body, "\nvar groolFunctions = &%s{}\n", g.qualified(reflect.TypeOf(model.GroolFunctions{})))
+	}
+	fmt.Fprintf(body, "\nvar groolRules = []*groolRule{\n")
+	for _, entry := range entries {
+		fmt.Fprintf(body, "{name: %q, salience: %d, when: groolWhen%s, then: groolThen%s},\n", entry.RuleName, entry.Salience, entry.RuleName, entry.RuleName)
+	}
+	fmt.Fprintf(body, "}\n")
+	body.Write(rules.Bytes())
+
+	g.imports["fmt"] = "fmt"
+	g.imports["sort"] = "sort"
+	g.imports["github.com/sirupsen/logrus"] = "log"
+	paths := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	src := &bytes.Buffer{}
+	fmt.Fprintf(src, "%s\n\npackage %s\n\nimport (\n", Header, config.Package.Name())
+	for _, path := range paths {
+		if name := g.imports[path]; name != path[strings.LastIndex(path, "/")+1:] {
+			fmt.Fprintf(src, "%s %q\n", name, path)
+		} else {
+			fmt.Fprintf(src, "%q\n", path)
+		}
+	}
+	fmt.Fprintf(src, ")\n\n")
+	src.Write(body.Bytes())
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, errors.Annotatef(err, "generated code is invalid")
+	}
+	return formatted, nil
+}
+
+// runtimeTemplate is the engine executing the generated rules, it mirrors engine.Grool Execute.
+const runtimeTemplate = `
+// RuleEngine executes the rules compiled into Go code, following the salience and cycle semantics of engine.Grool.
+type RuleEngine struct {
+	MaxCycle uint64
+	// MaxLoopIteration limits the number of iteration of a single loop in the then scope. Zero means default.
+	MaxLoopIteration uint64
+	// OnRuleExecuted, if set, is called after each successful execution of a rule.
+	OnRuleExecuted func(ruleName string)
+}
+
+// NewRuleEngine creates a rule engine with a max cycle of 5000.
+func NewRuleEngine() *RuleEngine {
+	return &RuleEngine{
+		MaxCycle:         5000,
+		MaxLoopIteration: %d,
+	}
+}
+
+// Execute executes the rules against the facts, until no rule can be executed or an executed rule changes nothing.
+func (e *RuleEngine) Execute(facts *RuleFacts) error {
+	s := &groolSession{
+		facts:            facts,
+		maxLoopIteration: e.MaxLoopIteration,
+		retracted:        make(map[string]bool),
+	}
+	if s.maxLoopIteration == 0 {
+		s.maxLoopIteration = %d
+	}
+	runnable := make([]*groolRule, 0, len(groolRules))
+	var cycle uint64
+	for {
+		cycle++
+		if cycle > e.MaxCycle {
+			return fmt.Errorf("Grool successfully selected rule candidate for execution after %%d cycles, this could possibly caused by rule entry(s) that keep added into execution pool but when executed it does not change any data in context. Please evaluate your rule entries \"When\" and \"Then\" scope. You can adjust the maximum cycle using RuleEngine.MaxCycle variable.", e.MaxCycle)
+		}
+		runnable = runnable[:0]
+		for _, rule := range groolRules {
+			if s.retracted[rule.name] {
+				continue
+			}
+			can, err := rule.when(s)
+			if err != nil {
+				log.Errorf("Failed testing condition for rule : %%s. Got error %%v", rule.name, err)
+			}
+			if can {
+				runnable = append(runnable, rule)
+			}
+		}
+		if len(runnable) == 0 {
+			return nil
+		}
+		sort.SliceStable(runnable, func(i, j int) bool {
+			return runnable[i].salience > runnable[j].salience
+		})
+		cycleDone := true
+		for _, rule := range runnable {
+			s.changes = 0
+			if err := rule.then(s); err != nil {
+				log.Errorf("Failed execution rule : %%s. Got error %%v", rule.name, err)
+				return err
+			}
+			if e.OnRuleExecuted != nil {
+				e.OnRuleExecuted(rule.name)
+			}
+			if s.changes > 0 {
+				cycleDone = false
+				break
+			}
+		}
+		if cycleDone {
+			return nil
+		}
+	}
+}
+
+// groolSession is the state of a single execution.
+type groolSession struct {
+	facts            *RuleFacts
+	maxLoopIteration uint64
+	retracted        map[string]bool
+	changes          uint64
+}
+
+// groolRule is a rule compiled into Go code.
+type groolRule struct {
+	name     string
+	salience int64
+	when     func(s *groolSession) (bool, error)
+	then     func(s *groolSession) error
+}
+`
+
+type generator struct {
+	config    *Config
+	naming    pkg.FieldNaming
+	facts     map[string]types.Type
+	imports   map[string]string
+	functions bool
+	errors    model.TypeErrors
+}
+
+// qualifier names the packages in the generated code, recording their import.
+func (g *generator) qualifier(p *types.Package) string {
+	if p == g.config.Package {
+		return ""
+	}
+	g.imports[p.Path()] = p.Name()
+	return p.Name()
+}
+
+func (g *generator) typeString(typ types.Type) string {
+	return types.TypeString(typ, g.qualifier)
+}
+
+// qualified names a Go type from outside the package of the generated code.
+func (g *generator) qualified(typ reflect.Type) string {
+	g.imports[typ.PkgPath()] = typ.PkgPath()[strings.LastIndex(typ.PkgPath(), "/")+1:]
+	return typ.String()
+}
+
+func (g *generator) use(path string) {
+	g.imports[path] = path[strings.LastIndex(path, "/")+1:]
+}
+
+// field resolves the struct field the rules refer to by the name, like pkg.FieldNaming FieldByName.
+func (g *generator) field(typ types.Type, name string) (*types.Var, reflect.StructField, bool) {
+	st := structOf(typ)
+	if st == nil {
+		return nil, reflect.StructField{}, false
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		field := reflect.StructField{Name: st.Field(i).Name(), Tag: reflect.StructTag(st.Tag(i))}
+		if alias := g.naming.FieldName(field); alias != field.Name && alias == name {
+			return st.Field(i), field, st.Field(i).Exported()
+		}
+	}
+	obj, index, _ := types.LookupFieldOrMethod(typ, true, g.config.Package, name)
+	v, ok := obj.(*types.Var)
+	if !ok || !v.IsField() || !v.Exported() {
+		return nil, reflect.StructField{}, false
+	}
+	// the tag of a promoted field is found walking the embedded fields.
+	var field reflect.StructField
+	for _, idx := range index {
+		st := structOf(typ)
+		field = reflect.StructField{Name: st.Field(idx).Name(), Tag: reflect.StructTag(st.Tag(idx))}
+		typ = st.Field(idx).Type()
+	}
+	if g.naming.FieldName(field) == "" {
+		return nil, reflect.StructField{}, false
+	}
+	return v, field, true
+}
+
+func (g *generator) rule(out *bytes.Buffer, entry *model.RuleEntry) {
+	rg := &ruleGen{
+		g:     g,
+		entry: entry,
+		out:   &bytes.Buffer{},
+		scope: make(map[string]*value),
+		fail:  "false, ",
+	}
+	fmt.Fprintf(out, "\n// groolWhen%s is the when scope of rule %s.\n", entry.RuleName, entry.RuleName)
+	fmt.Fprintf(out, "func groolWhen%s(s *groolSession) (bool, error) {\n", entry.RuleName)
+	result := "false"
+	if entry.WhenScope == nil || entry.WhenScope.Expression == nil {
+		rg.errorf(entry.Line, entry.Column, "rule has no when scope")
+	} else if val := rg.expression(entry.WhenScope.Expression); rg.hasValue(entry.Line, entry.Column, val) {
+		if kindOf(val.typ) != reflect.Bool {
+			rg.errorf(entry.WhenScope.Expression.Line, entry.WhenScope.Expression.Column, "when scope must be a boolean expression, not %s", val.typ)
+		}
+		result = rg.convert(val, types.Typ[types.Bool])
+	}
+	fmt.Fprintf(out, "%sreturn %s, nil\n}\n", rg.out.String(), result)
+
+	rg.out = &bytes.Buffer{}
+	rg.fail = ""
+	fmt.Fprintf(out, "\n// groolThen%s is the then scope of rule %s.\n", entry.RuleName, entry.RuleName)
+	fmt.Fprintf(out, "func groolThen%s(s *groolSession) error {\n", entry.RuleName)
+	if entry.ThenScope != nil {
+		rg.assignExpressions(entry.ThenScope.AssignExpressions)
+	}
+	fmt.Fprintf(out, "%sreturn nil\n}\n", rg.out.String())
+}
+
+// value is the Go expression of a rule expression, and its type. A nil type is no value, the result of a void call.
+type value struct {
+	expr string
+	typ  types.Type
+	used bool
+}
+
+// ruleGen generates the functions of a rule entry. It writes the statements computing each expression into out,
+// and returns the Go expression of the result, which is a variable or a constant so it can be used in any order.
+type ruleGen struct {
+	g     *generator
+	entry *model.RuleEntry
+	out   *bytes.Buffer
+	temps int
+	scope map[string]*value
+	// fail is what the generated function returns along an error.
+	fail string
+}
+
+func (rg *ruleGen) errorf(line, column int, format string, args ...interface{}) {
+	rg.g.errors = append(rg.g.errors, &model.TypeError{
+		RuleName: rg.entry.RuleName,
+		Line:     line,
+		Column:   column,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (rg *ruleGen) printf(format string, args ...interface{}) {
+	fmt.Fprintf(rg.out, format, args...)
+	rg.out.WriteString("\n")
+}
+
+func (rg *ruleGen) temp() string {
+	rg.temps++
+	return fmt.Sprintf("v%d", rg.temps)
+}
+
+// capture returns what the function generates, instead of writing it.
+func (rg *ruleGen) capture(fn func()) string {
+	out := rg.out
+	rg.out = &bytes.Buffer{}
+	fn()
+	captured := rg.out.String()
+	rg.out = out
+	return captured
+}
+
+// failWith generates the return of an error with the message.
+func (rg *ruleGen) failWith(msg string) {
+	rg.g.use("errors")
+	rg.printf("return %serrors.New(%q)", rg.fail, msg)
+}
+
+// checkError generates the return of the err variable if it is not nil.
+func (rg *ruleGen) checkError() {
+	rg.printf("if err != nil {\nreturn %serr\n}", rg.fail)
+}
+
+// assign stores the Go expression into a new variable.
+func (rg *ruleGen) assign(expr string, typ types.Type) *value {
+	v := rg.temp()
+	rg.printf("%s := %s", v, expr)
+	return &value{expr: v, typ: typ}
+}
+
+// convert returns the Go expression of the value converted into the type.
+func (rg *ruleGen) convert(val *value, typ types.Type) string {
+	if types.Identical(val.typ, typ) {
+		return val.expr
+	}
+	return fmt.Sprintf("%s(%s)", rg.g.typeString(typ), val.expr)
+}
+
+// nilMessages returns the runtime error of a variable path element being nil, given the nil element name and the next
+// element name, next is empty for the last element. The root element is the first one, its name is empty if it has none.
+type nilMessages func(name, next string, root bool) string
+
+func getMessages(name, next string, root bool) string {
+	if root {
+		return fmt.Sprintf("can not get %s from nil fact %s", next, name)
+	}
+	return fmt.Sprintf("can not get %s from nil attribute %s", next, name)
+}
+
+// root resolves the first element of a variable path, which is a loop variable or a fact.
+func (rg *ruleGen) root(name string, line, column int) *value {
+	if val, ok := rg.scope[name]; ok {
+		val.used = true
+		return &value{expr: val.expr, typ: val.typ}
+	}
+	if typ, ok := rg.g.facts[name]; ok {
+		return &value{expr: "s.facts." + name, typ: typ}
+	}
+	rg.errorf(line, column, "fact %s is not declared", name)
+	return nil
+}
+
+// walk generates the access to the members of the value named rootName. Stepping through a nil pointer fails with
+// the message, the last member is checked too if deref is set.
+func (rg *ruleGen) walk(val *value, rootName string, names []string, deref bool, messages nilMessages, line, column int) *value {
+	name, root := rootName, true
+	for i := 0; ; i++ {
+		last := i == len(names)
+		if kindOf(val.typ) == reflect.Ptr && (!last || deref) {
+			next := ""
+			if !last {
+				next = names[i]
+			}
+			rg.printf("if %s == nil {", val.expr)
+			rg.failWith(messages(name, next, root))
+			rg.printf("}")
+		}
+		if last {
+			return val
+		}
+		field, _, ok := rg.g.field(val.typ, names[i])
+		if !ok {
+			if structOf(val.typ) == nil {
+				rg.errorf(line, column, "%s is not a struct, it has no member %s", val.typ, names[i])
+			} else {
+				rg.errorf(line, column, "%s has no member %s", val.typ, names[i])
+			}
+			return nil
+		}
+		val = &value{expr: val.expr + "." + field.Name(), typ: field.Type()}
+		name, root = names[i], false
+	}
+}
+
+// load generates the read of a variable path into a new variable.
+func (rg *ruleGen) load(variable string, line, column int) *value {
+	if strings.Contains(variable, "?") {
+		rg.errorf(line, column, "null-safe variable %s is not supported", variable)
+		return nil
+	}
+	names := strings.Split(variable, ".")
+	val := rg.root(names[0], line, column)
+	if val == nil {
+		return nil
+	}
+	if val = rg.walk(val, names[0], names[1:], false, getMessages, line, column); val == nil {
+		return nil
+	}
+	return rg.member(val)
+}
+
+// member stores the member value into a new variable. A struct is taken by its address, so a method call on it
+// does not work on a copy.
+func (rg *ruleGen) member(val *value) *value {
+	if kindOf(val.typ) == reflect.Struct && !isTime(val.typ) {
+		return rg.assign("&"+val.expr, types.NewPointer(val.typ))
+	}
+	return rg.assign(val.expr, val.typ)
+}
+
+// hasValue checks the values are not the no value of a void call.
+func (rg *ruleGen) hasValue(line, column int, vals ...*value) bool {
+	for _, val := range vals {
+		if val == nil {
+			return false
+		}
+		if val.typ == nil {
+			rg.errorf(line, column, "void function call has no value")
+			return false
+		}
+	}
+	return true
+}
+
+func (rg *ruleGen) expression(expr *model.Expression) *value {
+	if expr.Predicate != nil {
+		return rg.predicate(expr.Predicate)
+	}
+	if expr.Conditional {
+		return rg.conditional(expr)
+	}
+	if expr.LeftExpression == nil || expr.RightExpression == nil {
+		rg.errorf(expr.Line, expr.Column, "expression %s has no predicate nor operands", expr.Text)
+		return nil
+	}
+	// both operands are evaluated, like the rule engine does.
+	left := rg.expression(expr.LeftExpression)
+	right := rg.expression(expr.RightExpression)
+	if !rg.hasValue(expr.Line, expr.Column, left, right) {
+		return nil
+	}
+	if kindOf(left.typ) != reflect.Bool || kindOf(right.typ) != reflect.Bool {
+		rg.errorf(expr.Line, expr.Column, "logical operator can only be applied to boolean expression, not %s and %s", left.typ, right.typ)
+		return nil
+	}
+	operator := "&&"
+	if expr.LogicalOperator == model.LogicalOperatorOr {
+		operator = "||"
+	}
+	boolType := types.Typ[types.Bool]
+	return rg.assign(fmt.Sprintf("%s %s %s", rg.convert(left, boolType), operator, rg.convert(right, boolType)), boolType)
+}
+
+func (rg *ruleGen) conditional(expr *model.Expression) *value {
+	cond := rg.expression(expr.ConditionExpression)
+	if !rg.hasValue(expr.Line, expr.Column, cond) {
+		return nil
+	}
+	if kindOf(cond.typ) != reflect.Bool {
+		rg.errorf(expr.ConditionExpression.Line, expr.ConditionExpression.Column, "condition of conditional expression must be a boolean expression, not %s", cond.typ)
+		return nil
+	}
+	var left, right *value
+	leftCode := rg.capture(func() { left = rg.expression(expr.LeftExpression) })
+	rightCode := rg.capture(func() { right = rg.expression(expr.RightExpression) })
+	if !rg.hasValue(expr.Line, expr.Column, left, right) {
+		return nil
+	}
+	typ := left.typ
+	if !sameType(left.typ, right.typ) {
+		// numbers of the same base kind behave the same, except as method argument.
+		if !isNumber(left.typ) || baseKind(left.typ) != baseKind(right.typ) {
+			rg.errorf(expr.Line, expr.Column, "both values of conditional expression must have the same type, not %s and %s", left.typ, right.typ)
+			return nil
+		}
+		typ = baseType(baseKind(left.typ))
+	}
+	if typ == nil {
+		rg.errorf(expr.Line, expr.Column, "conditional expression has no value")
+		return nil
+	}
+	v := rg.temp()
+	rg.printf("var %s %s", v, rg.g.typeString(typ))
+	rg.printf("if %s {\n%s%s = %s\n} else {\n%s%s = %s\n}", rg.convert(cond, types.Typ[types.Bool]),
+		leftCode, v, rg.convert(left, typ), rightCode, v, rg.convert(right, typ))
+	return &value{expr: v, typ: typ}
+}
+
+func (rg *ruleGen) predicate(prdct *model.Predicate) *value {
+	switch prdct.ComparisonOperator {
+	case model.ComparisonOperatorIn, model.ComparisonOperatorNotIn, model.ComparisonOperatorContains, model.ComparisonOperatorMatches:
+		rg.errorf(prdct.Line, prdct.Column, "%s operator is not supported", prdct.ComparisonOperator)
+		return nil
+	}
+	left := rg.expressionAtom(prdct.ExpressionAtomLeft)
+	if prdct.ExpressionAtomRight == nil {
+		return left
+	}
+	right := rg.expressionAtom(prdct.ExpressionAtomRight)
+	if !rg.hasValue(prdct.Line, prdct.Column, left, right) {
+		return nil
+	}
+	return rg.compare(prdct, left, right)
+}
+
+// compare generates the comparison of two values like model.Predicate does.
+func (rg *ruleGen) compare(prdct *model.Predicate, left, right *value) *value {
+	op := prdct.ComparisonOperator
+	boolType := types.Typ[types.Bool]
+	lk, rk := kindOf(left.typ), kindOf(right.typ)
+	switch {
+	case isTime(left.typ) && isTime(right.typ):
+		l, r := left.expr, right.expr
+		var expr string
+		switch op {
+		case model.ComparisonOperatorEQ:
+			expr = fmt.Sprintf("%s.Equal(%s)", l, r)
+		case model.ComparisonOperatorNEQ:
+			expr = fmt.Sprintf("!%s.Equal(%s)", l, r)
+		case model.ComparisonOperatorGT:
+			expr = fmt.Sprintf("%s.After(%s)", l, r)
+		case model.ComparisonOperatorGTE:
+			expr = fmt.Sprintf("%s.After(%s) || %s.Equal(%s)", l, r, l, r)
+		case model.ComparisonOperatorLT:
+			expr = fmt.Sprintf("%s.Before(%s)", l, r)
+		default:
+			expr = fmt.Sprintf("%s.Before(%s) || %s.Equal(%s)", l, r, l, r)
+		}
+		return rg.assign(expr, boolType)
+	case lk == reflect.String && rk == reflect.String:
+		stringType := types.Typ[types.String]
+		return rg.assign(fmt.Sprintf("%s %s %s", rg.convert(left, stringType), op, rg.convert(right, stringType)), boolType)
+	case lk == rk && (op == model.ComparisonOperatorEQ || op == model.ComparisonOperatorNEQ):
+		typ := baseType(baseKind(left.typ))
+		if typ == nil || lk == reflect.String {
+			break
+		}
+		return rg.assign(fmt.Sprintf("%s %s %s", rg.convert(left, typ), op, rg.convert(right, typ)), boolType)
+	case isNumber(left.typ) && isNumber(right.typ):
+		floatType := types.Typ[types.Float64]
+		return rg.assign(fmt.Sprintf("%s %s %s", rg.convert(left, floatType), op, rg.convert(right, floatType)), boolType)
+	}
+	rg.errorf(prdct.Line, prdct.Column, "can not compare %s with %s using %s", left.typ, right.typ, op)
+	return nil
+}
+
+func (rg *ruleGen) expressionAtom(exprAtm *model.ExpressionAtom) *value {
+	switch {
+	case exprAtm == nil:
+		return nil
+	case len(exprAtm.Variable) > 0:
+		return rg.load(exprAtm.Variable, exprAtm.Line, exprAtm.Column)
+	case exprAtm.Constant != nil:
+		return rg.constant(exprAtm.Constant, exprAtm.Line, exprAtm.Column)
+	case exprAtm.FunctionCall != nil:
+		return rg.functionCall(exprAtm.FunctionCall, false)
+	case exprAtm.MethodCall != nil:
+		return rg.methodCall(exprAtm.MethodCall, false)
+	case exprAtm.Selector != nil:
+		receiver := rg.expressionAtom(exprAtm.ExpressionAtomLeft)
+		if receiver == nil {
+			return nil
+		}
+		return rg.selector(receiver, exprAtm.Selector, false)
+	case exprAtm.NullCoalescing:
+		rg.errorf(exprAtm.Line, exprAtm.Column, "null coalescing operator is not supported")
+		return nil
+	default:
+		left := rg.expressionAtom(exprAtm.ExpressionAtomLeft)
+		right := rg.expressionAtom(exprAtm.ExpressionAtomRight)
+		if !rg.hasValue(exprAtm.Line, exprAtm.Column, left, right) {
+			return nil
+		}
+		return rg.math(exprAtm, left, right)
+	}
+}
+
+// math generates a math operation like the pkg Value functions do.
+func (rg *ruleGen) math(exprAtm *model.ExpressionAtom, left, right *value) *value {
+	op := exprAtm.MathOperator
+	durationTyp := durationType
+	switch {
+	case op == model.MathOperatorPlus && isTime(left.typ) && isDuration(right.typ):
+		rg.g.use("time")
+		return rg.assign(fmt.Sprintf("%s.Add(time.Duration(%s))", left.expr, right.expr), left.typ)
+	case op == model.MathOperatorPlus && isDuration(left.typ) && isTime(right.typ):
+		rg.g.use("time")
+		return rg.assign(fmt.Sprintf("%s.Add(time.Duration(%s))", right.expr, left.expr), right.typ)
+	case op == model.MathOperatorPlus && isDuration(left.typ) && isDuration(right.typ):
+		rg.g.use("time")
+		return rg.assign(fmt.Sprintf("time.Duration(int64(%s) + int64(%s))", left.expr, right.expr), durationTyp)
+	case op == model.MathOperatorMinus && isTime(left.typ) && isDuration(right.typ):
+		rg.g.use("time")
+		return rg.assign(fmt.Sprintf("%s.Add(-time.Duration(%s))", left.expr, right.expr), left.typ)
+	case op == model.MathOperatorMinus && isTime(left.typ) && isTime(right.typ):
+		return rg.assign(fmt.Sprintf("%s.Sub(%s)", left.expr, right.expr), durationTyp)
+	case op == model.MathOperatorMinus && isDuration(left.typ) && isDuration(right.typ):
+		rg.g.use("time")
+		return rg.assign(fmt.Sprintf("time.Duration(int64(%s) - int64(%s))", left.expr, right.expr), durationTyp)
+	case op != model.MathOperatorPlus && op != model.MathOperatorMinus && op != model.MathOperatorMul && op != model.MathOperatorDiv:
+		rg.errorf(exprAtm.Line, exprAtm.Column, "%s operator is not supported", op)
+		return nil
+	case isNumber(left.typ) && isNumber(right.typ):
+		return rg.checkedMath(op, left, right)
+	case op == model.MathOperatorPlus:
+		if val := rg.concat(left, right); val != nil {
+			return val
+		}
+	}
+	rg.errorf(exprAtm.Line, exprAtm.Column, "can not apply %s between %s and %s", op, left.typ, right.typ)
+	return nil
+}
+
+// checkedMath generates a math operation between numbers, which fails on overflow, division by zero or a result
+// that is not finite. Both unsigned yields an unsigned, any float yields a float, otherwise it yields a signed integer.
+func (rg *ruleGen) checkedMath(op model.MathOperator, left, right *value) *value {
+	lk, rk := baseKind(left.typ), baseKind(right.typ)
+	fn, lt, rt := "CheckedInt", types.Typ[types.Int64], types.Typ[types.Int64]
+	switch {
+	case lk == reflect.Float64 || rk == reflect.Float64:
+		fn, lt, rt = "CheckedFloat", types.Typ[types.Float64], types.Typ[types.Float64]
+	case lk == reflect.Uint64 && rk == reflect.Uint64:
+		fn, lt, rt = "CheckedUint", types.Typ[types.Uint64], types.Typ[types.Uint64]
+	case lk == reflect.Uint64:
+		fn, lt = "CheckedUintInt", types.Typ[types.Uint64]
+	case rk == reflect.Uint64:
+		fn, rt = "CheckedIntUint", types.Typ[types.Uint64]
+	}
+	typ := lt
+	if lk != rk && lk != reflect.Float64 && rk != reflect.Float64 {
+		typ = types.Typ[types.Int64]
+	}
+	rg.g.use("github.com/newm4n/grool/pkg")
+	v := rg.temp()
+	rg.printf("%s, err := pkg.%s(%q, %s, %s)", v, fn, op.String(), rg.convert(left, lt), rg.convert(right, rt))
+	rg.checkError()
+	return &value{expr: v, typ: typ}
+}
+
+// concat generates the string concatenation of pkg.ValueAdd, it returns nil if the values can not be concatenated.
+func (rg *ruleGen) concat(left, right *value) *value {
+	lk, rk := baseKind(left.typ), baseKind(right.typ)
+	stringType := types.Typ[types.String]
+	verbs := map[reflect.Kind]string{
+		reflect.Int64:   "%d",
+		reflect.Uint64:  "%d",
+		reflect.Float64: "%f",
+		reflect.String:  "%s",
+		reflect.Bool:    "%v",
+	}
+	switch {
+	case lk == reflect.String && rk == reflect.String:
+		return rg.assign(fmt.Sprintf("%s + %s", rg.convert(left, stringType), rg.convert(right, stringType)), stringType)
+	case lk == reflect.String && verbs[rk] != "":
+		return rg.assign(fmt.Sprintf("fmt.Sprintf(%q, %s, %s)", "%s"+verbs[rk], rg.convert(left, stringType), rg.convert(right, baseType(rk))), stringType)
+	case rk == reflect.String && isNumber(left.typ):
+		return rg.assign(fmt.Sprintf("fmt.Sprintf(%q, %s, %s)", verbs[lk]+"%s", rg.convert(left, baseType(lk)), rg.convert(right, stringType)), stringType)
+	default:
+		return nil
+	}
+}
+
+// constant generates the Go literal of a scalar constant.
+func (rg *ruleGen) constant(cons *model.Constant, line, column int) *value {
+	if cons.ConstantKind != model.ConstantKindScalar {
+		rg.errorf(line, column, "list, map and struct literal are not supported")
+		return nil
+	}
+	val := cons.ConstantValue
+	if !val.IsValid() {
+		rg.errorf(line, column, "null literal is not supported")
+		return nil
+	}
+	switch val.Type() {
+	case reflectDurationType:
+		rg.g.use("time")
+		return &value{expr: fmt.Sprintf("time.Duration(%d)", val.Int()), typ: durationType}
+	case reflectTimeType:
+		t := val.Interface().(time.Time)
+		location := ""
+		switch t.Location() {
+		case time.Local:
+			location = "time.Local"
+		case time.UTC:
+			location = "time.UTC"
+		default:
+			rg.errorf(line, column, "time literal in %s location is not supported", t.Location())
+			return nil
+		}
+		rg.g.use("time")
+		return &value{expr: fmt.Sprintf("time.Date(%d, %d, %d, %d, %d, %d, %d, %s)", t.Year(), t.Month(), t.Day(),
+			t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), location), typ: timeType}
+	}
+	// a folded constant may hold any basic kind, such as the int of GetTimeYear.
+	typ, ok := fromReflect(val.Type()).(*types.Basic)
+	if !ok {
+		rg.errorf(line, column, "%s literal is not supported", val.Type())
+		return nil
+	}
+	switch pkg.GetBaseKind(val) {
+	case reflect.Int64:
+		return &value{expr: fmt.Sprintf("%s(%d)", typ.Name(), val.Int()), typ: typ}
+	case reflect.Uint64:
+		return &value{expr: fmt.Sprintf("%s(%d)", typ.Name(), val.Uint()), typ: typ}
+	case reflect.Float64:
+		return &value{expr: fmt.Sprintf("%s(%s)", typ.Name(), strconv.FormatFloat(val.Float(), 'g', -1, 64)), typ: typ}
+	case reflect.String:
+		return &value{expr: strconv.Quote(val.String()), typ: typ}
+	case reflect.Bool:
+		return &value{expr: strconv.FormatBool(val.Bool()), typ: typ}
+	}
+	rg.errorf(line, column, "%s literal is not supported", val.Type())
+	return nil
+}
+
+func (rg *ruleGen) arguments(funcArg *model.FunctionArgument, line, column int) ([]*value, bool) {
+	if funcArg == nil {
+		return nil, true
+	}
+	args := make([]*value, len(funcArg.Arguments))
+	ok := true
+	for i, arg := range funcArg.Arguments {
+		switch {
+		case len(arg.Variable) > 0:
+			args[i] = rg.load(arg.Variable, line, column)
+		case arg.Constant != nil:
+			args[i] = rg.constant(arg.Constant, line, column)
+		case arg.FunctionCall != nil:
+			args[i] = rg.functionCall(arg.FunctionCall, false)
+		case arg.MethodCall != nil:
+			args[i] = rg.methodCall(arg.MethodCall, false)
+		case arg.Expression != nil:
+			args[i] = rg.expression(arg.Expression)
+		default:
+			rg.errorf(line, column, "argument holder stores no value")
+		}
+		if !rg.hasValue(line, column, args[i]) {
+			ok = false
+		}
+	}
+	return args, ok
+}
+
+// signature is the parameters and results of a method, without the error returned along the first result.
+type signature struct {
+	params    []types.Type
+	results   []types.Type
+	withError bool
+}
+
+func newSignature(params, results []types.Type) *signature {
+	sig := &signature{params: params, results: results}
+	if len(results) == 2 && types.Identical(results[1], errorType) {
+		sig.results = results[:1]
+		sig.withError = true
+	}
+	return sig
+}
+
+// builtin returns the signature of a built-in function, or nil if generated code can not call it.
+func builtin(name string) *signature {
+	method, ok := reflect.TypeOf(&model.GroolFunctions{}).MethodByName(name)
+	if !ok {
+		return nil
+	}
+	params := make([]types.Type, 0, method.Type.NumIn()-1)
+	for i := 1; i < method.Type.NumIn(); i++ {
+		params = append(params, fromReflect(method.Type.In(i)))
+	}
+	results := make([]types.Type, 0, method.Type.NumOut())
+	for i := 0; i < method.Type.NumOut(); i++ {
+		results = append(results, fromReflect(method.Type.Out(i)))
+	}
+	for _, typ := range append(params, results...) {
+		if typ == nil {
+			return nil
+		}
+	}
+	return newSignature(params, results)
+}
+
+func (rg *ruleGen) functionCall(funcCall *model.FunctionCall, discard bool) *value {
+	args, ok := rg.arguments(funcCall.FunctionArguments, funcCall.Line, funcCall.Column)
+	if !ok {
+		return nil
+	}
+	if funcCall.FunctionName == "Retract" {
+		if len(args) != 1 || kindOf(args[0].typ) != reflect.String {
+			rg.errorf(funcCall.Line, funcCall.Column, "function Retract() need a string argument")
+			return nil
+		}
+		rg.printf("s.retracted[%s] = true", rg.convert(args[0], types.Typ[types.String]))
+		return &value{}
+	}
+	sig := builtin(funcCall.FunctionName)
+	if sig == nil {
+		rg.errorf(funcCall.Line, funcCall.Column, "function %s() is not supported", funcCall.FunctionName)
+		return nil
+	}
+	rg.g.functions = true
+	return rg.invoke("groolFunctions", funcCall.FunctionName, sig, args, discard, funcCall.Line, funcCall.Column)
+}
+
+func (rg *ruleGen) methodCall(methCall *model.MethodCall, discard bool) *value {
+	args, ok := rg.arguments(methCall.MethodArguments, methCall.Line, methCall.Column)
+	if !ok {
+		return nil
+	}
+	if strings.Contains(methCall.MethodName, "?") {
+		rg.errorf(methCall.Line, methCall.Column, "null-safe method call %s() is not supported", methCall.MethodName)
+		return nil
+	}
+	names := strings.Split(methCall.MethodName, ".")
+	method := names[len(names)-1]
+	receiver := rg.root(names[0], methCall.Line, methCall.Column)
+	if receiver == nil {
+		return nil
+	}
+	receiver = rg.walk(receiver, names[0], names[1:len(names)-1], true, func(name, next string, root bool) string {
+		if root {
+			return fmt.Sprintf("can not call %s on nil fact %s", method, name)
+		}
+		return fmt.Sprintf("can not call %s on nil attribute %s", method, name)
+	}, methCall.Line, methCall.Column)
+	if receiver == nil {
+		return nil
+	}
+	return rg.method(receiver, method, args, discard, methCall.Line, methCall.Column)
+}
+
+// selector generates the member access or method call on the value of an expression atom.
+func (rg *ruleGen) selector(receiver *value, sel *model.Selector, discard bool) *value {
+	if sel.NullSafe || strings.Contains(sel.Name, "?") {
+		rg.errorf(sel.Line, sel.Column, "null-safe selector %s is not supported", sel.Name)
+		return nil
+	}
+	if !rg.hasValue(sel.Line, sel.Column, receiver) {
+		return nil
+	}
+	names := strings.Split(sel.Name, ".")
+	if !sel.MethodCall {
+		val := rg.walk(receiver, "", names, false, func(name, next string, root bool) string {
+			if root {
+				return fmt.Sprintf("can not get %s from nil value", sel.Name)
+			}
+			return getMessages(name, next, false)
+		}, sel.Line, sel.Column)
+		if val == nil {
+			return nil
+		}
+		return rg.member(val)
+	}
+	args, ok := rg.arguments(sel.MethodArguments, sel.Line, sel.Column)
+	if !ok {
+		return nil
+	}
+	method := names[len(names)-1]
+	receiver = rg.walk(receiver, "", names[:len(names)-1], true, func(name, next string, root bool) string {
+		if root {
+			return fmt.Sprintf("can not call %s on nil value", sel.Name)
+		}
+		return fmt.Sprintf("can not call %s on nil attribute %s", method, name)
+	}, sel.Line, sel.Column)
+	if receiver == nil {
+		return nil
+	}
+	return rg.method(receiver, method, args, discard, sel.Line, sel.Column)
+}
+
+// method generates the call of a method of a struct or pointer to struct value.
+func (rg *ruleGen) method(receiver *value, name string, args []*value, discard bool, line, column int) *value {
+	if structOf(receiver.typ) == nil {
+		rg.errorf(line, column, "%s is not a struct, it has no method %s()", receiver.typ, name)
+		return nil
+	}
+	obj, _, _ := types.LookupFieldOrMethod(receiver.typ, true, rg.g.config.Package, name)
+	fn, ok := obj.(*types.Func)
+	if !ok || !fn.Exported() {
+		rg.errorf(line, column, "%s has no method %s()", receiver.typ, name)
+		return nil
+	}
+	funcSig := fn.Type().(*types.Signature)
+	if funcSig.Variadic() {
+		rg.errorf(line, column, "variadic method %s() is not supported", name)
+		return nil
+	}
+	params := make([]types.Type, funcSig.Params().Len())
+	for i := range params {
+		params[i] = funcSig.Params().At(i).Type()
+	}
+	results := make([]types.Type, funcSig.Results().Len())
+	for i := range results {
+		results[i] = funcSig.Results().At(i).Type()
+	}
+	return rg.invoke(receiver.expr, name, newSignature(params, results), args, discard, line, column)
+}
+
+// invoke generates a call, converting the arguments like the rule engine does.
+func (rg *ruleGen) invoke(receiver, name string, sig *signature, args []*value, discard bool, line, column int) *value {
+	if len(sig.params) != len(args) {
+		rg.errorf(line, column, "method %s() need %d argument while there are %d", name, len(sig.params), len(args))
+		return nil
+	}
+	if len(sig.results) > 1 {
+		rg.errorf(line, column, "method %s() with multiple return value is not supported", name)
+		return nil
+	}
+	exprs := make([]string, len(args))
+	for i, arg := range args {
+		param := sig.params[i]
+		switch {
+		case kindOf(param) == reflect.Interface || sameType(arg.typ, param):
+			exprs[i] = arg.expr
+		case kindOf(param) == kindOf(arg.typ) && types.ConvertibleTo(arg.typ, param):
+			exprs[i] = rg.convert(arg, param)
+		default:
+			rg.errorf(line, column, "argument #%d of method %s() require %s but %s", i, name, param, arg.typ)
+			return nil
+		}
+	}
+	call := fmt.Sprintf("%s.%s(%s)", receiver, name, strings.Join(exprs, ", "))
+	val := &value{}
+	switch {
+	case sig.withError && discard:
+		rg.printf("if _, err := %s; err != nil {", call)
+	case sig.withError:
+		val = &value{expr: rg.temp(), typ: sig.results[0]}
+		rg.printf("%s, err := %s", val.expr, call)
+		rg.printf("if err != nil {")
+	case discard || len(sig.results) == 0:
+		rg.printf("%s", call)
+		return val
+	default:
+		return rg.assign(call, sig.results[0])
+	}
+	rg.printf("return %sfmt.Errorf(%q, err)\n}", rg.fail, "function "+name+"() returns error: %v")
+	return val
+}
+
+func (rg *ruleGen) assignExpressions(assigns *model.AssignExpressions) {
+	if assigns == nil {
+		return
+	}
+	for _, ae := range assigns.ExpressionList {
+		switch {
+		case ae.Assignment != nil:
+			rg.assignment(ae.Assignment)
+		case ae.FunctionCall != nil:
+			rg.functionCall(ae.FunctionCall, true)
+		case ae.MethodCall != nil:
+			rg.methodCall(ae.MethodCall, true)
+		case ae.IfStatement != nil:
+			rg.ifStatement(ae.IfStatement)
+		case ae.ForStatement != nil:
+			rg.forStatement(ae.ForStatement)
+		case ae.Selector != nil:
+			if receiver := rg.expressionAtom(ae.ExpressionAtom); receiver != nil {
+				rg.selector(receiver, ae.Selector, true)
+			}
+		default:
+			rg.errorf(ae.Line, ae.Column, "no assignment, function, method call, if or for statement to evaluate")
+		}
+	}
+}
+
+func (rg *ruleGen) assignment(assign *model.Assignment) {
+	val := rg.expression(assign.Expression)
+	if !rg.hasValue(assign.Line, assign.Column, val) {
+		return
+	}
+	if strings.Contains(assign.Variable, "?") {
+		rg.errorf(assign.Line, assign.Column, "null-safe assignment to %s is not supported", assign.Variable)
+		return
+	}
+	names := strings.Split(assign.Variable, ".")
+	if len(names) < 2 {
+		rg.errorf(assign.Line, assign.Column, "can not assign %s, only a member of a fact can be assigned", assign.Variable)
+		return
+	}
+	parent := rg.root(names[0], assign.Line, assign.Column)
+	if parent == nil {
+		return
+	}
+	fieldName := names[len(names)-1]
+	parent = rg.walk(parent, names[0], names[1:len(names)-1], true, func(name, next string, root bool) string {
+		if next == "" {
+			next = fieldName
+		}
+		if root {
+			return fmt.Sprintf("can not set %s of nil fact %s", next, name)
+		}
+		return fmt.Sprintf("can not set %s of nil attribute %s", next, name)
+	}, assign.Line, assign.Column)
+	if parent == nil {
+		return
+	}
+	field, structField, ok := rg.g.field(parent.typ, fieldName)
+	if !ok {
+		rg.errorf(assign.Line, assign.Column, "%s has no member %s", parent.typ, fieldName)
+		return
+	}
+	if pkg.IsReadOnlyField(structField) {
+		rg.errorf(assign.Line, assign.Column, "can not assign %s, its read only", assign.Variable)
+		return
+	}
+	fieldType := field.Type()
+	var expr string
+	switch kind := baseKind(fieldType); {
+	case sameType(val.typ, fieldType):
+		expr = val.expr
+	case baseType(kind) != nil && kind == baseKind(val.typ):
+		expr = rg.convert(val, fieldType)
+	default:
+		rg.errorf(assign.Line, assign.Column, "can not assign %s to %s, its a %s", val.typ, assign.Variable, fieldType)
+		return
+	}
+	rg.printf("%s.%s = %s", parent.expr, field.Name(), expr)
+	rg.printf("s.changes++")
+}
+
+func (rg *ruleGen) ifStatement(ifStmt *model.IfStatement) {
+	cond := rg.expression(ifStmt.Expression)
+	if !rg.hasValue(ifStmt.Expression.Line, ifStmt.Expression.Column, cond) {
+		return
+	}
+	if kindOf(cond.typ) != reflect.Bool {
+		rg.errorf(ifStmt.Expression.Line, ifStmt.Expression.Column, "if statement condition must be a boolean expression, not %s", cond.typ)
+		return
+	}
+	rg.printf("if %s {", rg.convert(cond, types.Typ[types.Bool]))
+	rg.assignExpressions(ifStmt.AssignExpressions)
+	switch {
+	case ifStmt.ElseIfStatement != nil:
+		rg.printf("} else {")
+		rg.ifStatement(ifStmt.ElseIfStatement)
+	case ifStmt.ElseAssignExpressions != nil:
+		rg.printf("} else {")
+		rg.assignExpressions(ifStmt.ElseAssignExpressions)
+	}
+	rg.printf("}")
+}
+
+func (rg *ruleGen) forStatement(forStmt *model.ForStatement) {
+	coll := rg.load(forStmt.Variable, forStmt.Line, forStmt.Column)
+	if coll == nil {
+		return
+	}
+	slice, ok := coll.typ.Underlying().(*types.Slice)
+	if !ok {
+		rg.errorf(forStmt.Line, forStmt.Column, "can not iterate over %s, only slice is supported", coll.typ)
+		return
+	}
+	rg.g.use("fmt")
+	rg.printf("if uint64(len(%s)) > s.maxLoopIteration {", coll.expr)
+	rg.printf("return %sfmt.Errorf(\"loop over %s have %%d items, exceeding the maximum of %%d iterations\", len(%s), s.maxLoopIteration)",
+		rg.fail, forStmt.Variable, coll.expr)
+	rg.printf("}")
+
+	index, item := rg.temp(), rg.temp()
+	loopVar := &value{expr: item, typ: slice.Elem()}
+	if kindOf(slice.Elem()) == reflect.Struct {
+		// struct items are taken by their address, so assignment changes the item inside the collection.
+		loopVar.typ = types.NewPointer(slice.Elem())
+	}
+	outer, shadowing := rg.scope[forStmt.LoopVariable]
+	rg.scope[forStmt.LoopVariable] = loopVar
+	body := rg.capture(func() { rg.assignExpressions(forStmt.AssignExpressions) })
+	if shadowing {
+		rg.scope[forStmt.LoopVariable] = outer
+	} else {
+		delete(rg.scope, forStmt.LoopVariable)
+	}
+	switch {
+	case !loopVar.used:
+		rg.printf("for range %s {", coll.expr)
+	case kindOf(slice.Elem()) == reflect.Struct:
+		rg.printf("for %s := range %s {\n%s := &%s[%s]", index, coll.expr, item, coll.expr, index)
+	default:
+		rg.printf("for _, %s := range %s {", item, coll.expr)
+	}
+	rg.printf("%s}", body)
+}
@@ -0,0 +1,157 @@
+package gen
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testFacts = `package facts
+
+import "time"
+
+type Account struct {
+	ID      string ` + "`grool:\",readonly\"`" + `
+	Owner   *Owner
+	Balance float64
+	Count   int
+	Tags    []string
+	Opened  time.Time
+	secret  string
+}
+
+type Owner struct {
+	Name string ` + "`grool:\"name\"`" + `
+}
+
+func (a *Account) Fee(rate float64) (float64, error) {
+	return a.Balance * rate, nil
+}
+
+func (a *Account) Label(prefix string, values ...string) string {
+	return prefix
+}
+`
+
+func loadTestPackage(t *testing.T) *types.Package {
+	dir, err := ioutil.TempDir("", "gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "Facts.go"), []byte(testFacts), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// a generated file is skipped, so a stale one does not break the generation.
+	if err := ioutil.WriteFile(filepath.Join(dir, "Rules.go"), []byte(Header+"\n\npackage facts\n\nvar broken = undefined\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	factPackage, err := LoadPackage(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if factPackage.Scope().Lookup("broken") != nil {
+		t.Errorf("generated file should be skipped")
+	}
+	return factPackage
+}
+
+func generate(t *testing.T, factPackage *types.Package, rule string) ([]byte, error) {
+	knowledgeBase := model.NewKnowledgeBase()
+	if err := builder.NewRuleBuilder(knowledgeBase).BuildRuleFromResource(pkg.NewBytesResource([]byte(rule))); err != nil {
+		t.Fatal(err)
+	}
+	return Generate(knowledgeBase, &Config{
+		Package: factPackage,
+		Facts:   map[string]string{"Account": "Account"},
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	factPackage := loadTestPackage(t)
+	code, err := generate(t, factPackage, `
+rule Fee "Charge the fee" salience 10 {
+	when
+		Account.Balance > 100 && Account.Owner.name != ""
+	then
+		Account.Balance = Account.Balance - Account.Fee(0.01);
+		Account.Count = Account.Count + 1;
+		for tag in Account.Tags {
+			Account.Count = Account.Count + 1;
+		}
+		Retract("Fee");
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expect := range []string{
+		Header,
+		"package facts",
+		"type RuleFacts struct {\n\tAccount *Account\n}",
+		`{name: "Fee", salience: 10, when: groolWhenFee, then: groolThenFee}`,
+		"s.facts.Account.Owner.Name",
+		"s.facts.Account.Fee(float64(0.01))",
+		`pkg.CheckedInt("+", int64(v`,
+		"s.facts.Account.Count = int(v",
+		`s.retracted["Fee"] = true`,
+	} {
+		if !strings.Contains(string(code), expect) {
+			t.Errorf("generated code should contain %q\n%s", expect, code)
+		}
+	}
+}
+
+func TestGenerate_Errors(t *testing.T) {
+	factPackage := loadTestPackage(t)
+	testData := []struct {
+		name  string
+		rule  string
+		error string
+	}{
+		{name: "UnknownFact", rule: `Customer.Age > 10`, error: "fact Customer is not declared"},
+		{name: "UnknownMember", rule: `Account.Age > 10`, error: "*facts.Account has no member Age"},
+		{name: "Unexported", rule: `Account.secret == ""`, error: "*facts.Account has no member secret"},
+		{name: "Membership", rule: `Account.Count in (1, 2)`, error: "in operator is not supported"},
+		{name: "NullSafe", rule: `Account.Owner?.name == ""`, error: "null-safe variable Account.Owner?.name is not supported"},
+		{name: "Compare", rule: `Account.Opened > 10`, error: "can not compare time.Time with int64 using >"},
+		{name: "Variadic", rule: `Account.Label("a", "b") == ""`, error: "variadic method Label() is not supported"},
+		{name: "Then/ReadOnly", rule: `true then Account.ID = "x";`, error: "can not assign Account.ID, its read only"},
+		{name: "Then/Kind", rule: `true then Account.Count = 1.5;`, error: "can not assign float64 to Account.Count, its a int"},
+		{name: "Then/Loop", rule: `true then for c in Account.Count { Account.Count = 1; }`, error: "can not iterate over int, only slice is supported"},
+	}
+	for _, td := range testData {
+		t.Run(td.name, func(t *testing.T) {
+			scopes := strings.SplitN(td.rule, " then ", 2)
+			then := "Account.Count = 0;"
+			if len(scopes) == 2 {
+				then = scopes[1]
+			}
+			_, err := generate(t, factPackage, "rule Test \"test\" {\nwhen\n"+scopes[0]+"\nthen\n"+then+"\n}")
+			if err == nil {
+				t.Fatalf("expect error %q", td.error)
+			}
+			typeErrors, ok := err.(model.TypeErrors)
+			if !ok {
+				t.Fatalf("expect type errors, got %v", err)
+			}
+			if typeErrors[0].Message != td.error {
+				t.Errorf("expect error %q but %q", td.error, typeErrors[0].Message)
+			}
+		})
+	}
+}
+
+func TestGenerate_UnknownType(t *testing.T) {
+	factPackage := loadTestPackage(t)
+	knowledgeBase := model.NewKnowledgeBase()
+	if _, err := Generate(knowledgeBase, &Config{Package: factPackage, Facts: map[string]string{"Owner": "Person"}}); err == nil {
+		t.Errorf("expect error on unknown fact type")
+	}
+}
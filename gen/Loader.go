@@ -0,0 +1,60 @@
+package gen
+
+import (
+	"github.com/juju/errors"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strings"
+)
+
+// LoadPackage type checks the Go package in the directory, so the rules can be generated against its fact types.
+// Test files and files generated by grool gen are skipped, so the rules can always be generated again.
+// Type errors are ignored, as long as the fact types can be resolved.
+func LoadPackage(dir string) (*types.Package, error) {
+	buildPkg, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	fset := token.NewFileSet()
+	files := make([]*ast.File, 0, len(buildPkg.GoFiles))
+	for _, name := range buildPkg.GoFiles {
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if isGenerated(file) {
+			continue
+		}
+		files = append(files, file)
+	}
+	if len(files) == 0 {
+		return nil, errors.Errorf("no Go file to load in %s", dir)
+	}
+	conf := &types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(err error) {},
+	}
+	// outside of GOPATH the import path is not known, the types of the package are then named by the package name.
+	path := buildPkg.ImportPath
+	if path == "." || strings.HasPrefix(path, "_") {
+		path = buildPkg.Name
+	}
+	pkg, _ := conf.Check(path, fset, files, nil)
+	if pkg == nil {
+		return nil, errors.Errorf("can not type check the package in %s", dir)
+	}
+	return pkg, nil
+}
+
+// isGenerated checks if the file starts with the header of generated files.
+func isGenerated(file *ast.File) bool {
+	if len(file.Comments) == 0 || file.Comments[0].Pos() > file.Package {
+		return false
+	}
+	return strings.TrimSpace(file.Comments[0].List[0].Text) == Header
+}
@@ -0,0 +1,148 @@
+package gen
+
+import (
+	"go/token"
+	"go/types"
+	"reflect"
+	"time"
+)
+
+var (
+	timePackage   = types.NewPackage("time", "time")
+	timeType      = types.NewNamed(types.NewTypeName(token.NoPos, timePackage, "Time", nil), types.NewStruct(nil, nil), nil)
+	durationType  = types.NewNamed(types.NewTypeName(token.NoPos, timePackage, "Duration", nil), types.Typ[types.Int64], nil)
+	errorType     = types.Universe.Lookup("error").Type()
+	interfaceType = types.NewInterfaceType(nil, nil).Complete()
+
+	reflectTimeType     = reflect.TypeOf(time.Time{})
+	reflectDurationType = reflect.TypeOf(time.Duration(0))
+	reflectErrorType    = reflect.TypeOf((*error)(nil)).Elem()
+
+	basicKinds = map[types.BasicKind]reflect.Kind{
+		types.Bool:    reflect.Bool,
+		types.Int:     reflect.Int,
+		types.Int8:    reflect.Int8,
+		types.Int16:   reflect.Int16,
+		types.Int32:   reflect.Int32,
+		types.Int64:   reflect.Int64,
+		types.Uint:    reflect.Uint,
+		types.Uint8:   reflect.Uint8,
+		types.Uint16:  reflect.Uint16,
+		types.Uint32:  reflect.Uint32,
+		types.Uint64:  reflect.Uint64,
+		types.Float32: reflect.Float32,
+		types.Float64: reflect.Float64,
+		types.String:  reflect.String,
+	}
+)
+
+// kindOf returns the kind a value of the type has at runtime, like reflect.Value Kind.
+func kindOf(typ types.Type) reflect.Kind {
+	if typ == nil {
+		return reflect.Invalid
+	}
+	switch under := typ.Underlying().(type) {
+	case *types.Basic:
+		return basicKinds[under.Kind()]
+	case *types.Pointer:
+		return reflect.Ptr
+	case *types.Struct:
+		return reflect.Struct
+	case *types.Slice:
+		return reflect.Slice
+	case *types.Array:
+		return reflect.Array
+	case *types.Map:
+		return reflect.Map
+	case *types.Interface:
+		return reflect.Interface
+	default:
+		return reflect.Invalid
+	}
+}
+
+// baseKind returns the kind of the type like pkg.GetBaseKind, all integers are Int64 or Uint64 and all floats are Float64.
+func baseKind(typ types.Type) reflect.Kind {
+	switch kind := kindOf(typ); kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.Int64
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflect.Uint64
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	default:
+		return kind
+	}
+}
+
+// baseType returns the type a value of the base kind is converted into for arithmetic.
+func baseType(kind reflect.Kind) types.Type {
+	switch kind {
+	case reflect.Int64:
+		return types.Typ[types.Int64]
+	case reflect.Uint64:
+		return types.Typ[types.Uint64]
+	case reflect.Float64:
+		return types.Typ[types.Float64]
+	case reflect.String:
+		return types.Typ[types.String]
+	case reflect.Bool:
+		return types.Typ[types.Bool]
+	default:
+		return nil
+	}
+}
+
+func isNumber(typ types.Type) bool {
+	kind := baseKind(typ)
+	return kind == reflect.Int64 || kind == reflect.Uint64 || kind == reflect.Float64
+}
+
+func isNamed(typ types.Type, path, name string) bool {
+	named, ok := typ.(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == path && named.Obj().Name() == name
+}
+
+func isTime(typ types.Type) bool {
+	return isNamed(typ, "time", "Time")
+}
+
+func isDuration(typ types.Type) bool {
+	return isNamed(typ, "time", "Duration")
+}
+
+// sameType checks if the two types are identical, time.Time and time.Duration are the same wherever they were resolved.
+func sameType(a, b types.Type) bool {
+	return types.Identical(a, b) || (isTime(a) && isTime(b)) || (isDuration(a) && isDuration(b))
+}
+
+// fromReflect returns the type of a built-in function parameter or return value, it is nil if generated code can not use it.
+func fromReflect(typ reflect.Type) types.Type {
+	switch {
+	case typ == reflectTimeType:
+		return timeType
+	case typ == reflectDurationType:
+		return durationType
+	case typ == reflectErrorType:
+		return errorType
+	case typ.Kind() == reflect.Interface && typ.NumMethod() == 0:
+		return interfaceType
+	case typ.PkgPath() != "":
+		return nil
+	}
+	for basic, kind := range basicKinds {
+		if typ.Kind() == kind {
+			return types.Typ[basic]
+		}
+	}
+	return nil
+}
+
+// structOf returns the struct of a struct or pointer to struct type, or nil.
+func structOf(typ types.Type) *types.Struct {
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	st, _ := typ.Underlying().(*types.Struct)
+	return st
+}
@@ -0,0 +1,476 @@
+package lint
+
+import (
+	"fmt"
+	"github.com/newm4n/grool/model"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// The checks done by Lint, each warning tells which check found it.
+const (
+	CheckInfiniteLoop         = "infinite-loop"
+	CheckUnreachableCondition = "unreachable-condition"
+	CheckConstantCondition    = "constant-condition"
+	CheckDuplicateRule        = "duplicate-rule"
+	CheckShadowedRule         = "shadowed-rule"
+	CheckUnusedSalience       = "unused-salience"
+	CheckSelfAssignment       = "self-assignment"
+)
+
+// Warning is a possible mistake found in a rule. Line and Column locates it in the rule script.
+type Warning struct {
+	RuleName string
+	Line     int
+	Column   int
+	Check    string
+	Message  string
+}
+
+// String returns the warning message along with the rule name, position and the check that found it.
+func (w *Warning) String() string {
+	return fmt.Sprintf("rule %s line %d column %d : %s [%s]", w.RuleName, w.Line, w.Column, w.Message, w.Check)
+}
+
+// Lint walks the rule entries of the knowledge base and returns the warnings found, sorted by their position.
+func Lint(knowledgeBase *model.KnowledgeBase) []*Warning {
+	entries := make([]*model.RuleEntry, 0, len(knowledgeBase.RuleEntries))
+	for _, entry := range knowledgeBase.RuleEntries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Line != entries[j].Line {
+			return entries[i].Line < entries[j].Line
+		}
+		return entries[i].RuleName < entries[j].RuleName
+	})
+
+	l := &linter{
+		warnings: make([]*Warning, 0),
+	}
+	for _, entry := range entries {
+		l.entry = entry
+		if entry.WhenScope != nil && entry.WhenScope.Expression != nil {
+			l.lintConstantCondition(entry.WhenScope.Expression)
+			l.lintUnreachableCondition(entry.WhenScope.Expression)
+		}
+		if entry.ThenScope != nil {
+			l.lintSelfAssignment(entry.ThenScope.AssignExpressions)
+		}
+		l.lintInfiniteLoop()
+	}
+	l.lintDuplicateRule(entries)
+	l.lintUnusedSalience(entries)
+
+	sort.SliceStable(l.warnings, func(i, j int) bool {
+		if l.warnings[i].Line != l.warnings[j].Line {
+			return l.warnings[i].Line < l.warnings[j].Line
+		}
+		return l.warnings[i].Column < l.warnings[j].Column
+	})
+	return l.warnings
+}
+
+type linter struct {
+	entry    *model.RuleEntry
+	warnings []*Warning
+}
+
+func (l *linter) warnf(line, column int, check, format string, args ...interface{}) {
+	l.warnings = append(l.warnings, &Warning{
+		RuleName: l.entry.RuleName,
+		Line:     line,
+		Column:   column,
+		Check:    check,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// lintConstantCondition warns about a predicate that does not depend on any fact.
+func (l *linter) lintConstantCondition(expr *model.Expression) {
+	if expr == nil {
+		return
+	}
+	if expr.Predicate == nil {
+		l.lintConstantCondition(expr.ConditionExpression)
+		l.lintConstantCondition(expr.LeftExpression)
+		l.lintConstantCondition(expr.RightExpression)
+		return
+	}
+	prdct := expr.Predicate
+	atoms := append([]*model.ExpressionAtom{prdct.ExpressionAtomLeft, prdct.ExpressionAtomRight}, prdct.ExpressionAtomList...)
+	for _, atom := range atoms {
+		if atom != nil && !isConstant(atom) {
+			return
+		}
+	}
+	val, err := prdct.Evaluate()
+	if err != nil || !val.IsValid() || val.Kind() != reflect.Bool {
+		return
+	}
+	l.warnf(prdct.Line, prdct.Column, CheckConstantCondition, "condition %s is always %v", expr.Text, val.Bool())
+}
+
+// lintUnreachableCondition warns about a chain of && that can never be true, such as x > 5 && x < 3.
+func (l *linter) lintUnreachableCondition(expr *model.Expression) {
+	if expr == nil || expr.Predicate != nil {
+		return
+	}
+	if expr.Conditional || expr.LogicalOperator != model.LogicalOperatorAnd {
+		l.lintUnreachableCondition(expr.ConditionExpression)
+		l.lintUnreachableCondition(expr.LeftExpression)
+		l.lintUnreachableCondition(expr.RightExpression)
+		return
+	}
+	predicates := make([]*model.Predicate, 0)
+	l.collectAnd(expr, &predicates)
+	ranges := make(map[string]*numberRange)
+	for _, prdct := range predicates {
+		variable, op, limit, ok := rangePredicate(prdct)
+		if !ok {
+			continue
+		}
+		rng, ok := ranges[variable]
+		if !ok {
+			rng = &numberRange{}
+			ranges[variable] = rng
+		}
+		if rng.empty() {
+			continue
+		}
+		rng.apply(op, limit)
+		if rng.empty() {
+			l.warnf(prdct.Line, prdct.Column, CheckUnreachableCondition, "condition on %s can never be true", variable)
+		}
+	}
+}
+
+// collectAnd collects the predicates of a chain of &&, other sub expressions are linted on their own.
+func (l *linter) collectAnd(expr *model.Expression, predicates *[]*model.Predicate) {
+	switch {
+	case expr.Predicate != nil:
+		*predicates = append(*predicates, expr.Predicate)
+	case !expr.Conditional && expr.LogicalOperator == model.LogicalOperatorAnd:
+		l.collectAnd(expr.LeftExpression, predicates)
+		l.collectAnd(expr.RightExpression, predicates)
+	default:
+		l.lintUnreachableCondition(expr)
+	}
+}
+
+// lintSelfAssignment warns about an assignment of a variable to itself.
+func (l *linter) lintSelfAssignment(assigns *model.AssignExpressions) {
+	walkStatements(assigns, func(ae *model.AssignExpression) {
+		assign := ae.Assignment
+		if assign == nil || assign.Expression == nil || assign.Expression.Predicate == nil {
+			return
+		}
+		prdct := assign.Expression.Predicate
+		if prdct.ExpressionAtomRight != nil || len(prdct.ExpressionAtomList) > 0 {
+			return
+		}
+		if prdct.ExpressionAtomLeft != nil && normalizePath(prdct.ExpressionAtomLeft.Variable) == normalizePath(assign.Variable) {
+			l.warnf(assign.Line, assign.Column, CheckSelfAssignment, "%s is assigned to itself", assign.Variable)
+		}
+	})
+}
+
+// lintInfiniteLoop warns about a rule which then scope changes facts, but none tested by its when scope.
+// Such rule stays true after it executes and is selected again on every cycle until Grool.MaxCycle is reached.
+func (l *linter) lintInfiniteLoop() {
+	if l.entry.WhenScope == nil || l.entry.ThenScope == nil {
+		return
+	}
+	reads := make([]string, 0)
+	readExpression(l.entry.WhenScope.Expression, &reads)
+	if len(reads) == 0 {
+		return
+	}
+	writes := make([]string, 0)
+	// a method call may change anything and a retracted rule is no longer selected, so they are not checked.
+	unknown := false
+	loopVariables := make(map[string]string)
+	var collect func(assigns *model.AssignExpressions)
+	collect = func(assigns *model.AssignExpressions) {
+		if assigns == nil {
+			return
+		}
+		for _, ae := range assigns.ExpressionList {
+			switch {
+			case ae.Assignment != nil:
+				writes = append(writes, resolveLoopVariable(normalizePath(ae.Assignment.Variable), loopVariables))
+			case ae.MethodCall != nil, ae.Selector != nil:
+				unknown = true
+			case ae.FunctionCall != nil:
+				if ae.FunctionCall.FunctionName == "Retract" {
+					unknown = true
+				}
+			case ae.IfStatement != nil:
+				for ifStmt := ae.IfStatement; ifStmt != nil; ifStmt = ifStmt.ElseIfStatement {
+					collect(ifStmt.AssignExpressions)
+					collect(ifStmt.ElseAssignExpressions)
+				}
+			case ae.ForStatement != nil:
+				loopVariables[ae.ForStatement.LoopVariable] = resolveLoopVariable(normalizePath(ae.ForStatement.Variable), loopVariables)
+				collect(ae.ForStatement.AssignExpressions)
+				delete(loopVariables, ae.ForStatement.LoopVariable)
+			}
+		}
+	}
+	collect(l.entry.ThenScope.AssignExpressions)
+	if unknown || len(writes) == 0 {
+		return
+	}
+	for _, write := range writes {
+		for _, read := range reads {
+			if overlaps(write, read) {
+				return
+			}
+		}
+	}
+	l.warnf(l.entry.Line, l.entry.Column, CheckInfiniteLoop,
+		"then scope never changes anything tested in the when scope, the rule will be executed again on every cycle until Grool.MaxCycle is reached")
+}
+
+// lintDuplicateRule warns about rules having the same when scope.
+func (l *linter) lintDuplicateRule(entries []*model.RuleEntry) {
+	seen := make(map[string]*model.RuleEntry)
+	for _, entry := range entries {
+		if entry.WhenScope == nil || entry.WhenScope.Expression == nil {
+			continue
+		}
+		when := entry.WhenScope.Expression.Text
+		other, ok := seen[when]
+		if !ok {
+			seen[when] = entry
+			continue
+		}
+		l.entry = entry
+		switch {
+		case thenText(entry) == thenText(other):
+			l.warnf(entry.Line, entry.Column, CheckDuplicateRule, "rule is a duplicate of rule %s", other.RuleName)
+		case entry.Salience < other.Salience:
+			l.warnf(entry.Line, entry.Column, CheckShadowedRule, "rule has the same condition as rule %s, which has higher salience and always executes first", other.RuleName)
+		case entry.Salience > other.Salience:
+			l.warnf(entry.Line, entry.Column, CheckShadowedRule, "rule has the same condition as rule %s, and always executes before it", other.RuleName)
+		default:
+			l.warnf(entry.Line, entry.Column, CheckShadowedRule, "rule has the same condition and salience as rule %s, their execution order is not defined", other.RuleName)
+		}
+	}
+}
+
+// lintUnusedSalience warns about salience when every rule has the same salience.
+func (l *linter) lintUnusedSalience(entries []*model.RuleEntry) {
+	for _, entry := range entries {
+		if entry.Salience != entries[0].Salience {
+			return
+		}
+	}
+	for _, entry := range entries {
+		if entry.Salience != 0 {
+			l.entry = entry
+			l.warnf(entry.Line, entry.Column, CheckUnusedSalience, "salience %d has no effect, every rule has the same salience", entry.Salience)
+		}
+	}
+}
+
+func walkStatements(assigns *model.AssignExpressions, fn func(ae *model.AssignExpression)) {
+	if assigns == nil {
+		return
+	}
+	for _, ae := range assigns.ExpressionList {
+		fn(ae)
+		for ifStmt := ae.IfStatement; ifStmt != nil; ifStmt = ifStmt.ElseIfStatement {
+			walkStatements(ifStmt.AssignExpressions, fn)
+			walkStatements(ifStmt.ElseAssignExpressions, fn)
+		}
+		if ae.ForStatement != nil {
+			walkStatements(ae.ForStatement.AssignExpressions, fn)
+		}
+	}
+}
+
+func thenText(entry *model.RuleEntry) string {
+	texts := make([]string, 0)
+	if entry.ThenScope != nil {
+		walkStatements(entry.ThenScope.AssignExpressions, func(ae *model.AssignExpression) {
+			texts = append(texts, ae.Text)
+		})
+	}
+	return strings.Join(texts, "\n")
+}
+
+func isConstant(atom *model.ExpressionAtom) bool {
+	switch {
+	case atom.Constant != nil:
+		return true
+	case len(atom.Variable) > 0, atom.FunctionCall != nil, atom.MethodCall != nil, atom.Selector != nil:
+		return false
+	default:
+		return atom.ExpressionAtomLeft != nil && isConstant(atom.ExpressionAtomLeft) &&
+			(atom.ExpressionAtomRight == nil || isConstant(atom.ExpressionAtomRight))
+	}
+}
+
+func readExpression(expr *model.Expression, reads *[]string) {
+	if expr == nil {
+		return
+	}
+	readExpression(expr.ConditionExpression, reads)
+	readExpression(expr.LeftExpression, reads)
+	readExpression(expr.RightExpression, reads)
+	if expr.Predicate != nil {
+		readAtom(expr.Predicate.ExpressionAtomLeft, reads)
+		readAtom(expr.Predicate.ExpressionAtomRight, reads)
+		for _, atom := range expr.Predicate.ExpressionAtomList {
+			readAtom(atom, reads)
+		}
+	}
+}
+
+func readAtom(atom *model.ExpressionAtom, reads *[]string) {
+	if atom == nil {
+		return
+	}
+	if len(atom.Variable) > 0 {
+		*reads = append(*reads, normalizePath(atom.Variable))
+	}
+	if atom.MethodCall != nil {
+		// the method may read anything of its receiver.
+		name := normalizePath(atom.MethodCall.MethodName)
+		*reads = append(*reads, name[:strings.LastIndex(name, ".")])
+		readArguments(atom.MethodCall.MethodArguments, reads)
+	}
+	if atom.FunctionCall != nil {
+		readArguments(atom.FunctionCall.FunctionArguments, reads)
+	}
+	if atom.Selector != nil {
+		readArguments(atom.Selector.MethodArguments, reads)
+	}
+	readAtom(atom.ExpressionAtomLeft, reads)
+	readAtom(atom.ExpressionAtomRight, reads)
+}
+
+func readArguments(funcArg *model.FunctionArgument, reads *[]string) {
+	if funcArg == nil {
+		return
+	}
+	for _, arg := range funcArg.Arguments {
+		if len(arg.Variable) > 0 {
+			*reads = append(*reads, normalizePath(arg.Variable))
+		}
+		if arg.MethodCall != nil {
+			name := normalizePath(arg.MethodCall.MethodName)
+			*reads = append(*reads, name[:strings.LastIndex(name, ".")])
+			readArguments(arg.MethodCall.MethodArguments, reads)
+		}
+		if arg.FunctionCall != nil {
+			readArguments(arg.FunctionCall.FunctionArguments, reads)
+		}
+		readExpression(arg.Expression, reads)
+	}
+}
+
+// normalizePath removes the null-safe markers of a variable path.
+func normalizePath(path string) string {
+	return strings.Replace(path, "?", "", -1)
+}
+
+// resolveLoopVariable replaces a loop variable at the beginning of the path with the collection it iterates.
+func resolveLoopVariable(path string, loopVariables map[string]string) string {
+	elems := strings.SplitN(path, ".", 2)
+	collection, ok := loopVariables[elems[0]]
+	if !ok {
+		return path
+	}
+	return collection
+}
+
+// overlaps tells whether changing one path may change the other.
+func overlaps(a, b string) bool {
+	return a == b || strings.HasPrefix(a, b+".") || strings.HasPrefix(b, a+".")
+}
+
+// rangePredicate extracts "variable operator number" out of a predicate, flipping it if the number is on the left.
+func rangePredicate(prdct *model.Predicate) (string, model.ComparisonOperator, float64, bool) {
+	if prdct.ExpressionAtomLeft == nil || prdct.ExpressionAtomRight == nil {
+		return "", "", 0, false
+	}
+	op := prdct.ComparisonOperator
+	variable, limit := prdct.ExpressionAtomLeft, prdct.ExpressionAtomRight
+	if len(variable.Variable) == 0 {
+		variable, limit = limit, variable
+		switch op {
+		case model.ComparisonOperatorGT:
+			op = model.ComparisonOperatorLT
+		case model.ComparisonOperatorGTE:
+			op = model.ComparisonOperatorLTE
+		case model.ComparisonOperatorLT:
+			op = model.ComparisonOperatorGT
+		case model.ComparisonOperatorLTE:
+			op = model.ComparisonOperatorGTE
+		}
+	}
+	if len(variable.Variable) == 0 || limit.Constant == nil {
+		return "", "", 0, false
+	}
+	val := limit.Constant.ConstantValue
+	if !val.IsValid() {
+		return "", "", 0, false
+	}
+	var number float64
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number = float64(val.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number = float64(val.Uint())
+	case reflect.Float32, reflect.Float64:
+		number = val.Float()
+	default:
+		return "", "", 0, false
+	}
+	return normalizePath(variable.Variable), op, number, true
+}
+
+// numberRange is the range of numbers satisfying a chain of comparisons.
+type numberRange struct {
+	hasMin, minInclusive bool
+	hasMax, maxInclusive bool
+	min, max             float64
+}
+
+func (rng *numberRange) apply(op model.ComparisonOperator, limit float64) {
+	switch op {
+	case model.ComparisonOperatorGT:
+		rng.raiseMin(limit, false)
+	case model.ComparisonOperatorGTE:
+		rng.raiseMin(limit, true)
+	case model.ComparisonOperatorLT:
+		rng.lowerMax(limit, false)
+	case model.ComparisonOperatorLTE:
+		rng.lowerMax(limit, true)
+	case model.ComparisonOperatorEQ:
+		rng.raiseMin(limit, true)
+		rng.lowerMax(limit, true)
+	}
+}
+
+func (rng *numberRange) raiseMin(limit float64, inclusive bool) {
+	if !rng.hasMin || limit > rng.min || (limit == rng.min && !inclusive) {
+		rng.hasMin, rng.min, rng.minInclusive = true, limit, inclusive
+	}
+}
+
+func (rng *numberRange) lowerMax(limit float64, inclusive bool) {
+	if !rng.hasMax || limit < rng.max || (limit == rng.max && !inclusive) {
+		rng.hasMax, rng.max, rng.maxInclusive = true, limit, inclusive
+	}
+}
+
+func (rng *numberRange) empty() bool {
+	if !rng.hasMin || !rng.hasMax {
+		return false
+	}
+	return rng.min > rng.max || (rng.min == rng.max && !(rng.minInclusive && rng.maxInclusive))
+}
@@ -0,0 +1,82 @@
+package lint
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"testing"
+)
+
+func lintRules(t *testing.T, rules string) []*Warning {
+	knowledgeBase := model.NewKnowledgeBase()
+	ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+	err := ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(rules)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Lint(knowledgeBase)
+}
+
+func TestLint(t *testing.T) {
+	testData := []struct {
+		rule  string
+		check string
+		line  int
+	}{
+		{"rule A \"\" {\n when\n Cart.Total > 100\n then\n Cart.Discount = 10;\n}", CheckInfiniteLoop, 1},
+		{"rule A \"\" {\n when\n Cart.Total > 100 && Cart.Discount == 0\n then\n for item in Cart.Items {\n item.Price = 1;\n }\n}", CheckInfiniteLoop, 1},
+		{"rule A \"\" {\n when\n Cart.Total > 5 && Cart.Total < 3\n then\n Cart.Total = 4;\n}", CheckUnreachableCondition, 3},
+		{"rule A \"\" {\n when\n Cart.Done == false && (Cart.Total >= 5 && 5 > Cart.Total)\n then\n Cart.Total = 4;\n}", CheckUnreachableCondition, 3},
+		{"rule A \"\" {\n when\n Cart.Total == 1 && Cart.Total == 2\n then\n Cart.Total = 4;\n}", CheckUnreachableCondition, 3},
+		{"rule A \"\" {\n when\n Cart.Total > 1 && 1 == 1\n then\n Cart.Total = 0;\n}", CheckConstantCondition, 3},
+		{"rule A \"\" {\n when\n Cart.Total > 1\n then\n Cart.Total = 0;\n Cart.Name = Cart.Name;\n}", CheckSelfAssignment, 6},
+		{"rule A \"\" salience 10 {\n when\n Cart.Total > 1\n then\n Cart.Total = 0;\n}", CheckUnusedSalience, 1},
+		{"rule A \"\" {\n when\n Cart.Total > 1\n then\n Cart.Total = 0;\n}\nrule B \"\" {\n when\n Cart.Total>1\n then\n Cart.Total = 0;\n}", CheckDuplicateRule, 7},
+		{"rule A \"\" salience 10 {\n when\n Cart.Total > 1\n then\n Cart.Total = 0;\n}\nrule B \"\" {\n when\n Cart.Total > 1\n then\n Cart.Total = 2;\n}", CheckShadowedRule, 7},
+	}
+	for i, td := range testData {
+		warnings := lintRules(t, td.rule)
+		found := false
+		for _, warning := range warnings {
+			if warning.Check == td.check && warning.Line == td.line {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("test #%d expect %s warning at line %d, but %v", i, td.check, td.line, warnings)
+		}
+	}
+}
+
+func TestLint_NoWarning(t *testing.T) {
+	rules := `
+rule Discount "Give discount once" salience 10 {
+	when
+		Cart.Total > 100 && Cart.Total < 1000 && Cart.Discount == 0
+	then
+		Cart.Discount = 10;
+}
+
+rule Retracted "Retract after logging" {
+	when
+		Cart.Total > 1000 || Cart.Total < 0
+	then
+		Cart.Flagged = true;
+		Retract("Retracted");
+}
+
+rule Items "Changes the tested items" {
+	when
+		Cart.Items.Count > 0 && Cart.Checked == false
+	then
+		for item in Cart.Items.List {
+			item.Checked = true;
+		}
+		Cart.Checked = true;
+}
+`
+	warnings := lintRules(t, rules)
+	if len(warnings) != 0 {
+		t.Errorf("expect no warning, but %v", warnings)
+	}
+}
@@ -17,6 +17,7 @@ type ArgumentHolder struct {
 	knowledgeContext *context.KnowledgeContext
 	ruleCtx          *context.RuleContext
 	dataCtx          *context.DataContext
+	path             *context.Path
 }
 
 // Initialize this ArgumentHolder instance graph before rule execution start.
@@ -24,6 +25,7 @@ func (ah *ArgumentHolder) Initialize(knowledgeContext *context.KnowledgeContext,
 	ah.knowledgeContext = knowledgeContext
 	ah.ruleCtx = ruleCtx
 	ah.dataCtx = dataCtx
+	ah.path = variablePath(ah.path, ah.Variable)
 
 	if ah.Constant != nil {
 		ah.Constant.Initialize(knowledgeContext, ruleCtx, dataCtx)
@@ -42,7 +44,7 @@ func (ah *ArgumentHolder) Initialize(knowledgeContext *context.KnowledgeContext,
 // Evaluate the object graph against underlined context or execute evaluation in the sub graph.
 func (ah *ArgumentHolder) Evaluate() (reflect.Value, error) {
 	if len(ah.Variable) > 0 {
-		return ah.dataCtx.GetValue(ah.Variable)
+		return ah.dataCtx.GetPathValue(ah.path)
 	}
 	if ah.Constant != nil {
 		return ah.Constant.Evaluate()
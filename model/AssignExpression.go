@@ -8,9 +8,16 @@ import (
 
 // AssignExpression an expression for assignment, used to assign a variable with some function, constants or method  all or simply calling function.
 type AssignExpression struct {
+	Text             string
+	Line             int
+	Column           int
 	Assignment       *Assignment
 	FunctionCall     *FunctionCall
 	MethodCall       *MethodCall
+	IfStatement      *IfStatement
+	ForStatement     *ForStatement
+	ExpressionAtom   *ExpressionAtom
+	Selector         *Selector
 	knowledgeContext *context.KnowledgeContext
 	ruleCtx          *context.RuleContext
 	dataCtx          *context.DataContext
@@ -33,6 +40,22 @@ func (ae *AssignExpression) Initialize(knowledgeContext *context.KnowledgeContex
 	if ae.MethodCall != nil {
 		ae.MethodCall.Initialize(knowledgeContext, ruleCtx, dataCtx)
 	}
+
+	if ae.IfStatement != nil {
+		ae.IfStatement.Initialize(knowledgeContext, ruleCtx, dataCtx)
+	}
+
+	if ae.ForStatement != nil {
+		ae.ForStatement.Initialize(knowledgeContext, ruleCtx, dataCtx)
+	}
+
+	if ae.ExpressionAtom != nil {
+		ae.ExpressionAtom.Initialize(knowledgeContext, ruleCtx, dataCtx)
+	}
+
+	if ae.Selector != nil {
+		ae.Selector.Initialize(knowledgeContext, ruleCtx, dataCtx)
+	}
 }
 
 // AcceptFunctionCall prepare this graph for function call.
@@ -47,6 +70,33 @@ func (ae *AssignExpression) AcceptMethodCall(methodCall *MethodCall) error {
 	return nil
 }
 
+// AcceptIfStatement prepare this graph for conditional statement.
+func (ae *AssignExpression) AcceptIfStatement(ifStmt *IfStatement) error {
+	ae.IfStatement = ifStmt
+	return nil
+}
+
+// AcceptForStatement prepare this graph for loop statement.
+func (ae *AssignExpression) AcceptForStatement(forStmt *ForStatement) error {
+	ae.ForStatement = forStmt
+	return nil
+}
+
+// AcceptExpressionAtom prepare this graph with the receiver of a chained method call.
+func (ae *AssignExpression) AcceptExpressionAtom(exprAtom *ExpressionAtom) error {
+	ae.ExpressionAtom = exprAtom
+	return nil
+}
+
+// AcceptSelector prepare this graph for chained method call on the receiver.
+func (ae *AssignExpression) AcceptSelector(sel *Selector) error {
+	if !sel.MethodCall {
+		return errors.Errorf("member %s is accessed but not used, only a method call can be a statement", sel.Name)
+	}
+	ae.Selector = sel
+	return nil
+}
+
 // Evaluate the object graph against underlined context or execute evaluation in the sub graph.
 func (ae *AssignExpression) Evaluate() (reflect.Value, error) {
 	if ae.Assignment != nil {
@@ -58,6 +108,19 @@ func (ae *AssignExpression) Evaluate() (reflect.Value, error) {
 	if ae.MethodCall != nil {
 		return ae.MethodCall.Evaluate()
 	}
-	return reflect.ValueOf(nil), errors.Errorf("no assignment, function or method call to evaluate")
+	if ae.IfStatement != nil {
+		return ae.IfStatement.Evaluate()
+	}
+	if ae.ForStatement != nil {
+		return ae.ForStatement.Evaluate()
+	}
+	if ae.Selector != nil {
+		receiver, err := ae.ExpressionAtom.Evaluate()
+		if err != nil {
+			return reflect.ValueOf(nil), errors.Trace(err)
+		}
+		return ae.Selector.EvaluateOn(receiver)
+	}
+	return reflect.ValueOf(nil), errors.Errorf("no assignment, function, method call, if or for statement to evaluate")
 
 }
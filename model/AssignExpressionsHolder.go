@@ -0,0 +1,6 @@
+package model
+
+// AssignExpressionsHolder defines all graph that should be able to store a list of assignment expressions.
+type AssignExpressionsHolder interface {
+	AcceptAssignExpressions(assigns *AssignExpressions) error
+}
@@ -12,9 +12,12 @@ import (
 type Assignment struct {
 	Variable         string
 	Expression       *Expression
+	Line             int
+	Column           int
 	knowledgeContext *context.KnowledgeContext
 	ruleCtx          *context.RuleContext
 	dataCtx          *context.DataContext
+	path             *context.Path
 }
 
 // Initialize will prepare this graph with contexts.
@@ -22,6 +25,7 @@ func (assign *Assignment) Initialize(knowledgeContext *context.KnowledgeContext,
 	assign.knowledgeContext = knowledgeContext
 	assign.ruleCtx = ruleCtx
 	assign.dataCtx = dataCtx
+	assign.path = variablePath(assign.path, assign.Variable)
 
 	if assign.Expression != nil {
 		assign.Expression.Initialize(knowledgeContext, ruleCtx, dataCtx)
@@ -53,7 +57,7 @@ func (assign *Assignment) Evaluate() (reflect.Value, error) {
 		log.Errorf("Evaluate Got error %v", err)
 		return reflect.ValueOf(nil), errors.Trace(err)
 	}
-	err = assign.dataCtx.SetValue(assign.Variable, v)
+	err = assign.dataCtx.SetPathValue(assign.path, v)
 	if err != nil {
 		log.Errorf("SetValue Got error %v", err)
 		return reflect.ValueOf(nil), errors.Trace(err)
@@ -1,12 +1,16 @@
 package model
 
 var (
-	ComparisonOperatorGT  = ComparisonOperator(">")
-	ComparisonOperatorLT  = ComparisonOperator("<")
-	ComparisonOperatorGTE = ComparisonOperator(">=")
-	ComparisonOperatorLTE = ComparisonOperator("<=")
-	ComparisonOperatorEQ  = ComparisonOperator("==")
-	ComparisonOperatorNEQ = ComparisonOperator("!=")
+	ComparisonOperatorGT       = ComparisonOperator(">")
+	ComparisonOperatorLT       = ComparisonOperator("<")
+	ComparisonOperatorGTE      = ComparisonOperator(">=")
+	ComparisonOperatorLTE      = ComparisonOperator("<=")
+	ComparisonOperatorEQ       = ComparisonOperator("==")
+	ComparisonOperatorNEQ      = ComparisonOperator("!=")
+	ComparisonOperatorIn       = ComparisonOperator("in")
+	ComparisonOperatorNotIn    = ComparisonOperator("not in")
+	ComparisonOperatorContains = ComparisonOperator("contains")
+	ComparisonOperatorMatches  = ComparisonOperator("matches")
 )
 
 type ComparisonOperator string
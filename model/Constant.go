@@ -1,16 +1,26 @@
 package model
 
 import (
+	"github.com/juju/errors"
 	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/pkg"
+	"math/big"
 	"reflect"
 )
 
-// Constant holds a constants, it holds a simple golang value.
+// Constant holds a constants, it holds a simple golang value,
+// or a list, map or struct literal built from other constants. Text is the literal as written in the rule.
 type Constant struct {
+	Text             string
 	ConstantValue    reflect.Value
+	ConstantKind     ConstantKind
+	Keys             []*Constant
+	FieldNames       []string
+	Values           []*Constant
 	knowledgeContext *context.KnowledgeContext
 	ruleCtx          *context.RuleContext
 	dataCtx          *context.DataContext
+	decimal          *big.Rat
 }
 
 // Initialize will initialize this graph with context
@@ -18,11 +28,88 @@ func (cons *Constant) Initialize(knowledgeContext *context.KnowledgeContext, rul
 	cons.knowledgeContext = knowledgeContext
 	cons.ruleCtx = ruleCtx
 	cons.dataCtx = dataCtx
+
+	for _, key := range cons.Keys {
+		key.Initialize(knowledgeContext, ruleCtx, dataCtx)
+	}
+	for _, val := range cons.Values {
+		val.Initialize(knowledgeContext, ruleCtx, dataCtx)
+	}
 }
 
 // Evaluate the object graph against underlined context or execute evaluation in the sub graph.
+// List, map and struct literals are built anew on each evaluation so facts never share them.
 func (cons *Constant) Evaluate() (reflect.Value, error) {
-	return cons.ConstantValue, nil
+	switch cons.ConstantKind {
+	case ConstantKindList, ConstantKindStruct:
+		values, err := evaluateConstants(cons.Values)
+		if err != nil {
+			return reflect.ValueOf(nil), errors.Trace(err)
+		}
+		return cons.Compose(nil, values)
+	case ConstantKindMap:
+		keys, err := evaluateConstants(cons.Keys)
+		if err != nil {
+			return reflect.ValueOf(nil), errors.Trace(err)
+		}
+		values, err := evaluateConstants(cons.Values)
+		if err != nil {
+			return reflect.ValueOf(nil), errors.Trace(err)
+		}
+		return cons.Compose(keys, values)
+	default:
+		if cons.knowledgeContext != nil && cons.knowledgeContext.DecimalMode && cons.ConstantValue.Kind() == reflect.Float64 {
+			return reflect.ValueOf(cons.decimalValue()), nil
+		}
+		return cons.ConstantValue, nil
+	}
+}
+
+// Compose builds the list, map or struct literal from the evaluated keys and values of its elements.
+func (cons *Constant) Compose(keys, values []reflect.Value) (reflect.Value, error) {
+	switch cons.ConstantKind {
+	case ConstantKindList:
+		list := reflect.MakeSlice(reflect.SliceOf(commonType(values)), 0, len(values))
+		for _, val := range values {
+			list = reflect.Append(list, literalElement(val, list.Type().Elem()))
+		}
+		return list, nil
+	case ConstantKindMap:
+		mapType := reflect.MapOf(commonType(keys), commonType(values))
+		mapVal := reflect.MakeMapWithSize(mapType, len(keys))
+		for i, key := range keys {
+			if !key.IsValid() {
+				return reflect.ValueOf(nil), errors.Errorf("map literal key can not be null")
+			}
+			mapVal.SetMapIndex(literalElement(key, mapType.Key()), literalElement(values[i], mapType.Elem()))
+		}
+		return mapVal, nil
+	case ConstantKindStruct:
+		fields := make(map[string]interface{}, len(values))
+		for i, val := range values {
+			if val.IsValid() {
+				fields[cons.FieldNames[i]] = val.Interface()
+			} else {
+				fields[cons.FieldNames[i]] = nil
+			}
+		}
+		return reflect.ValueOf(fields), nil
+	default:
+		return reflect.ValueOf(nil), errors.Errorf("constant %s is not a list, map or struct literal", cons.Text)
+	}
+}
+
+// decimalValue returns the real constant as an exact decimal, a new one on each call so arithmetic can never alter the constant.
+func (cons *Constant) decimalValue() *big.Rat {
+	if cons.decimal == nil {
+		cons.decimal, _ = pkg.ToDecimal(cons.ConstantValue)
+	}
+	return new(big.Rat).Set(cons.decimal)
+}
+
+// Decimal returns the real constant as an exact decimal, the value it evaluates into in decimal mode.
+func (cons *Constant) Decimal() *big.Rat {
+	return cons.decimalValue()
 }
 
 // AcceptDecimal prepare this graph with a decimal value.
@@ -30,3 +117,66 @@ func (cons *Constant) AcceptDecimal(val int64) error {
 	cons.ConstantValue = reflect.ValueOf(val)
 	return nil
 }
+
+// AcceptConstant will accept an element of a list literal, or a key or value of a map or struct literal.
+// Map literal keys and values are accepted alternately.
+func (cons *Constant) AcceptConstant(elem *Constant) error {
+	switch cons.ConstantKind {
+	case ConstantKindList, ConstantKindStruct:
+		cons.Values = append(cons.Values, elem)
+	case ConstantKindMap:
+		if len(cons.Keys) == len(cons.Values) {
+			cons.Keys = append(cons.Keys, elem)
+		} else {
+			cons.Values = append(cons.Values, elem)
+		}
+	default:
+		return errors.Errorf("constant can not hold another constant")
+	}
+	return nil
+}
+
+// AcceptFieldName will accept the name of the next field of a struct literal.
+func (cons *Constant) AcceptFieldName(name string) error {
+	for _, fieldName := range cons.FieldNames {
+		if fieldName == name {
+			return errors.Errorf("duplicate field %s in struct literal", name)
+		}
+	}
+	cons.FieldNames = append(cons.FieldNames, name)
+	return nil
+}
+
+func evaluateConstants(constants []*Constant) ([]reflect.Value, error) {
+	values := make([]reflect.Value, len(constants))
+	for i, c := range constants {
+		val, err := c.Evaluate()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		values[i] = val
+	}
+	return values, nil
+}
+
+// commonType returns the type shared by all the values, or the empty interface type if they differ or are null.
+func commonType(values []reflect.Value) reflect.Type {
+	var typ reflect.Type
+	for _, val := range values {
+		if !val.IsValid() || (typ != nil && typ != val.Type()) {
+			return reflect.TypeOf((*interface{})(nil)).Elem()
+		}
+		typ = val.Type()
+	}
+	if typ == nil {
+		return reflect.TypeOf((*interface{})(nil)).Elem()
+	}
+	return typ
+}
+
+func literalElement(val reflect.Value, typ reflect.Type) reflect.Value {
+	if !val.IsValid() {
+		return reflect.Zero(typ)
+	}
+	return val
+}
@@ -0,0 +1,10 @@
+package model
+
+var (
+	ConstantKindScalar = ConstantKind(0)
+	ConstantKindList   = ConstantKind(1)
+	ConstantKindMap    = ConstantKind(2)
+	ConstantKindStruct = ConstantKind(3)
+)
+
+type ConstantKind int
@@ -3,19 +3,26 @@ package model
 import (
 	"github.com/juju/errors"
 	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/pkg"
 	"reflect"
 )
 
 // Expression hold the object graph as defined in the rule semantic.
-// an expression could hold a predicate or pair of logical operated expression.
+// an expression could hold a predicate, pair of logical operated expression or a conditional expression.
+// A conditional expression evaluates the left expression if its condition is true, otherwise the right expression.
 type Expression struct {
-	LeftExpression   *Expression
-	RightExpression  *Expression
-	LogicalOperator  LogicalOperator
-	Predicate        *Predicate
-	knowledgeContext *context.KnowledgeContext
-	ruleCtx          *context.RuleContext
-	dataCtx          *context.DataContext
+	Text                string
+	LeftExpression      *Expression
+	RightExpression     *Expression
+	LogicalOperator     LogicalOperator
+	Predicate           *Predicate
+	Conditional         bool
+	ConditionExpression *Expression
+	Line                int
+	Column              int
+	knowledgeContext    *context.KnowledgeContext
+	ruleCtx             *context.RuleContext
+	dataCtx             *context.DataContext
 }
 
 // Initialize this object graph with necessary context prior engine execution.
@@ -33,11 +40,17 @@ func (expr *Expression) Initialize(knowledgeContext *context.KnowledgeContext, r
 	if expr.Predicate != nil {
 		expr.Predicate.Initialize(knowledgeContext, ruleCtx, dataCtx)
 	}
+	if expr.ConditionExpression != nil {
+		expr.ConditionExpression.Initialize(knowledgeContext, ruleCtx, dataCtx)
+	}
 }
 
 // AcceptExpression will store expression as they are defined in the rule script, into this object graph.
+// On conditional expression, the first expression is the condition.
 func (expr *Expression) AcceptExpression(expression *Expression) error {
-	if expr.LeftExpression == nil {
+	if expr.Conditional && expr.ConditionExpression == nil {
+		expr.ConditionExpression = expression
+	} else if expr.LeftExpression == nil {
 		expr.LeftExpression = expression
 	} else if expr.RightExpression == nil {
 		expr.RightExpression = expression
@@ -52,6 +65,19 @@ func (expr *Expression) Evaluate() (reflect.Value, error) {
 	if expr.Predicate != nil {
 		return expr.Predicate.Evaluate()
 	}
+	if expr.Conditional {
+		cv, err := expr.ConditionExpression.Evaluate()
+		if err != nil {
+			return cv, errors.Trace(err)
+		}
+		if pkg.GetBaseKind(cv) != reflect.Bool {
+			return reflect.ValueOf(nil), errors.Errorf("condition of conditional expression must be a boolean expression")
+		}
+		if cv.Bool() {
+			return expr.LeftExpression.Evaluate()
+		}
+		return expr.RightExpression.Evaluate()
+	}
 	lv, err := expr.LeftExpression.Evaluate()
 	if err != nil {
 		return lv, errors.Trace(err)
@@ -9,18 +9,25 @@ import (
 )
 
 // ExpressionAtom holds an expression atom graph. it can form a mathematical expression, a simple contants, function  all, method call.
+// When NullCoalescing is set, the right expression atom is the default value used when the left one evaluates to nil.
+// When Selector is set, it is applied on the result of the left expression atom.
 type ExpressionAtom struct {
 	Text                string
 	ExpressionAtomLeft  *ExpressionAtom
 	ExpressionAtomRight *ExpressionAtom
 	MathOperator        MathOperator
+	NullCoalescing      bool
 	Variable            string
 	Constant            *Constant
 	FunctionCall        *FunctionCall
 	MethodCall          *MethodCall
+	Selector            *Selector
+	Line                int
+	Column              int
 	knowledgeContext    *context.KnowledgeContext
 	ruleCtx             *context.RuleContext
 	dataCtx             *context.DataContext
+	path                *context.Path
 }
 
 // Evaluate the object graph against underlined context or execute evaluation in the sub graph.
@@ -28,7 +35,7 @@ func (exprAtm *ExpressionAtom) Evaluate() (reflect.Value, error) {
 	//logrus.Tracef("ExpressionAtom : %s", exprAtm.Text)
 	if len(exprAtm.Variable) > 0 {
 		logrus.Tracef("ExpressionAtom Variable : %s", exprAtm.Text)
-		return exprAtm.dataCtx.GetValue(exprAtm.Variable)
+		return exprAtm.dataCtx.GetPathValue(exprAtm.path)
 	} else if exprAtm.Constant != nil {
 		logrus.Tracef("ExpressionAtom Constant : %s", exprAtm.Text)
 		return exprAtm.Constant.Evaluate()
@@ -38,6 +45,23 @@ func (exprAtm *ExpressionAtom) Evaluate() (reflect.Value, error) {
 	} else if exprAtm.MethodCall != nil {
 		logrus.Tracef("MethodCall Function : %s", exprAtm.Text)
 		return exprAtm.MethodCall.Evaluate()
+	} else if exprAtm.Selector != nil {
+		logrus.Tracef("ExpressionAtom Selector : %s", exprAtm.Text)
+		receiver, err := exprAtm.ExpressionAtomLeft.Evaluate()
+		if err != nil {
+			return reflect.ValueOf(nil), errors.Trace(err)
+		}
+		return exprAtm.Selector.EvaluateOn(receiver)
+	} else if exprAtm.NullCoalescing {
+		logrus.Tracef("ExpressionAtom NullCoalescing : %s", exprAtm.Text)
+		lv, err := exprAtm.ExpressionAtomLeft.Evaluate()
+		if err != nil {
+			return reflect.ValueOf(nil), errors.Trace(err)
+		}
+		if !pkg.IsNilValue(lv) {
+			return lv, nil
+		}
+		return exprAtm.ExpressionAtomRight.Evaluate()
 	} else {
 		logrus.Tracef("ExpressionAtom MathOps : %s", exprAtm.Text)
 		lv, err := exprAtm.ExpressionAtomLeft.Evaluate()
@@ -48,17 +72,13 @@ func (exprAtm *ExpressionAtom) Evaluate() (reflect.Value, error) {
 		if err != nil {
 			return reflect.ValueOf(nil), errors.Trace(err)
 		}
-		switch exprAtm.MathOperator {
-		case MathOperatorPlus:
-			return pkg.ValueAdd(lv, rv)
-		case MathOperatorMinus:
-			return pkg.ValueSub(lv, rv)
-		case MathOperatorMul:
-			return pkg.ValueMul(lv, rv)
-		case MathOperatorDiv:
-			return pkg.ValueDiv(lv, rv)
+		isShift := exprAtm.MathOperator == MathOperatorShiftLeft || exprAtm.MathOperator == MathOperatorShiftRight
+		if exprAtm.knowledgeContext != nil && exprAtm.knowledgeContext.StrictMath && !isShift {
+			if err := pkg.CheckSignedness(exprAtm.MathOperator.String(), lv, rv); err != nil {
+				return reflect.ValueOf(nil), errors.Trace(err)
+			}
 		}
-		return reflect.ValueOf(nil), errors.Errorf("math operation can only be applied to numerical data (eg. int, uit or float) or string")
+		return exprAtm.MathOperator.Apply(lv, rv)
 	}
 }
 
@@ -67,6 +87,7 @@ func (exprAtm *ExpressionAtom) Initialize(knowledgeContext *context.KnowledgeCon
 	exprAtm.knowledgeContext = knowledgeContext
 	exprAtm.ruleCtx = ruleCtx
 	exprAtm.dataCtx = dataCtx
+	exprAtm.path = variablePath(exprAtm.path, exprAtm.Variable)
 
 	if exprAtm.ExpressionAtomLeft != nil {
 		exprAtm.ExpressionAtomLeft.Initialize(knowledgeContext, ruleCtx, dataCtx)
@@ -87,6 +108,10 @@ func (exprAtm *ExpressionAtom) Initialize(knowledgeContext *context.KnowledgeCon
 	if exprAtm.MethodCall != nil {
 		exprAtm.MethodCall.Initialize(knowledgeContext, ruleCtx, dataCtx)
 	}
+
+	if exprAtm.Selector != nil {
+		exprAtm.Selector.Initialize(knowledgeContext, ruleCtx, dataCtx)
+	}
 }
 
 // AcceptExpressionAtom will prepare this graph an expression atom. The first invocation to this function will set the
@@ -120,6 +145,15 @@ func (exprAtm *ExpressionAtom) AcceptMethodCall(methodCall *MethodCall) error {
 	return nil
 }
 
+// AcceptSelector will prepare this graph with a selector applied on the left expression atom.
+func (exprAtm *ExpressionAtom) AcceptSelector(sel *Selector) error {
+	if exprAtm.Selector != nil {
+		return errors.Errorf("selector alredy set")
+	}
+	exprAtm.Selector = sel
+	return nil
+}
+
 // AcceptVariable will prepare this expression atom as a variable.
 func (exprAtm *ExpressionAtom) AcceptVariable(name string) error {
 	if exprAtm.Variable == "" {
@@ -137,3 +171,14 @@ func (exprAtm *ExpressionAtom) AcceptConstant(cons *Constant) error {
 	}
 	return errors.Errorf("constant already defined")
 }
+
+// variablePath returns the parsed path of the variable, reusing the one parsed before if the variable is unchanged.
+func variablePath(path *context.Path, variable string) *context.Path {
+	if len(variable) == 0 {
+		return nil
+	}
+	if path != nil && path.Variable == variable {
+		return path
+	}
+	return context.NewPath(variable)
+}
@@ -0,0 +1,151 @@
+package model
+
+import (
+	"fmt"
+	"github.com/juju/errors"
+	"github.com/newm4n/grool/context"
+	"reflect"
+	"strings"
+)
+
+// ForStatement holds a loop in the "then" scope. The assign expressions will be executed for each item
+// in the collection variable, where the item is made available as a variable named after the loop variable.
+type ForStatement struct {
+	LoopVariable      string
+	Variable          string
+	AssignExpressions *AssignExpressions
+	Line              int
+	Column            int
+	knowledgeContext  *context.KnowledgeContext
+	ruleCtx           *context.RuleContext
+	dataCtx           *context.DataContext
+	path              *context.Path
+}
+
+// Initialize will initialize this graph with context.
+func (forStmt *ForStatement) Initialize(knowledgeContext *context.KnowledgeContext, ruleCtx *context.RuleContext, dataCtx *context.DataContext) {
+	forStmt.knowledgeContext = knowledgeContext
+	forStmt.ruleCtx = ruleCtx
+	forStmt.dataCtx = dataCtx
+	forStmt.path = variablePath(forStmt.path, forStmt.Variable)
+
+	if forStmt.AssignExpressions != nil {
+		forStmt.AssignExpressions.Initialize(knowledgeContext, ruleCtx, dataCtx)
+	}
+}
+
+// AcceptVariable will set the collection variable to iterate.
+func (forStmt *ForStatement) AcceptVariable(name string) error {
+	if forStmt.Variable == "" {
+		forStmt.Variable = name
+		return nil
+	}
+	return errors.Errorf("variable already defined")
+}
+
+// AcceptAssignExpressions will set the loop body.
+func (forStmt *ForStatement) AcceptAssignExpressions(assigns *AssignExpressions) error {
+	if forStmt.AssignExpressions != nil {
+		return errors.Errorf("assign expressions were set twice in for statement")
+	}
+	forStmt.AssignExpressions = assigns
+	return nil
+}
+
+// Evaluate the object graph against underlined context or execute evaluation in the sub graph.
+func (forStmt *ForStatement) Evaluate() (reflect.Value, error) {
+	coll, err := forStmt.dataCtx.GetPathValue(forStmt.path)
+	if err != nil {
+		return reflect.ValueOf(nil), errors.Trace(err)
+	}
+	items, indexes, err := forStmt.Items(coll)
+	if err != nil {
+		return reflect.ValueOf(nil), errors.Trace(err)
+	}
+	collPath := forStmt.dataCtx.FactPath(forStmt.Variable)
+	for i, item := range items {
+		if err := forStmt.Bind(item, collPath, indexes[i]); err != nil {
+			return reflect.ValueOf(nil), errors.Trace(err)
+		}
+		_, err = forStmt.AssignExpressions.Evaluate()
+		forStmt.Unbind()
+		if err != nil {
+			return reflect.ValueOf(nil), errors.Trace(err)
+		}
+	}
+	return reflect.ValueOf(nil), nil
+}
+
+// Items returns the items of the collection to iterate, and their index as a fact path element like [0] or ["key"].
+// A nil collection has no item.
+func (forStmt *ForStatement) Items(coll reflect.Value) ([]interface{}, []string, error) {
+	for coll.Kind() == reflect.Ptr || coll.Kind() == reflect.Interface {
+		if coll.IsNil() {
+			return nil, nil, nil
+		}
+		coll = coll.Elem()
+	}
+	var items []interface{}
+	var indexes []string
+	switch coll.Kind() {
+	case reflect.Slice, reflect.Array:
+		items = make([]interface{}, coll.Len())
+		indexes = make([]string, coll.Len())
+		for i := 0; i < coll.Len(); i++ {
+			items[i] = loopItem(coll.Index(i))
+			indexes[i] = fmt.Sprintf("[%d]", i)
+		}
+	case reflect.Map:
+		items = make([]interface{}, 0, coll.Len())
+		indexes = make([]string, 0, coll.Len())
+		iter := coll.MapRange()
+		for iter.Next() {
+			items = append(items, loopItem(iter.Value()))
+			indexes = append(indexes, fmt.Sprintf("[%q]", fmt.Sprint(iter.Key().Interface())))
+		}
+	default:
+		return nil, nil, errors.Errorf("can not iterate over %s, its a %s", forStmt.Variable, coll.Kind().String())
+	}
+	max := uint64(context.DefaultMaxLoopIteration)
+	if forStmt.knowledgeContext != nil && forStmt.knowledgeContext.MaxLoopIteration > 0 {
+		max = forStmt.knowledgeContext.MaxLoopIteration
+	}
+	if uint64(len(items)) > max {
+		return nil, nil, errors.Errorf("loop over %s have %d items, exceeding the maximum of %d iterations", forStmt.Variable, len(items), max)
+	}
+	return items, indexes, nil
+}
+
+// Bind makes the item available as the loop variable, at the fact path of the collection followed by the item index.
+func (forStmt *ForStatement) Bind(item interface{}, collPath []string, index string) error {
+	var err error
+	if forStmt.dataCtx.IsReadOnly(forStmt.root()) {
+		err = forStmt.dataCtx.AddReadOnlyScopedVariable(forStmt.LoopVariable, item)
+	} else {
+		err = forStmt.dataCtx.AddScopedVariable(forStmt.LoopVariable, item)
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	forStmt.dataCtx.SetScopedVariablePath(forStmt.LoopVariable, append(collPath[:len(collPath):len(collPath)], index))
+	return nil
+}
+
+// Unbind removes the loop variable bound by Bind.
+func (forStmt *ForStatement) Unbind() {
+	forStmt.dataCtx.RemoveScopedVariable(forStmt.LoopVariable)
+}
+
+// loopItem returns the item to bind into the loop variable. Addressable structs are bound by pointer,
+// so assignment to the loop variable fields will change the item inside the collection.
+func loopItem(val reflect.Value) interface{} {
+	if val.Kind() == reflect.Struct && val.CanAddr() {
+		return val.Addr().Interface()
+	}
+	return val.Interface()
+}
+
+// root returns the fact or variable name the loop collection belongs to.
+func (forStmt *ForStatement) root() string {
+	return strings.TrimSuffix(strings.Split(forStmt.Variable, ".")[0], "?")
+}
@@ -0,0 +1,6 @@
+package model
+
+// ForStatementHolder defines all graph that should be able to store a for statement.
+type ForStatementHolder interface {
+	AcceptForStatement(forStmt *ForStatement) error
+}
@@ -11,9 +11,12 @@ import (
 type FunctionCall struct {
 	FunctionName      string
 	FunctionArguments *FunctionArgument
+	Line              int
+	Column            int
 	knowledgeContext  *context.KnowledgeContext
 	ruleCtx           *context.RuleContext
 	dataCtx           *context.DataContext
+	path              *context.Path
 }
 
 // AcceptFunctionArgument configure this function call with sets of function arguments.
@@ -27,6 +30,7 @@ func (funcCall *FunctionCall) Initialize(knowledgeContext *context.KnowledgeCont
 	funcCall.knowledgeContext = knowledgeContext
 	funcCall.ruleCtx = ruleCtx
 	funcCall.dataCtx = dataCtx
+	funcCall.path = variablePath(funcCall.path, fmt.Sprintf("DEFUNC.%s", funcCall.FunctionName))
 
 	if funcCall.FunctionArguments != nil {
 		funcCall.FunctionArguments.Initialize(knowledgeContext, ruleCtx, dataCtx)
@@ -46,6 +50,5 @@ func (funcCall *FunctionCall) Evaluate() (reflect.Value, error) {
 		argumentValues = av
 	}
 
-	fName := fmt.Sprintf("DEFUNC.%s", funcCall.FunctionName)
-	return funcCall.dataCtx.ExecMethod(fName, argumentValues)
+	return funcCall.dataCtx.ExecPathMethod(funcCall.path, argumentValues)
 }
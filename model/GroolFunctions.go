@@ -1,6 +1,7 @@
 package model
 
 import (
+	"github.com/juju/errors"
 	"github.com/newm4n/grool/pkg"
 	"log"
 	"reflect"
@@ -13,6 +14,24 @@ type GroolFunctions struct {
 	Knowledge *KnowledgeBase
 }
 
+// PureFunctions are the built-in functions which result only depends on their arguments.
+// A call to them with constant arguments is folded into a constant by KnowledgeBase Optimize.
+var PureFunctions = map[string]bool{
+	"MakeTime":      true,
+	"IsNil":         true,
+	"IsZero":        true,
+	"Round":         true,
+	"GetTimeYear":   true,
+	"GetTimeMonth":  true,
+	"GetTimeDay":    true,
+	"GetTimeHour":   true,
+	"GetTimeMinute": true,
+	"GetTimeSecond": true,
+	"IsTimeBefore":  true,
+	"IsTimeAfter":   true,
+	"TimeFormat":    true,
+}
+
 func (gf *GroolFunctions) MakeTime(year, month, day, hour, minute, second int64) time.Time {
 	return time.Date(int(year), time.Month(month), int(day), int(hour), int(minute), int(second), 0, time.Local)
 }
@@ -63,6 +82,42 @@ func (gf *GroolFunctions) IsZero(i interface{}) bool {
 	}
 }
 
+// Round will round a number into the number of decimal places using the rounding mode, which is one of
+// HALF_UP, HALF_DOWN, HALF_EVEN, UP, DOWN, CEILING or FLOOR. A decimal is rounded exactly and stays a decimal,
+// a float is rounded as a decimal and converted back into a float. Negative places round to the left of the decimal
+// point, which is the only rounding an integer gets.
+func (gf *GroolFunctions) Round(i interface{}, places int64, mode string) (interface{}, error) {
+	val := reflect.ValueOf(i)
+	isInteger := false
+	switch pkg.GetBaseKind(val) {
+	case reflect.Int64, reflect.Uint64:
+		if places >= 0 {
+			return i, nil
+		}
+		isInteger = true
+	}
+	r, err := pkg.ToDecimal(val)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	rounded, err := pkg.RoundDecimal(r, int(places), pkg.RoundingMode(mode))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if isInteger {
+		converted, err := pkg.ConvertDecimal(rounded, val.Type())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return converted.Interface(), nil
+	}
+	if pkg.IsDecimal(val) {
+		return rounded, nil
+	}
+	f, _ := rounded.Float64()
+	return f, nil
+}
+
 // Retract will retract a rule from next evaluation cycle.
 func (gf *GroolFunctions) Retract(ruleName string) {
 	gf.Knowledge.Retract(strings.ReplaceAll(ruleName, "\"", ""))
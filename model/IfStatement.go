@@ -0,0 +1,91 @@
+package model
+
+import (
+	"github.com/juju/errors"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/pkg"
+	"reflect"
+)
+
+// IfStatement holds a conditional block in the "then" scope. When the expression evaluates to true, the then block
+// will be executed, otherwise the else-if statement or else block will be executed if they are defined.
+type IfStatement struct {
+	Expression            *Expression
+	AssignExpressions     *AssignExpressions
+	ElseIfStatement       *IfStatement
+	ElseAssignExpressions *AssignExpressions
+	knowledgeContext      *context.KnowledgeContext
+	ruleCtx               *context.RuleContext
+	dataCtx               *context.DataContext
+}
+
+// Initialize will initialize this graph with context.
+func (ifStmt *IfStatement) Initialize(knowledgeContext *context.KnowledgeContext, ruleCtx *context.RuleContext, dataCtx *context.DataContext) {
+	ifStmt.knowledgeContext = knowledgeContext
+	ifStmt.ruleCtx = ruleCtx
+	ifStmt.dataCtx = dataCtx
+
+	if ifStmt.Expression != nil {
+		ifStmt.Expression.Initialize(knowledgeContext, ruleCtx, dataCtx)
+	}
+	if ifStmt.AssignExpressions != nil {
+		ifStmt.AssignExpressions.Initialize(knowledgeContext, ruleCtx, dataCtx)
+	}
+	if ifStmt.ElseIfStatement != nil {
+		ifStmt.ElseIfStatement.Initialize(knowledgeContext, ruleCtx, dataCtx)
+	}
+	if ifStmt.ElseAssignExpressions != nil {
+		ifStmt.ElseAssignExpressions.Initialize(knowledgeContext, ruleCtx, dataCtx)
+	}
+}
+
+// AcceptExpression will set the condition of this if statement.
+func (ifStmt *IfStatement) AcceptExpression(expression *Expression) error {
+	if ifStmt.Expression != nil {
+		return errors.Errorf("expression were set twice in if statement")
+	}
+	ifStmt.Expression = expression
+	return nil
+}
+
+// AcceptAssignExpressions will set the then block on the first call and the else block on the second.
+func (ifStmt *IfStatement) AcceptAssignExpressions(assigns *AssignExpressions) error {
+	if ifStmt.AssignExpressions == nil {
+		ifStmt.AssignExpressions = assigns
+	} else if ifStmt.ElseAssignExpressions == nil && ifStmt.ElseIfStatement == nil {
+		ifStmt.ElseAssignExpressions = assigns
+	} else {
+		return errors.Errorf("if statement block alredy set twice")
+	}
+	return nil
+}
+
+// AcceptIfStatement will set the else-if statement of this if statement.
+func (ifStmt *IfStatement) AcceptIfStatement(elseIf *IfStatement) error {
+	if ifStmt.ElseIfStatement != nil || ifStmt.ElseAssignExpressions != nil {
+		return errors.Errorf("else statement already defined")
+	}
+	ifStmt.ElseIfStatement = elseIf
+	return nil
+}
+
+// Evaluate the object graph against underlined context or execute evaluation in the sub graph.
+func (ifStmt *IfStatement) Evaluate() (reflect.Value, error) {
+	val, err := ifStmt.Expression.Evaluate()
+	if err != nil {
+		return reflect.ValueOf(nil), errors.Trace(err)
+	}
+	if pkg.GetBaseKind(val) != reflect.Bool {
+		return reflect.ValueOf(nil), errors.Errorf("if statement condition must be a boolean expression")
+	}
+	if val.Bool() {
+		return ifStmt.AssignExpressions.Evaluate()
+	}
+	if ifStmt.ElseIfStatement != nil {
+		return ifStmt.ElseIfStatement.Evaluate()
+	}
+	if ifStmt.ElseAssignExpressions != nil {
+		return ifStmt.ElseAssignExpressions.Evaluate()
+	}
+	return reflect.ValueOf(nil), nil
+}
@@ -0,0 +1,6 @@
+package model
+
+// IfStatementHolder defines all graph that should be able to store an if statement.
+type IfStatementHolder interface {
+	AcceptIfStatement(ifStmt *IfStatement) error
+}
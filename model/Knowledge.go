@@ -1,17 +1,49 @@
 package model
 
+import (
+	"github.com/juju/errors"
+	"github.com/newm4n/grool/pkg"
+	"reflect"
+)
+
 // KnowledgeBase hold list of rule entry to be evaluated in each cycle.
+// Facts holds the fact schemas declared using DeclareFact, used to type check the rules as they are built,
+// and ReadOnlyFacts the names of those declared using DeclareReadOnlyFact.
+// JSONTags makes the json struct tag name a field of the declared facts, like the DataContext JSONTags.
 type KnowledgeBase struct {
-	RuleEntries map[string]*RuleEntry
+	RuleEntries   map[string]*RuleEntry
+	Facts         map[string]reflect.Type
+	ReadOnlyFacts map[string]bool
+	JSONTags      bool
+
+	compiled         interface{}
+	compiledJSONTags bool
 }
 
 // NewKnowledgeBase create new instance of knowledge
 func NewKnowledgeBase() *KnowledgeBase {
 	return &KnowledgeBase{
-		RuleEntries: make(map[string]*RuleEntry),
+		RuleEntries:   make(map[string]*RuleEntry),
+		Facts:         make(map[string]reflect.Type),
+		ReadOnlyFacts: make(map[string]bool),
 	}
 }
 
+// Compiled returns the rules compiled by package vm, as cached using SetCompiled. It is nil once the rules are
+// optimized, a fact is declared or JSONTags is changed, as the compiled rules depend on the declared fact types.
+func (k *KnowledgeBase) Compiled() interface{} {
+	if k.compiledJSONTags != k.JSONTags {
+		return nil
+	}
+	return k.compiled
+}
+
+// SetCompiled caches the rules compiled by package vm.
+func (k *KnowledgeBase) SetCompiled(compiled interface{}) {
+	k.compiled = compiled
+	k.compiledJSONTags = k.JSONTags
+}
+
 // Retract retract a rule entry from next evaluation cycle.
 func (k *KnowledgeBase) Retract(ruleEntryName string) {
 	if re, ok := k.RuleEntries[ruleEntryName]; ok {
@@ -25,3 +57,28 @@ func (k *KnowledgeBase) Reset() {
 		v.Retracted = false
 	}
 }
+
+// DeclareFact declares the type of a fact that will be added into the data context under the specified name.
+// Once a fact is declared, the rule builder checks every rule against the declared facts, and a rule referring
+// an undeclared fact, a non existent member or method, or applying an operator on incompatible types fails to build.
+func (k *KnowledgeBase) DeclareFact(name string, fact interface{}) error {
+	if !pkg.IsStruct(fact) || reflect.TypeOf(fact).Kind() != reflect.Ptr {
+		return errors.Errorf("fact %s must be a pointer to a struct", name)
+	}
+	k.Facts[name] = reflect.TypeOf(fact)
+	k.compiled = nil
+	return nil
+}
+
+// DeclareReadOnlyFact declares the type of a fact that will be added into the data context using AddReadOnly,
+// so a rule assigning the fact fails to build.
+func (k *KnowledgeBase) DeclareReadOnlyFact(name string, fact interface{}) error {
+	if err := k.DeclareFact(name, fact); err != nil {
+		return err
+	}
+	if k.ReadOnlyFacts == nil {
+		k.ReadOnlyFacts = make(map[string]bool)
+	}
+	k.ReadOnlyFacts[name] = true
+	return nil
+}
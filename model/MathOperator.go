@@ -1,10 +1,73 @@
 package model
 
+import (
+	"github.com/juju/errors"
+	"github.com/newm4n/grool/pkg"
+	"reflect"
+)
+
 var (
 	MathOperatorMul   = MathOperator(1)
 	MathOperatorDiv   = MathOperator(2)
 	MathOperatorPlus  = MathOperator(3)
 	MathOperatorMinus = MathOperator(4)
+
+	MathOperatorBitAnd     = MathOperator(5)
+	MathOperatorBitOr      = MathOperator(6)
+	MathOperatorBitXor     = MathOperator(7)
+	MathOperatorShiftLeft  = MathOperator(8)
+	MathOperatorShiftRight = MathOperator(9)
 )
 
 type MathOperator int
+
+// String returns the symbol of the math operator.
+func (op MathOperator) String() string {
+	switch op {
+	case MathOperatorMul:
+		return "*"
+	case MathOperatorDiv:
+		return "/"
+	case MathOperatorPlus:
+		return "+"
+	case MathOperatorMinus:
+		return "-"
+	case MathOperatorBitAnd:
+		return "&"
+	case MathOperatorBitOr:
+		return "|"
+	case MathOperatorBitXor:
+		return "^"
+	case MathOperatorShiftLeft:
+		return "<<"
+	case MathOperatorShiftRight:
+		return ">>"
+	default:
+		return "?"
+	}
+}
+
+// Apply applies the math operator between the left and right value.
+func (op MathOperator) Apply(lv, rv reflect.Value) (reflect.Value, error) {
+	switch op {
+	case MathOperatorPlus:
+		return pkg.ValueAdd(lv, rv)
+	case MathOperatorMinus:
+		return pkg.ValueSub(lv, rv)
+	case MathOperatorMul:
+		return pkg.ValueMul(lv, rv)
+	case MathOperatorDiv:
+		return pkg.ValueDiv(lv, rv)
+	case MathOperatorBitAnd:
+		return pkg.ValueBitAnd(lv, rv)
+	case MathOperatorBitOr:
+		return pkg.ValueBitOr(lv, rv)
+	case MathOperatorBitXor:
+		return pkg.ValueBitXor(lv, rv)
+	case MathOperatorShiftLeft:
+		return pkg.ValueShiftLeft(lv, rv)
+	case MathOperatorShiftRight:
+		return pkg.ValueShiftRight(lv, rv)
+	}
+	return reflect.ValueOf(nil), errors.Errorf("math operation can only be applied to numerical data (eg. int, uit or float) or string")
+}
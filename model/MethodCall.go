@@ -10,9 +10,12 @@ import (
 type MethodCall struct {
 	MethodName       string
 	MethodArguments  *FunctionArgument
+	Line             int
+	Column           int
 	knowledgeContext *context.KnowledgeContext
 	ruleCtx          *context.RuleContext
 	dataCtx          *context.DataContext
+	path             *context.Path
 }
 
 // Initialize will initialize this graph with context.
@@ -20,6 +23,7 @@ func (methCall *MethodCall) Initialize(knowledgeContext *context.KnowledgeContex
 	methCall.knowledgeContext = knowledgeContext
 	methCall.ruleCtx = ruleCtx
 	methCall.dataCtx = dataCtx
+	methCall.path = variablePath(methCall.path, methCall.MethodName)
 
 	if methCall.MethodArguments != nil {
 		methCall.MethodArguments.Initialize(knowledgeContext, ruleCtx, dataCtx)
@@ -45,5 +49,5 @@ func (methCall *MethodCall) Evaluate() (reflect.Value, error) {
 		argumentValues = av
 	}
 
-	return methCall.dataCtx.ExecMethod(methCall.MethodName, argumentValues)
+	return methCall.dataCtx.ExecPathMethod(methCall.path, argumentValues)
 }
@@ -0,0 +1,462 @@
+package model
+
+import (
+	"fmt"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/pkg"
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+// Optimize simplifies the rule entries at build time, so what does not depend on the facts is not computed again
+// on every cycle. Math operations, comparisons and calls to PureFunctions with constant operands are folded into
+// constants, a boolean compared with true or false is replaced by itself, a logical operation with a constant
+// operand that does not change its result is replaced by the other operand, and a conditional expression or if
+// statement with a constant condition keeps only the branch it takes. It returns the number of simplifications.
+// Anything which evaluation fails, or gives another result in decimal mode, is left to fail at execution.
+func (k *KnowledgeBase) Optimize(entries ...*RuleEntry) int {
+	dataCtx := context.NewDataContext()
+	dataCtx.Add("DEFUNC", &GroolFunctions{Knowledge: k})
+	folded := 0
+	for _, entry := range entries {
+		o := &optimizer{
+			dataCtx: dataCtx,
+			types: &typeChecker{
+				knowledgeBase: k,
+				ruleName:      entry.RuleName,
+				scope:         make(map[string]reflect.Type),
+				readOnly:      make(map[string]bool),
+			},
+		}
+		if entry.WhenScope != nil {
+			o.expression(entry.WhenScope.Expression)
+		}
+		if entry.ThenScope != nil {
+			o.assignExpressions(entry.ThenScope.AssignExpressions)
+		}
+		folded += o.folded
+	}
+	k.compiled = nil
+	return folded
+}
+
+// optimizer simplifies a rule entry graph in place. The type checker tells which operands are booleans, its errors
+// are ignored, and the data context calls the pure functions.
+type optimizer struct {
+	dataCtx *context.DataContext
+	types   *typeChecker
+	folded  int
+}
+
+// scalar returns the value of the atom if it is a scalar constant.
+func scalar(exprAtm *ExpressionAtom) (reflect.Value, bool) {
+	if exprAtm == nil || exprAtm.Constant == nil || exprAtm.Constant.ConstantKind != ConstantKindScalar {
+		return reflect.Value{}, false
+	}
+	return exprAtm.Constant.ConstantValue, true
+}
+
+// foldable checks the value can be held by a constant, like the literals.
+func foldable(val reflect.Value) bool {
+	if !val.IsValid() {
+		return false
+	}
+	if val.Type().String() == TimeTypeString {
+		return true
+	}
+	switch pkg.GetBaseKind(val) {
+	case reflect.Int64, reflect.Uint64, reflect.Float64, reflect.String, reflect.Bool:
+		return true
+	default:
+		return false
+	}
+}
+
+// decimalOperand returns the value of the constant in decimal mode.
+func decimalOperand(cons *Constant) reflect.Value {
+	if cons.ConstantValue.Kind() == reflect.Float64 {
+		return reflect.ValueOf(cons.Decimal())
+	}
+	return cons.ConstantValue
+}
+
+func (o *optimizer) setConstant(exprAtm *ExpressionAtom, cons *Constant) {
+	*exprAtm = ExpressionAtom{
+		Text:     exprAtm.Text,
+		Constant: cons,
+		Line:     exprAtm.Line,
+		Column:   exprAtm.Column,
+	}
+	o.folded++
+}
+
+// setBool turns the expression into a predicate of a boolean constant.
+func (o *optimizer) setBool(expr *Expression, val bool) {
+	*expr = Expression{
+		Text: expr.Text,
+		Predicate: &Predicate{
+			ExpressionAtomLeft: &ExpressionAtom{
+				Text:     expr.Text,
+				Constant: &Constant{Text: strconv.FormatBool(val), ConstantValue: reflect.ValueOf(val)},
+				Line:     expr.Line,
+				Column:   expr.Column,
+			},
+			Line:   expr.Line,
+			Column: expr.Column,
+		},
+		Line:   expr.Line,
+		Column: expr.Column,
+	}
+	o.folded++
+}
+
+// constantBool returns the value of an expression which is a boolean constant.
+func constantBool(expr *Expression) (bool, bool) {
+	if expr == nil || expr.Predicate == nil || expr.Predicate.ExpressionAtomRight != nil || len(expr.Predicate.ExpressionAtomList) > 0 {
+		return false, false
+	}
+	val, ok := scalar(expr.Predicate.ExpressionAtomLeft)
+	if !ok || val.Kind() != reflect.Bool {
+		return false, false
+	}
+	return val.Bool(), true
+}
+
+func (o *optimizer) isBool(exprAtm *ExpressionAtom) bool {
+	typ := o.types.checkExpressionAtom(exprAtm)
+	return typ != nil && typ.Kind() == reflect.Bool
+}
+
+func (o *optimizer) isBoolExpression(expr *Expression) bool {
+	typ := o.types.checkExpression(expr)
+	return typ != nil && typ.Kind() == reflect.Bool
+}
+
+// expression simplifies the expression, it returns true if the expression is a scalar constant afterwards.
+func (o *optimizer) expression(expr *Expression) bool {
+	if expr == nil {
+		return false
+	}
+	if expr.Predicate != nil {
+		return o.predicate(expr.Predicate, expr.Text)
+	}
+	if expr.Conditional {
+		o.expression(expr.ConditionExpression)
+		o.expression(expr.LeftExpression)
+		o.expression(expr.RightExpression)
+		cond, ok := constantBool(expr.ConditionExpression)
+		if !ok {
+			return false
+		}
+		if cond {
+			*expr = *expr.LeftExpression
+		} else {
+			*expr = *expr.RightExpression
+		}
+		o.folded++
+		if expr.Predicate == nil || expr.Predicate.ExpressionAtomRight != nil || len(expr.Predicate.ExpressionAtomList) > 0 {
+			return false
+		}
+		_, ok = scalar(expr.Predicate.ExpressionAtomLeft)
+		return ok
+	}
+	o.expression(expr.LeftExpression)
+	o.expression(expr.RightExpression)
+	and := expr.LogicalOperator == LogicalOperatorAnd
+	left, leftOk := constantBool(expr.LeftExpression)
+	right, rightOk := constantBool(expr.RightExpression)
+	switch {
+	case leftOk && rightOk:
+		if and {
+			o.setBool(expr, left && right)
+		} else {
+			o.setBool(expr, left || right)
+		}
+		return true
+	// both operands are evaluated, so true && x and false || x are x, but false && x is false only if x never fails.
+	case leftOk && left == and && o.isBoolExpression(expr.RightExpression):
+		*expr = *expr.RightExpression
+		o.folded++
+	case rightOk && right == and && o.isBoolExpression(expr.LeftExpression):
+		*expr = *expr.LeftExpression
+		o.folded++
+	}
+	return false
+}
+
+// predicate simplifies the predicate, it returns true if the predicate is a single scalar constant afterwards.
+func (o *optimizer) predicate(prdct *Predicate, text string) bool {
+	left := o.atom(prdct.ExpressionAtomLeft)
+	if prdct.ComparisonOperator == ComparisonOperatorIn || prdct.ComparisonOperator == ComparisonOperatorNotIn {
+		constant := left
+		for _, exprAtom := range prdct.ExpressionAtomList {
+			constant = o.atom(exprAtom) && constant
+		}
+		return constant && o.foldPredicate(prdct, text)
+	}
+	if prdct.ExpressionAtomRight == nil {
+		return left
+	}
+	right := o.atom(prdct.ExpressionAtomRight)
+	if left && right {
+		return o.foldPredicate(prdct, text)
+	}
+	// x == true, and the double negation x != false, are x itself if x is a boolean.
+	if val, ok := scalar(prdct.ExpressionAtomRight); ok && isIdentity(prdct.ComparisonOperator, val) && o.isBool(prdct.ExpressionAtomLeft) {
+		prdct.ExpressionAtomRight, prdct.ComparisonOperator = nil, ""
+		o.folded++
+	} else if val, ok := scalar(prdct.ExpressionAtomLeft); ok && isIdentity(prdct.ComparisonOperator, val) && o.isBool(prdct.ExpressionAtomRight) {
+		prdct.ExpressionAtomLeft, prdct.ExpressionAtomRight, prdct.ComparisonOperator = prdct.ExpressionAtomRight, nil, ""
+		o.folded++
+	}
+	return false
+}
+
+// isIdentity checks if comparing a boolean with the value using the operator gives the boolean itself.
+func isIdentity(op ComparisonOperator, val reflect.Value) bool {
+	if val.Kind() != reflect.Bool {
+		return false
+	}
+	return (op == ComparisonOperatorEQ && val.Bool()) || (op == ComparisonOperatorNEQ && !val.Bool())
+}
+
+// foldPredicate evaluates the predicate of constant atoms into a boolean constant. Reals compare differently in
+// decimal mode, so they are not folded.
+func (o *optimizer) foldPredicate(prdct *Predicate, text string) bool {
+	atoms := append([]*ExpressionAtom{prdct.ExpressionAtomLeft, prdct.ExpressionAtomRight}, prdct.ExpressionAtomList...)
+	for _, exprAtom := range atoms {
+		if val, ok := scalar(exprAtom); ok && val.Kind() == reflect.Float64 {
+			return false
+		}
+	}
+	val, err := prdct.Evaluate()
+	if err != nil || !val.IsValid() || val.Kind() != reflect.Bool {
+		return false
+	}
+	*prdct = Predicate{
+		ExpressionAtomLeft: &ExpressionAtom{
+			Text:     text,
+			Constant: &Constant{Text: strconv.FormatBool(val.Bool()), ConstantValue: val},
+			Line:     prdct.Line,
+			Column:   prdct.Column,
+		},
+		Line:   prdct.Line,
+		Column: prdct.Column,
+	}
+	o.folded++
+	return true
+}
+
+// atom simplifies the expression atom, it returns true if the atom is a scalar constant afterwards.
+func (o *optimizer) atom(exprAtm *ExpressionAtom) bool {
+	switch {
+	case exprAtm == nil:
+		return false
+	case exprAtm.Constant != nil:
+		_, ok := scalar(exprAtm)
+		return ok
+	case len(exprAtm.Variable) > 0:
+		return false
+	case exprAtm.FunctionCall != nil:
+		cons := o.call(exprAtm.FunctionCall, exprAtm.Text)
+		if cons == nil {
+			return false
+		}
+		o.setConstant(exprAtm, cons)
+		return true
+	case exprAtm.MethodCall != nil:
+		o.arguments(exprAtm.MethodCall.MethodArguments)
+		return false
+	case exprAtm.Selector != nil:
+		o.atom(exprAtm.ExpressionAtomLeft)
+		if exprAtm.Selector.MethodCall {
+			o.arguments(exprAtm.Selector.MethodArguments)
+		}
+		return false
+	case exprAtm.NullCoalescing:
+		left := o.atom(exprAtm.ExpressionAtomLeft)
+		right := o.atom(exprAtm.ExpressionAtomRight)
+		if !left {
+			return false
+		}
+		if val, _ := scalar(exprAtm.ExpressionAtomLeft); pkg.IsNilValue(val) {
+			*exprAtm = *exprAtm.ExpressionAtomRight
+			o.folded++
+			return right
+		}
+		*exprAtm = *exprAtm.ExpressionAtomLeft
+		o.folded++
+		return true
+	default:
+		left := o.atom(exprAtm.ExpressionAtomLeft)
+		right := o.atom(exprAtm.ExpressionAtomRight)
+		if !left || !right {
+			return false
+		}
+		cons := o.math(exprAtm)
+		if cons == nil {
+			return false
+		}
+		o.setConstant(exprAtm, cons)
+		return true
+	}
+}
+
+// math applies the math operator on constant operands. A real result also keeps its exact decimal, so folding
+// does not change the result in decimal mode.
+func (o *optimizer) math(exprAtm *ExpressionAtom) *Constant {
+	lc, rc := exprAtm.ExpressionAtomLeft.Constant, exprAtm.ExpressionAtomRight.Constant
+	lv, rv := lc.ConstantValue, rc.ConstantValue
+	op := exprAtm.MathOperator
+	// strict math refuses to mix signed and unsigned integers, let the execution tell.
+	if op != MathOperatorShiftLeft && op != MathOperatorShiftRight && pkg.CheckSignedness(op.String(), lv, rv) != nil {
+		return nil
+	}
+	val, err := op.Apply(lv, rv)
+	if err != nil || !foldable(val) {
+		return nil
+	}
+	cons := &Constant{Text: exprAtm.Text, ConstantValue: val}
+	if lv.Kind() != reflect.Float64 && rv.Kind() != reflect.Float64 {
+		return cons
+	}
+	if val.Kind() != reflect.Float64 {
+		return nil
+	}
+	decimal, err := op.Apply(decimalOperand(lc), decimalOperand(rc))
+	if err != nil || !pkg.IsDecimal(decimal) {
+		return nil
+	}
+	cons.decimal = decimal.Interface().(*big.Rat)
+	return cons
+}
+
+// call evaluates the call of a pure function with constant arguments. Reals are not decimals yet, so a call with
+// a real argument is not folded.
+func (o *optimizer) call(funcCall *FunctionCall, text string) *Constant {
+	if !o.arguments(funcCall.FunctionArguments) || !PureFunctions[funcCall.FunctionName] {
+		return nil
+	}
+	if funcCall.FunctionArguments != nil {
+		for _, arg := range funcCall.FunctionArguments.Arguments {
+			if arg.Constant.ConstantValue.Kind() == reflect.Float64 {
+				return nil
+			}
+		}
+	}
+	funcCall.Initialize(nil, nil, o.dataCtx)
+	val, err := funcCall.Evaluate()
+	if err != nil || !foldable(val) {
+		return nil
+	}
+	if len(text) == 0 {
+		text = fmt.Sprintf("%v", val.Interface())
+	}
+	return &Constant{Text: text, ConstantValue: val}
+}
+
+// arguments simplifies the arguments, it returns true if all of them are scalar constants afterwards.
+func (o *optimizer) arguments(funcArg *FunctionArgument) bool {
+	if funcArg == nil {
+		return true
+	}
+	constant := true
+	for _, arg := range funcArg.Arguments {
+		switch {
+		case arg.Constant != nil:
+			constant = constant && arg.Constant.ConstantKind == ConstantKindScalar
+		case arg.FunctionCall != nil:
+			if cons := o.call(arg.FunctionCall, ""); cons != nil {
+				arg.FunctionCall, arg.Constant = nil, cons
+				o.folded++
+			} else {
+				constant = false
+			}
+		case arg.MethodCall != nil:
+			o.arguments(arg.MethodCall.MethodArguments)
+			constant = false
+		case arg.Expression != nil:
+			if o.expression(arg.Expression) {
+				arg.Expression, arg.Constant = nil, arg.Expression.Predicate.ExpressionAtomLeft.Constant
+			} else {
+				constant = false
+			}
+		default:
+			constant = false
+		}
+	}
+	return constant
+}
+
+func (o *optimizer) assignExpressions(assigns *AssignExpressions) {
+	if assigns == nil {
+		return
+	}
+	list := make([]*AssignExpression, 0, len(assigns.ExpressionList))
+	for _, ae := range assigns.ExpressionList {
+		switch {
+		case ae.Assignment != nil:
+			o.expression(ae.Assignment.Expression)
+		case ae.FunctionCall != nil:
+			o.arguments(ae.FunctionCall.FunctionArguments)
+		case ae.MethodCall != nil:
+			o.arguments(ae.MethodCall.MethodArguments)
+		case ae.IfStatement != nil:
+			list = append(list, o.ifStatement(ae)...)
+			continue
+		case ae.ForStatement != nil:
+			o.forStatement(ae.ForStatement)
+		case ae.Selector != nil:
+			o.atom(ae.ExpressionAtom)
+			if ae.Selector.MethodCall {
+				o.arguments(ae.Selector.MethodArguments)
+			}
+		}
+		list = append(list, ae)
+	}
+	assigns.ExpressionList = list
+}
+
+// ifStatement simplifies the if statement, and returns the statements replacing it. It is the statement itself,
+// unless its condition is a boolean constant.
+func (o *optimizer) ifStatement(ae *AssignExpression) []*AssignExpression {
+	ifStmt := ae.IfStatement
+	o.expression(ifStmt.Expression)
+	o.assignExpressions(ifStmt.AssignExpressions)
+	o.assignExpressions(ifStmt.ElseAssignExpressions)
+	if ifStmt.ElseIfStatement != nil {
+		elseIf := &AssignExpression{IfStatement: ifStmt.ElseIfStatement, Line: ae.Line, Column: ae.Column}
+		if stmts := o.ifStatement(elseIf); len(stmts) == 0 {
+			ifStmt.ElseIfStatement = nil
+		} else if len(stmts) > 1 || stmts[0] != elseIf {
+			ifStmt.ElseIfStatement = nil
+			ifStmt.ElseAssignExpressions = &AssignExpressions{ExpressionList: stmts}
+		}
+	}
+	cond, ok := constantBool(ifStmt.Expression)
+	if !ok {
+		return []*AssignExpression{ae}
+	}
+	o.folded++
+	branch := ifStmt.ElseAssignExpressions
+	if cond {
+		branch = ifStmt.AssignExpressions
+	}
+	if branch == nil {
+		return nil
+	}
+	return branch.ExpressionList
+}
+
+func (o *optimizer) forStatement(forStmt *ForStatement) {
+	// the type of the loop variable is not known, but it hides the fact of the same name.
+	outer, shadowing := o.types.scope[forStmt.LoopVariable]
+	o.types.scope[forStmt.LoopVariable] = nil
+	o.assignExpressions(forStmt.AssignExpressions)
+	if shadowing {
+		o.types.scope[forStmt.LoopVariable] = outer
+	} else {
+		delete(o.types.scope, forStmt.LoopVariable)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/newm4n/grool/context"
 	"github.com/newm4n/grool/pkg"
 	"reflect"
+	"regexp"
+	"strings"
 	"time"
 )
 
@@ -13,11 +15,16 @@ const (
 )
 
 // Predicate holds the left and right Expression Atom graph. And apply comparisson operator from both
-// expression atom result.
+// expression atom result. The "in" and "not in" operator compares the left expression atom against the ExpressionAtomList,
+// while "matches" operator uses the Pattern compiled at build time when the right hand side is a constant.
 type Predicate struct {
 	ExpressionAtomLeft  *ExpressionAtom
 	ExpressionAtomRight *ExpressionAtom
+	ExpressionAtomList  []*ExpressionAtom
 	ComparisonOperator  ComparisonOperator
+	Pattern             *regexp.Regexp
+	Line                int
+	Column              int
 	knowledgeContext    *context.KnowledgeContext
 	ruleCtx             *context.RuleContext
 	dataCtx             *context.DataContext
@@ -35,13 +42,19 @@ func (prdct *Predicate) Initialize(knowledgeContext *context.KnowledgeContext, r
 	if prdct.ExpressionAtomRight != nil {
 		prdct.ExpressionAtomRight.Initialize(knowledgeContext, ruleCtx, dataCtx)
 	}
+	for _, exprAtom := range prdct.ExpressionAtomList {
+		exprAtom.Initialize(knowledgeContext, ruleCtx, dataCtx)
+	}
 }
 
 // AcceptExpressionAtom configure this graph with left and right side of expression atom. The first call
 // to this function will set the left hand side and the second call will set the right.
+// For "in" and "not in" operator, the subsequent calls will add into the expression atom list.
 func (prdct *Predicate) AcceptExpressionAtom(exprAtom *ExpressionAtom) error {
 	if prdct.ExpressionAtomLeft == nil {
 		prdct.ExpressionAtomLeft = exprAtom
+	} else if prdct.ComparisonOperator == ComparisonOperatorIn || prdct.ComparisonOperator == ComparisonOperatorNotIn {
+		prdct.ExpressionAtomList = append(prdct.ExpressionAtomList, exprAtom)
 	} else if prdct.ExpressionAtomRight == nil {
 		prdct.ExpressionAtomRight = exprAtom
 	} else {
@@ -50,8 +63,29 @@ func (prdct *Predicate) AcceptExpressionAtom(exprAtom *ExpressionAtom) error {
 	return nil
 }
 
+// CompilePattern compiles the regular expression of "matches" operator if the right hand side is a string constant,
+// so it will not get compiled on every evaluation.
+func (prdct *Predicate) CompilePattern() error {
+	if prdct.ComparisonOperator != ComparisonOperatorMatches || prdct.ExpressionAtomRight == nil {
+		return nil
+	}
+	cons := prdct.ExpressionAtomRight.Constant
+	if cons == nil || cons.ConstantValue.Kind() != reflect.String {
+		return nil
+	}
+	pattern, err := regexp.Compile(cons.ConstantValue.String())
+	if err != nil {
+		return errors.Errorf("invalid regular expression %s. Got %v", cons.ConstantValue.String(), err)
+	}
+	prdct.Pattern = pattern
+	return nil
+}
+
 // Evaluate the object graph against underlined context or execute evaluation in the sub graph.
 func (prdct *Predicate) Evaluate() (reflect.Value, error) {
+	if prdct.ComparisonOperator == ComparisonOperatorIn || prdct.ComparisonOperator == ComparisonOperatorNotIn {
+		return prdct.evaluateIn()
+	}
 	if prdct.ExpressionAtomRight == nil {
 		return prdct.ExpressionAtomLeft.Evaluate()
 	}
@@ -63,6 +97,67 @@ func (prdct *Predicate) Evaluate() (reflect.Value, error) {
 	if err != nil {
 		return reflect.ValueOf(nil), errors.Trace(err)
 	}
+	return prdct.Apply(lv, rv)
+}
+
+// Apply applies the comparison operator, other than "in" and "not in", on the evaluated left and right value.
+func (prdct *Predicate) Apply(lv, rv reflect.Value) (reflect.Value, error) {
+	switch prdct.ComparisonOperator {
+	case ComparisonOperatorContains:
+		return prdct.evaluateContains(lv, rv)
+	case ComparisonOperatorMatches:
+		return prdct.evaluateMatches(lv, rv)
+	}
+	return prdct.compare(lv, rv)
+}
+
+// compare applies the comparison operator on both values.
+func (prdct *Predicate) compare(lv, rv reflect.Value) (reflect.Value, error) {
+	if pkg.IsNilValue(lv) || pkg.IsNilValue(rv) {
+		return prdct.evaluateNil(lv, rv), nil
+	}
+	if lv.Kind() == reflect.Ptr && lv.Elem().Type().String() == TimeTypeString {
+		lv = lv.Elem()
+	}
+	if rv.Kind() == reflect.Ptr && rv.Elem().Type().String() == TimeTypeString {
+		rv = rv.Elem()
+	}
+	if lv.Type().String() == TimeTypeString && rv.Type().String() == TimeTypeString {
+		tl := pkg.ValueToInterface(lv).(time.Time)
+		tr := pkg.ValueToInterface(rv).(time.Time)
+		switch prdct.ComparisonOperator {
+		case ComparisonOperatorEQ:
+			return reflect.ValueOf(tl.Equal(tr)), nil
+		case ComparisonOperatorNEQ:
+			return reflect.ValueOf(!tl.Equal(tr)), nil
+		case ComparisonOperatorGT:
+			return reflect.ValueOf(tl.After(tr)), nil
+		case ComparisonOperatorGTE:
+			return reflect.ValueOf(tl.After(tr) || tl.Equal(tr)), nil
+		case ComparisonOperatorLT:
+			return reflect.ValueOf(tl.Before(tr)), nil
+		case ComparisonOperatorLTE:
+			return reflect.ValueOf(tl.Before(tr) || tl.Equal(tr)), nil
+		}
+	}
+	if pkg.IsDecimal(lv) || pkg.IsDecimal(rv) {
+		ld, err := pkg.ToDecimal(lv)
+		if err != nil {
+			return reflect.ValueOf(nil), errors.Trace(err)
+		}
+		rd, err := pkg.ToDecimal(rv)
+		if err != nil {
+			return reflect.ValueOf(nil), errors.Trace(err)
+		}
+		return prdct.evaluateCompare(ld.Cmp(rd)), nil
+	}
+	if lv.Kind() == reflect.String && rv.Kind() == reflect.String {
+		cmp, err := pkg.ValueCompareString(lv, rv)
+		if err != nil {
+			return reflect.ValueOf(nil), errors.Trace(err)
+		}
+		return prdct.evaluateCompare(cmp), nil
+	}
 	if lv.Kind() == rv.Kind() && (prdct.ComparisonOperator == ComparisonOperatorEQ || prdct.ComparisonOperator == ComparisonOperatorNEQ) {
 		if prdct.ComparisonOperator == ComparisonOperatorEQ {
 			switch lv.Kind() {
@@ -72,16 +167,9 @@ func (prdct *Predicate) Evaluate() (reflect.Value, error) {
 				return reflect.ValueOf(lv.Uint() == rv.Uint()), nil
 			case reflect.Float64, reflect.Float32:
 				return reflect.ValueOf(lv.Float() == rv.Float()), nil
-			case reflect.String:
-				return reflect.ValueOf(lv.String() == rv.String()), nil
 			case reflect.Bool:
 				return reflect.ValueOf(lv.Bool() == rv.Bool()), nil
 			}
-			if lv.String() == TimeTypeString {
-				tl := pkg.ValueToInterface(lv).(time.Time)
-				tr := pkg.ValueToInterface(rv).(time.Time)
-				return reflect.ValueOf(tl.Equal(tr)), nil
-			}
 		} else {
 			switch lv.Kind() {
 			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
@@ -90,33 +178,9 @@ func (prdct *Predicate) Evaluate() (reflect.Value, error) {
 				return reflect.ValueOf(lv.Uint() != rv.Uint()), nil
 			case reflect.Float64, reflect.Float32:
 				return reflect.ValueOf(lv.Float() != rv.Float()), nil
-			case reflect.String:
-				return reflect.ValueOf(lv.String() != rv.String()), nil
 			case reflect.Bool:
 				return reflect.ValueOf(lv.Bool() != rv.Bool()), nil
 			}
-			if lv.String() == TimeTypeString {
-				tl := pkg.ValueToInterface(lv).(time.Time)
-				tr := pkg.ValueToInterface(rv).(time.Time)
-				return reflect.ValueOf(!tl.Equal(tr)), nil
-			}
-		}
-	} else if lv.Type().String() == TimeTypeString && rv.Type().String() == TimeTypeString {
-		tl := pkg.ValueToInterface(lv).(time.Time)
-		tr := pkg.ValueToInterface(rv).(time.Time)
-		switch prdct.ComparisonOperator {
-		case ComparisonOperatorEQ:
-			return reflect.ValueOf(tl.Equal(tr)), nil
-		case ComparisonOperatorNEQ:
-			return reflect.ValueOf(!tl.Equal(tr)), nil
-		case ComparisonOperatorGT:
-			return reflect.ValueOf(tl.After(tr)), nil
-		case ComparisonOperatorGTE:
-			return reflect.ValueOf(tl.After(tr) || tl.Equal(tr)), nil
-		case ComparisonOperatorLT:
-			return reflect.ValueOf(tl.Before(tr)), nil
-		case ComparisonOperatorLTE:
-			return reflect.ValueOf(tl.Before(tr) || tl.Equal(tr)), nil
 		}
 	} else {
 		var lf, rf float64
@@ -157,3 +221,144 @@ func (prdct *Predicate) Evaluate() (reflect.Value, error) {
 	}
 	return reflect.ValueOf(nil), nil
 }
+
+// evaluateCompare applies the comparison operator on the result of comparing the left against the right value,
+// which is 0 if they are equal, negative if the left is less and positive if the left is greater.
+func (prdct *Predicate) evaluateCompare(cmp int) reflect.Value {
+	switch prdct.ComparisonOperator {
+	case ComparisonOperatorEQ:
+		return reflect.ValueOf(cmp == 0)
+	case ComparisonOperatorNEQ:
+		return reflect.ValueOf(cmp != 0)
+	case ComparisonOperatorGT:
+		return reflect.ValueOf(cmp > 0)
+	case ComparisonOperatorGTE:
+		return reflect.ValueOf(cmp >= 0)
+	case ComparisonOperatorLT:
+		return reflect.ValueOf(cmp < 0)
+	default:
+		return reflect.ValueOf(cmp <= 0)
+	}
+}
+
+// evaluateNil compares values where at least one of them is nil. Two nils are equal, nil is never equal to
+// a non nil value, and ordering comparison involving nil is always false.
+func (prdct *Predicate) evaluateNil(lv, rv reflect.Value) reflect.Value {
+	bothNil := pkg.IsNilValue(lv) && pkg.IsNilValue(rv)
+	switch prdct.ComparisonOperator {
+	case ComparisonOperatorEQ:
+		return reflect.ValueOf(bothNil)
+	case ComparisonOperatorNEQ:
+		return reflect.ValueOf(!bothNil)
+	default:
+		return reflect.ValueOf(false)
+	}
+}
+
+// evaluateIn checks whether the left value equals to any of the value in the expression atom list.
+func (prdct *Predicate) evaluateIn() (reflect.Value, error) {
+	lv, err := prdct.ExpressionAtomLeft.Evaluate()
+	if err != nil {
+		return reflect.ValueOf(nil), errors.Trace(err)
+	}
+	found := false
+	for _, exprAtom := range prdct.ExpressionAtomList {
+		rv, err := exprAtom.Evaluate()
+		if err != nil {
+			return reflect.ValueOf(nil), errors.Trace(err)
+		}
+		eq, err := ValueEquals(lv, rv)
+		if err != nil {
+			return reflect.ValueOf(nil), errors.Trace(err)
+		}
+		if eq {
+			found = true
+			break
+		}
+	}
+	if prdct.ComparisonOperator == ComparisonOperatorNotIn {
+		return reflect.ValueOf(!found), nil
+	}
+	return reflect.ValueOf(found), nil
+}
+
+// evaluateContains checks whether a string contains a substring, a slice or array contains an element or a map contains a key.
+func (prdct *Predicate) evaluateContains(lv, rv reflect.Value) (reflect.Value, error) {
+	if pkg.IsNilValue(lv) {
+		return reflect.ValueOf(false), nil
+	}
+	for lv.Kind() == reflect.Ptr || lv.Kind() == reflect.Interface {
+		lv = lv.Elem()
+	}
+	switch lv.Kind() {
+	case reflect.String:
+		if rv.Kind() != reflect.String {
+			return reflect.ValueOf(nil), errors.Errorf("string can only contain a string, not %s", rv.Kind().String())
+		}
+		return reflect.ValueOf(strings.Contains(lv.String(), rv.String())), nil
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < lv.Len(); i++ {
+			eq, err := ValueEquals(lv.Index(i), rv)
+			if err != nil {
+				return reflect.ValueOf(nil), errors.Trace(err)
+			}
+			if eq {
+				return reflect.ValueOf(true), nil
+			}
+		}
+		return reflect.ValueOf(false), nil
+	case reflect.Map:
+		if pkg.IsNilValue(rv) {
+			return reflect.ValueOf(false), nil
+		}
+		if !rv.Type().ConvertibleTo(lv.Type().Key()) {
+			return reflect.ValueOf(nil), errors.Errorf("map key is %s, can not look up %s", lv.Type().Key().String(), rv.Type().String())
+		}
+		return reflect.ValueOf(lv.MapIndex(rv.Convert(lv.Type().Key())).IsValid()), nil
+	default:
+		return reflect.ValueOf(nil), errors.Errorf("contains operator can only be applied to string, slice, array or map, not %s", lv.Kind().String())
+	}
+}
+
+// evaluateMatches checks whether a string matches the regular expression.
+func (prdct *Predicate) evaluateMatches(lv, rv reflect.Value) (reflect.Value, error) {
+	if lv.Kind() != reflect.String {
+		return reflect.ValueOf(nil), errors.Errorf("matches operator can only be applied to string")
+	}
+	pattern := prdct.Pattern
+	if pattern == nil {
+		if rv.Kind() != reflect.String {
+			return reflect.ValueOf(nil), errors.Errorf("regular expression of matches operator must be a string")
+		}
+		p, err := regexp.Compile(rv.String())
+		if err != nil {
+			return reflect.ValueOf(nil), errors.Errorf("invalid regular expression %s. Got %v", rv.String(), err)
+		}
+		pattern = p
+	}
+	return reflect.ValueOf(pattern.MatchString(lv.String())), nil
+}
+
+// ValueEquals checks the equality of two values the same way "==" operator does.
+func ValueEquals(lv, rv reflect.Value) (bool, error) {
+	if lv.Kind() == reflect.Interface && !lv.IsNil() {
+		lv = lv.Elem()
+	}
+	if rv.Kind() == reflect.Interface && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	eq := &Predicate{ComparisonOperator: ComparisonOperatorEQ}
+	if pkg.IsNilValue(lv) || pkg.IsNilValue(rv) {
+		return eq.evaluateNil(lv, rv).Bool(), nil
+	}
+	if lv.Kind() == reflect.String || rv.Kind() == reflect.String || lv.Kind() == reflect.Bool || rv.Kind() == reflect.Bool {
+		if lv.Kind() != rv.Kind() {
+			return false, nil
+		}
+	}
+	res, err := eq.compare(lv, rv)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return res.Kind() == reflect.Bool && res.Bool(), nil
+}
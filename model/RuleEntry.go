@@ -6,12 +6,16 @@ import (
 )
 
 // RuleEntry represent the language graph of a single rule entry.
+// Line and Column locates the rule in the rule script, and EndLine is the line of its closing brace.
 type RuleEntry struct {
 	Salience         int64
 	RuleName         string
 	RuleDescription  string
 	WhenScope        *WhenScope
 	ThenScope        *ThenScope
+	Line             int
+	Column           int
+	EndLine          int
 	knowledgeContext *context.KnowledgeContext
 	ruleCtx          *context.RuleContext
 	dataCtx          *context.DataContext
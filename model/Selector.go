@@ -0,0 +1,61 @@
+package model
+
+import (
+	"github.com/juju/errors"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/pkg"
+	"reflect"
+)
+
+// Selector holds a member access or a method call applied to the result of another expression atom,
+// such as ".City" or ".Add(1h)". The member name may be a dotted path. A null-safe selector evaluates to nil
+// instead of failing when the receiver is nil.
+type Selector struct {
+	Name             string
+	NullSafe         bool
+	MethodCall       bool
+	MethodArguments  *FunctionArgument
+	Line             int
+	Column           int
+	knowledgeContext *context.KnowledgeContext
+	ruleCtx          *context.RuleContext
+	dataCtx          *context.DataContext
+}
+
+// Initialize will initialize this graph with context.
+func (sel *Selector) Initialize(knowledgeContext *context.KnowledgeContext, ruleCtx *context.RuleContext, dataCtx *context.DataContext) {
+	sel.knowledgeContext = knowledgeContext
+	sel.ruleCtx = ruleCtx
+	sel.dataCtx = dataCtx
+
+	if sel.MethodArguments != nil {
+		sel.MethodArguments.Initialize(knowledgeContext, ruleCtx, dataCtx)
+	}
+}
+
+// AcceptFunctionArgument will prepare this graph with the method arguments.
+func (sel *Selector) AcceptFunctionArgument(funcArg *FunctionArgument) error {
+	sel.MethodArguments = funcArg
+	return nil
+}
+
+// EvaluateOn applies the member access or method call on the receiver value.
+func (sel *Selector) EvaluateOn(receiver reflect.Value) (reflect.Value, error) {
+	if pkg.IsNilValue(receiver) && sel.NullSafe {
+		return reflect.ValueOf(nil), nil
+	}
+	if !sel.MethodCall {
+		return sel.dataCtx.GetValueFrom(receiver, sel.Name)
+	}
+	var argumentValues []reflect.Value
+	if sel.MethodArguments == nil {
+		argumentValues = make([]reflect.Value, 0)
+	} else {
+		av, err := sel.MethodArguments.EvaluateArguments()
+		if err != nil {
+			return reflect.ValueOf(nil), errors.Trace(err)
+		}
+		argumentValues = av
+	}
+	return sel.dataCtx.ExecMethodOn(receiver, sel.Name, argumentValues)
+}
@@ -0,0 +1,6 @@
+package model
+
+// SelectorHolder define all graph that should store a selector applied on another expression atom.
+type SelectorHolder interface {
+	AcceptSelector(sel *Selector) error
+}
@@ -23,6 +23,15 @@ func (then *ThenScope) Initialize(knowledgeContext *context.KnowledgeContext, ru
 	}
 }
 
+// AcceptAssignExpressions will set the list of assignment expressions of this scope.
+func (then *ThenScope) AcceptAssignExpressions(assigns *AssignExpressions) error {
+	if then.AssignExpressions != nil {
+		return errors.Errorf("assign expressions were set twice in then scope")
+	}
+	then.AssignExpressions = assigns
+	return nil
+}
+
 // Execute this graph against underlying facts.
 func (then *ThenScope) Execute() error {
 	_, err := then.AssignExpressions.Evaluate()
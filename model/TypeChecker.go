@@ -0,0 +1,522 @@
+package model
+
+import (
+	"fmt"
+	"github.com/newm4n/grool/pkg"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+var (
+	boolType      = reflect.TypeOf(true)
+	decimalType   = reflect.TypeOf(&big.Rat{})
+	functionsType = reflect.TypeOf(&GroolFunctions{})
+)
+
+// TypeError is an error found while type checking a rule. Line and Column locates it in the rule script.
+type TypeError struct {
+	RuleName string
+	Line     int
+	Column   int
+	Message  string
+}
+
+// Error returns the error message along with the rule name and position.
+func (e *TypeError) Error() string {
+	return fmt.Sprintf("rule %s line %d column %d : %s", e.RuleName, e.Line, e.Column, e.Message)
+}
+
+// TypeErrors holds all type errors found while type checking the rules.
+type TypeErrors []*TypeError
+
+// Error returns all the error messages, one per line.
+func (errs TypeErrors) Error() string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d type errors found.\n%s", len(errs), strings.Join(msgs, "\n"))
+}
+
+// CheckTypes resolves every variable, member, function and method call and operator of the rule entries
+// against the declared facts, and returns all type errors found, sorted by their position.
+// An expression which type is only known at runtime, such as an interface{} member, is not checked.
+func (k *KnowledgeBase) CheckTypes(entries ...*RuleEntry) TypeErrors {
+	errs := make(TypeErrors, 0)
+	for _, entry := range entries {
+		tc := &typeChecker{
+			knowledgeBase: k,
+			ruleName:      entry.RuleName,
+			scope:         make(map[string]reflect.Type),
+			readOnly:      make(map[string]bool),
+		}
+		if entry.WhenScope != nil && entry.WhenScope.Expression != nil {
+			tc.checkCondition(entry.WhenScope.Expression, "when scope")
+		}
+		if entry.ThenScope != nil {
+			tc.checkAssignExpressions(entry.ThenScope.AssignExpressions)
+		}
+		errs = append(errs, tc.errors...)
+	}
+	sort.SliceStable(errs, func(i, j int) bool {
+		if errs[i].Line != errs[j].Line {
+			return errs[i].Line < errs[j].Line
+		}
+		return errs[i].Column < errs[j].Column
+	})
+	return errs
+}
+
+// ExpressionAtomType returns the type of the expression atom resolved against the declared facts, or nil if it is
+// only known at runtime. The loop variables of the enclosing for statements are of unknown type.
+func (k *KnowledgeBase) ExpressionAtomType(exprAtm *ExpressionAtom, loopVariables ...string) reflect.Type {
+	tc := &typeChecker{
+		knowledgeBase: k,
+		scope:         make(map[string]reflect.Type, len(loopVariables)),
+		readOnly:      make(map[string]bool),
+	}
+	for _, name := range loopVariables {
+		tc.scope[name] = nil
+	}
+	return tc.checkExpressionAtom(exprAtm)
+}
+
+// typeChecker walks a rule entry graph computing the type of each expression. A nil type means unknown.
+type typeChecker struct {
+	knowledgeBase *KnowledgeBase
+	ruleName      string
+	scope         map[string]reflect.Type
+	readOnly      map[string]bool
+	errors        TypeErrors
+}
+
+func (tc *typeChecker) errorf(line, column int, format string, args ...interface{}) {
+	tc.errors = append(tc.errors, &TypeError{
+		RuleName: tc.ruleName,
+		Line:     line,
+		Column:   column,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (tc *typeChecker) checkCondition(expr *Expression, what string) {
+	typ := tc.checkExpression(expr)
+	if typ != nil && typ.Kind() != reflect.Bool {
+		tc.errorf(expr.Line, expr.Column, "%s must be a boolean expression, not %s", what, typ)
+	}
+}
+
+func (tc *typeChecker) checkAssignExpressions(assigns *AssignExpressions) {
+	if assigns == nil {
+		return
+	}
+	for _, ae := range assigns.ExpressionList {
+		switch {
+		case ae.Assignment != nil:
+			tc.checkAssignment(ae.Assignment)
+		case ae.FunctionCall != nil:
+			tc.checkFunctionCall(ae.FunctionCall)
+		case ae.MethodCall != nil:
+			tc.checkMethodCall(ae.MethodCall)
+		case ae.IfStatement != nil:
+			tc.checkIfStatement(ae.IfStatement)
+		case ae.ForStatement != nil:
+			tc.checkForStatement(ae.ForStatement)
+		case ae.Selector != nil:
+			tc.checkSelector(tc.checkExpressionAtom(ae.ExpressionAtom), ae.Selector)
+		}
+	}
+}
+
+func (tc *typeChecker) checkIfStatement(ifStmt *IfStatement) {
+	tc.checkCondition(ifStmt.Expression, "if statement condition")
+	tc.checkAssignExpressions(ifStmt.AssignExpressions)
+	if ifStmt.ElseIfStatement != nil {
+		tc.checkIfStatement(ifStmt.ElseIfStatement)
+	}
+	tc.checkAssignExpressions(ifStmt.ElseAssignExpressions)
+}
+
+func (tc *typeChecker) checkForStatement(forStmt *ForStatement) {
+	var itemType reflect.Type
+	if collType := tc.resolveVariable(forStmt.Variable, forStmt.Line, forStmt.Column); collType != nil {
+		for collType.Kind() == reflect.Ptr {
+			collType = collType.Elem()
+		}
+		switch collType.Kind() {
+		case reflect.Slice, reflect.Array:
+			itemType = knownType(collType.Elem())
+			if itemType != nil && itemType.Kind() == reflect.Struct {
+				itemType = reflect.PtrTo(itemType)
+			}
+		case reflect.Map:
+			itemType = knownType(collType.Elem())
+		default:
+			tc.errorf(forStmt.Line, forStmt.Column, "can not iterate over %s, its a %s", forStmt.Variable, collType)
+		}
+	}
+	outer, shadowing := tc.scope[forStmt.LoopVariable]
+	outerReadOnly := tc.readOnly[forStmt.LoopVariable]
+	tc.scope[forStmt.LoopVariable] = itemType
+	tc.readOnly[forStmt.LoopVariable] = tc.isReadOnlyRoot(forStmt.Variable)
+	tc.checkAssignExpressions(forStmt.AssignExpressions)
+	if shadowing {
+		tc.scope[forStmt.LoopVariable] = outer
+	} else {
+		delete(tc.scope, forStmt.LoopVariable)
+	}
+	tc.readOnly[forStmt.LoopVariable] = outerReadOnly
+}
+
+func (tc *typeChecker) checkAssignment(assign *Assignment) {
+	valueType := tc.checkExpression(assign.Expression)
+	path := strings.Split(assign.Variable, ".")
+	if len(path) < 2 {
+		tc.errorf(assign.Line, assign.Column, "can not assign %s, only a member of a fact can be assigned", assign.Variable)
+		return
+	}
+	parentType := tc.resolveVariable(strings.Join(path[:len(path)-1], "."), assign.Line, assign.Column)
+	if parentType == nil {
+		return
+	}
+	if tc.isReadOnlyRoot(assign.Variable) {
+		tc.errorf(assign.Line, assign.Column, "can not assign %s, fact %s is read only", assign.Variable, strings.TrimSuffix(path[0], "?"))
+		return
+	}
+	fieldName := path[len(path)-1]
+	fieldType := tc.fieldType(parentType, fieldName, assign.Line, assign.Column)
+	if structType := indirectType(parentType); structType.Kind() == reflect.Struct {
+		if field, ok := tc.naming().FieldByName(structType, fieldName); ok && pkg.IsReadOnlyField(field) {
+			tc.errorf(assign.Line, assign.Column, "can not assign %s, its read only", assign.Variable)
+			return
+		}
+	}
+	value := sampleValue(valueType)
+	if fieldType == nil || !value.IsValid() {
+		return
+	}
+	// assign into a sample struct or map, so the rule follows exactly the same assignment rules as the execution.
+	for parentType.Kind() == reflect.Ptr {
+		parentType = parentType.Elem()
+	}
+	sample := reflect.New(parentType)
+	if parentType.Kind() == reflect.Map {
+		sample.Elem().Set(reflect.MakeMap(parentType))
+	}
+	if err := tc.naming().SetAttributeValue(sample.Interface(), fieldName, value); err != nil {
+		tc.errorf(assign.Line, assign.Column, "can not assign %s to %s, its a %s", valueType, assign.Variable, fieldType)
+	}
+}
+
+func (tc *typeChecker) checkExpression(expr *Expression) reflect.Type {
+	if expr == nil {
+		return nil
+	}
+	if expr.Predicate != nil {
+		return tc.checkPredicate(expr.Predicate)
+	}
+	if expr.Conditional {
+		tc.checkCondition(expr.ConditionExpression, "condition of conditional expression")
+		lt := tc.checkExpression(expr.LeftExpression)
+		rt := tc.checkExpression(expr.RightExpression)
+		if lt == rt {
+			return lt
+		}
+		return nil
+	}
+	for _, operand := range []*Expression{expr.LeftExpression, expr.RightExpression} {
+		typ := tc.checkExpression(operand)
+		if typ != nil && typ.Kind() != reflect.Bool {
+			tc.errorf(operand.Line, operand.Column, "logical operator can only be applied to boolean expression, not %s", typ)
+		}
+	}
+	return boolType
+}
+
+func (tc *typeChecker) checkPredicate(prdct *Predicate) reflect.Type {
+	lt := tc.checkExpressionAtom(prdct.ExpressionAtomLeft)
+	if prdct.ComparisonOperator == ComparisonOperatorIn || prdct.ComparisonOperator == ComparisonOperatorNotIn {
+		for _, exprAtom := range prdct.ExpressionAtomList {
+			rt := tc.checkExpressionAtom(exprAtom)
+			lv, rv := sampleValue(lt), sampleValue(rt)
+			if !lv.IsValid() || !rv.IsValid() {
+				continue
+			}
+			if _, err := ValueEquals(lv, rv); err != nil {
+				tc.errorf(exprAtom.Line, exprAtom.Column, "can not look up %s in a list of %s", lt, rt)
+			}
+		}
+		return boolType
+	}
+	if prdct.ExpressionAtomRight == nil {
+		return lt
+	}
+	rt := tc.checkExpressionAtom(prdct.ExpressionAtomRight)
+	lv, rv := sampleValue(lt), sampleValue(rt)
+	if !lv.IsValid() || !rv.IsValid() {
+		return boolType
+	}
+	// compare sample values, so the rule follows exactly the same comparison rules as the execution.
+	sample := &Predicate{ComparisonOperator: prdct.ComparisonOperator, Pattern: prdct.Pattern}
+	var result reflect.Value
+	var err error
+	switch prdct.ComparisonOperator {
+	case ComparisonOperatorContains:
+		result, err = sample.evaluateContains(lv, rv)
+	case ComparisonOperatorMatches:
+		result, err = sample.evaluateMatches(lv, rv)
+	default:
+		result, err = sample.compare(lv, rv)
+	}
+	if err != nil || !result.IsValid() {
+		tc.errorf(prdct.Line, prdct.Column, "can not compare %s with %s using %s", lt, rt, prdct.ComparisonOperator)
+	}
+	return boolType
+}
+
+func (tc *typeChecker) checkExpressionAtom(exprAtm *ExpressionAtom) reflect.Type {
+	switch {
+	case exprAtm == nil:
+		return nil
+	case len(exprAtm.Variable) > 0:
+		return tc.resolveVariable(exprAtm.Variable, exprAtm.Line, exprAtm.Column)
+	case exprAtm.Constant != nil:
+		return constantType(exprAtm.Constant)
+	case exprAtm.FunctionCall != nil:
+		return tc.checkFunctionCall(exprAtm.FunctionCall)
+	case exprAtm.MethodCall != nil:
+		return tc.checkMethodCall(exprAtm.MethodCall)
+	case exprAtm.Selector != nil:
+		return tc.checkSelector(tc.checkExpressionAtom(exprAtm.ExpressionAtomLeft), exprAtm.Selector)
+	case exprAtm.NullCoalescing:
+		lt := tc.checkExpressionAtom(exprAtm.ExpressionAtomLeft)
+		rt := tc.checkExpressionAtom(exprAtm.ExpressionAtomRight)
+		if lt == rt {
+			return lt
+		}
+		return nil
+	default:
+		lt := tc.checkExpressionAtom(exprAtm.ExpressionAtomLeft)
+		rt := tc.checkExpressionAtom(exprAtm.ExpressionAtomRight)
+		lv, rv := sampleValue(lt), sampleValue(rt)
+		if !lv.IsValid() || !rv.IsValid() {
+			return nil
+		}
+		// apply the operator on sample values, so the rule follows exactly the same math rules as the execution.
+		result, err := exprAtm.MathOperator.Apply(lv, rv)
+		if err != nil {
+			tc.errorf(exprAtm.Line, exprAtm.Column, "can not apply %s between %s and %s", exprAtm.MathOperator, lt, rt)
+			return nil
+		}
+		if !result.IsValid() {
+			return nil
+		}
+		return knownType(result.Type())
+	}
+}
+
+func (tc *typeChecker) checkFunctionCall(funcCall *FunctionCall) reflect.Type {
+	args := tc.checkArguments(funcCall.FunctionArguments, funcCall.Line, funcCall.Column)
+	return tc.resolveMethod(functionsType, funcCall.FunctionName, args, funcCall.Line, funcCall.Column)
+}
+
+func (tc *typeChecker) checkMethodCall(methCall *MethodCall) reflect.Type {
+	args := tc.checkArguments(methCall.MethodArguments, methCall.Line, methCall.Column)
+	idx := strings.LastIndex(methCall.MethodName, ".")
+	receiver := tc.resolveVariable(methCall.MethodName[:idx], methCall.Line, methCall.Column)
+	if receiver == nil {
+		return nil
+	}
+	return tc.resolveMethod(receiver, methCall.MethodName[idx+1:], args, methCall.Line, methCall.Column)
+}
+
+func (tc *typeChecker) checkSelector(receiver reflect.Type, sel *Selector) reflect.Type {
+	var args []reflect.Type
+	if sel.MethodCall {
+		args = tc.checkArguments(sel.MethodArguments, sel.Line, sel.Column)
+	}
+	path := strings.Split(sel.Name, ".")
+	fields := path
+	if sel.MethodCall {
+		fields = path[:len(path)-1]
+	}
+	typ := receiver
+	for _, field := range fields {
+		if typ == nil {
+			return nil
+		}
+		typ = tc.fieldType(typ, strings.TrimSuffix(field, "?"), sel.Line, sel.Column)
+	}
+	if typ == nil || !sel.MethodCall {
+		return typ
+	}
+	return tc.resolveMethod(typ, path[len(path)-1], args, sel.Line, sel.Column)
+}
+
+func (tc *typeChecker) checkArguments(funcArg *FunctionArgument, line, column int) []reflect.Type {
+	if funcArg == nil {
+		return nil
+	}
+	types := make([]reflect.Type, len(funcArg.Arguments))
+	for i, arg := range funcArg.Arguments {
+		switch {
+		case len(arg.Variable) > 0:
+			types[i] = tc.resolveVariable(arg.Variable, line, column)
+		case arg.Constant != nil:
+			types[i] = constantType(arg.Constant)
+		case arg.FunctionCall != nil:
+			types[i] = tc.checkFunctionCall(arg.FunctionCall)
+		case arg.MethodCall != nil:
+			types[i] = tc.checkMethodCall(arg.MethodCall)
+		case arg.Expression != nil:
+			types[i] = tc.checkExpression(arg.Expression)
+		}
+	}
+	return types
+}
+
+// isReadOnlyRoot checks if the variable path starts from a read-only fact, or a loop variable over one.
+func (tc *typeChecker) isReadOnlyRoot(variable string) bool {
+	root := strings.TrimSuffix(strings.Split(variable, ".")[0], "?")
+	if _, ok := tc.scope[root]; ok {
+		return tc.readOnly[root]
+	}
+	return tc.knowledgeBase.ReadOnlyFacts[root]
+}
+
+func indirectType(typ reflect.Type) reflect.Type {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ
+}
+
+// resolveVariable resolves the type of a variable path, starting from a loop variable or a declared fact.
+func (tc *typeChecker) resolveVariable(variable string, line, column int) reflect.Type {
+	path := strings.Split(variable, ".")
+	root := strings.TrimSuffix(path[0], "?")
+	typ, ok := tc.scope[root]
+	if !ok {
+		typ, ok = tc.knowledgeBase.Facts[root]
+	}
+	if !ok {
+		tc.errorf(line, column, "fact %s is not declared", root)
+		return nil
+	}
+	for _, field := range path[1:] {
+		if typ == nil {
+			return nil
+		}
+		typ = tc.fieldType(typ, strings.TrimSuffix(field, "?"), line, column)
+	}
+	return typ
+}
+
+// naming tells how the rules name the fields of the declared facts.
+func (tc *typeChecker) naming() pkg.FieldNaming {
+	return pkg.FieldNaming{JSONTags: tc.knowledgeBase.JSONTags}
+}
+
+func (tc *typeChecker) fieldType(typ reflect.Type, name string, line, column int) reflect.Type {
+	structType := typ
+	for structType.Kind() == reflect.Ptr {
+		structType = structType.Elem()
+	}
+	// any member of a map fact is its entry, which may or may not be there until the execution.
+	if structType.Kind() == reflect.Map && structType.Key().Kind() == reflect.String {
+		return knownType(structType.Elem())
+	}
+	if structType.Kind() != reflect.Struct {
+		tc.errorf(line, column, "%s is not a struct, it has no member %s", typ, name)
+		return nil
+	}
+	field, ok := tc.naming().FieldByName(structType, name)
+	if !ok {
+		tc.errorf(line, column, "%s has no member %s", structType, name)
+		return nil
+	}
+	return knownType(field.Type)
+}
+
+// resolveMethod checks the method and its argument types, and returns the method first return type.
+func (tc *typeChecker) resolveMethod(receiver reflect.Type, name string, args []reflect.Type, line, column int) reflect.Type {
+	methodSet := receiver
+	if methodSet.Kind() == reflect.Struct {
+		methodSet = reflect.PtrTo(methodSet)
+	}
+	method, ok := pkg.MethodByName(methodSet, name)
+	if !ok {
+		tc.errorf(line, column, "%s has no method %s()", receiver, name)
+		return nil
+	}
+	var ret reflect.Type
+	if method.Type.NumOut() > 0 {
+		ret = knownType(method.Type.Out(0))
+	}
+	if method.Type.NumIn()-1 != len(args) {
+		tc.errorf(line, column, "method %s() need %d argument while there are %d", name, method.Type.NumIn()-1, len(args))
+		return ret
+	}
+	for i, arg := range args {
+		param := method.Type.In(i + 1)
+		value := sampleValue(arg)
+		if !value.IsValid() || param.Kind() == reflect.Interface {
+			continue
+		}
+		if pkg.IsConvertibleKind(param, value) {
+			if _, err := tc.naming().ConvertValue(value, param); err == nil {
+				continue
+			}
+		} else if param.Kind() == value.Kind() {
+			continue
+		}
+		tc.errorf(line, column, "argument #%d of method %s() require %s but %s", i, name, param, arg)
+	}
+	return ret
+}
+
+func constantType(cons *Constant) reflect.Type {
+	val, err := cons.Evaluate()
+	if err != nil || !val.IsValid() {
+		return nil
+	}
+	return knownType(val.Type())
+}
+
+// knownType returns nil for an interface type, as the actual type is only known at runtime.
+func knownType(typ reflect.Type) reflect.Type {
+	if typ == nil || typ.Kind() == reflect.Interface {
+		return nil
+	}
+	return typ
+}
+
+// sampleValue creates a non nil, non zero value of the type, used to apply an operator at build time.
+func sampleValue(typ reflect.Type) reflect.Value {
+	if typ == nil {
+		return reflect.Value{}
+	}
+	if typ == decimalType {
+		return reflect.ValueOf(big.NewRat(1, 1))
+	}
+	val := reflect.New(typ).Elem()
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		val.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		val.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		val.SetFloat(1)
+	case reflect.String:
+		val.SetString("a")
+	case reflect.Ptr:
+		return reflect.New(typ.Elem())
+	case reflect.Slice:
+		return reflect.MakeSlice(typ, 0, 0)
+	case reflect.Map:
+		return reflect.MakeMap(typ)
+	}
+	return val
+}
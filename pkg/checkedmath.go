@@ -0,0 +1,266 @@
+package pkg
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+var (
+	OperatorAdd = "+"
+	OperatorSub = "-"
+	OperatorMul = "*"
+	OperatorDiv = "/"
+
+	OperatorBitAnd     = "&"
+	OperatorBitOr      = "|"
+	OperatorBitXor     = "^"
+	OperatorShiftLeft  = "<<"
+	OperatorShiftRight = ">>"
+
+	ReasonOverflow       = "integer overflow"
+	ReasonDivisionByZero = "division by zero"
+	ReasonNaN            = "result is not a number"
+	ReasonInfinite       = "result is infinite"
+	ReasonSignedness     = "implicit mixing of signed and unsigned integer"
+	ReasonNegativeShift  = "negative shift count"
+	ReasonShiftCount     = "shift count too large"
+	ReasonRepeatCount    = "invalid string repeat count"
+)
+
+// ArithmeticError is returned when a math operation between two numbers can not produce a correct result.
+type ArithmeticError struct {
+	Operator string
+	Left     interface{}
+	Right    interface{}
+	Reason   string
+}
+
+func (e *ArithmeticError) Error() string {
+	return fmt.Sprintf("arithmetic error on %v %s %v, %s", e.Left, e.Operator, e.Right, e.Reason)
+}
+
+func newArithmeticError(operator string, a, b reflect.Value, reason string) *ArithmeticError {
+	return &ArithmeticError{
+		Operator: operator,
+		Left:     ValueToInterface(a),
+		Right:    ValueToInterface(b),
+		Reason:   reason,
+	}
+}
+
+func isNumberKind(kind reflect.Kind) bool {
+	return kind == reflect.Int64 || kind == reflect.Uint64 || kind == reflect.Float64
+}
+
+// CheckSignedness returns an *ArithmeticError if one of the value is a signed integer while the other is unsigned.
+// Used by the strict math mode to refuse implicit conversion between them.
+func CheckSignedness(operator string, a, b reflect.Value) error {
+	aBkind := GetBaseKind(a)
+	bBkind := GetBaseKind(b)
+	if (aBkind == reflect.Int64 && bBkind == reflect.Uint64) || (aBkind == reflect.Uint64 && bBkind == reflect.Int64) {
+		return newArithmeticError(operator, a, b, ReasonSignedness)
+	}
+	return nil
+}
+
+// checkedMath applies a math operation on two numbers. Both unsigned yields an unsigned, any float yields a float,
+// otherwise it yields a signed integer. It returns false if any of the value is not a number.
+func checkedMath(operator string, a, b reflect.Value) (reflect.Value, bool, error) {
+	aBkind := GetBaseKind(a)
+	bBkind := GetBaseKind(b)
+	if !isNumberKind(aBkind) || !isNumberKind(bBkind) {
+		return reflect.ValueOf(nil), false, nil
+	}
+	switch {
+	case aBkind == reflect.Float64 || bBkind == reflect.Float64:
+		res, reason := floatMath(operator, toFloat64(a), toFloat64(b))
+		if reason != "" {
+			return reflect.ValueOf(nil), true, newArithmeticError(operator, a, b, reason)
+		}
+		return reflect.ValueOf(res), true, nil
+	case aBkind == reflect.Uint64 && bBkind == reflect.Uint64:
+		res, reason := uintMath(operator, a.Uint(), b.Uint())
+		if reason != "" {
+			return reflect.ValueOf(nil), true, newArithmeticError(operator, a, b, reason)
+		}
+		return reflect.ValueOf(res), true, nil
+	default:
+		x, xok := toInt64(a)
+		y, yok := toInt64(b)
+		if !xok || !yok {
+			return reflect.ValueOf(nil), true, newArithmeticError(operator, a, b, ReasonOverflow)
+		}
+		res, reason := intMath(operator, x, y)
+		if reason != "" {
+			return reflect.ValueOf(nil), true, newArithmeticError(operator, a, b, reason)
+		}
+		return reflect.ValueOf(res), true, nil
+	}
+}
+
+func toFloat64(val reflect.Value) float64 {
+	switch GetBaseKind(val) {
+	case reflect.Int64:
+		return float64(val.Int())
+	case reflect.Uint64:
+		return float64(val.Uint())
+	default:
+		return val.Float()
+	}
+}
+
+// toInt64 converts signed or unsigned integer into int64, it returns false if the unsigned value is too big.
+func toInt64(val reflect.Value) (int64, bool) {
+	if GetBaseKind(val) == reflect.Uint64 {
+		if val.Uint() > math.MaxInt64 {
+			return 0, false
+		}
+		return int64(val.Uint()), true
+	}
+	return val.Int(), true
+}
+
+func floatMath(operator string, x, y float64) (float64, string) {
+	var res float64
+	switch operator {
+	case OperatorAdd:
+		res = x + y
+	case OperatorSub:
+		res = x - y
+	case OperatorMul:
+		res = x * y
+	case OperatorDiv:
+		if y == 0 {
+			return 0, ReasonDivisionByZero
+		}
+		res = x / y
+	}
+	if math.IsNaN(res) {
+		return 0, ReasonNaN
+	}
+	if math.IsInf(res, 0) {
+		return 0, ReasonInfinite
+	}
+	return res, ""
+}
+
+func intMath(operator string, x, y int64) (int64, string) {
+	switch operator {
+	case OperatorAdd:
+		res := x + y
+		if (y > 0 && res < x) || (y < 0 && res > x) {
+			return 0, ReasonOverflow
+		}
+		return res, ""
+	case OperatorSub:
+		res := x - y
+		if (y > 0 && res > x) || (y < 0 && res < x) {
+			return 0, ReasonOverflow
+		}
+		return res, ""
+	case OperatorMul:
+		if x == 0 || y == 0 {
+			return 0, ""
+		}
+		res := x * y
+		if res/y != x || (x == -1 && y == math.MinInt64) || (y == -1 && x == math.MinInt64) {
+			return 0, ReasonOverflow
+		}
+		return res, ""
+	default:
+		if y == 0 {
+			return 0, ReasonDivisionByZero
+		}
+		if x == math.MinInt64 && y == -1 {
+			return 0, ReasonOverflow
+		}
+		return x / y, ""
+	}
+}
+
+func uintMath(operator string, x, y uint64) (uint64, string) {
+	switch operator {
+	case OperatorAdd:
+		res := x + y
+		if res < x {
+			return 0, ReasonOverflow
+		}
+		return res, ""
+	case OperatorSub:
+		if y > x {
+			return 0, ReasonOverflow
+		}
+		return x - y, ""
+	case OperatorMul:
+		if x == 0 || y == 0 {
+			return 0, ""
+		}
+		res := x * y
+		if res/y != x {
+			return 0, ReasonOverflow
+		}
+		return res, ""
+	default:
+		if y == 0 {
+			return 0, ReasonDivisionByZero
+		}
+		return x / y, ""
+	}
+}
+
+// CheckedInt applies a math operator on two signed integers like the rules do, for code generated from the rules.
+// An overflow or a division by zero returns an *ArithmeticError.
+func CheckedInt(operator string, x, y int64) (int64, error) {
+	res, reason := intMath(operator, x, y)
+	if reason != "" {
+		return 0, &ArithmeticError{Operator: operator, Left: x, Right: y, Reason: reason}
+	}
+	return res, nil
+}
+
+// CheckedUint applies a math operator on two unsigned integers like the rules do, for code generated from the rules.
+// An overflow or a division by zero returns an *ArithmeticError.
+func CheckedUint(operator string, x, y uint64) (uint64, error) {
+	res, reason := uintMath(operator, x, y)
+	if reason != "" {
+		return 0, &ArithmeticError{Operator: operator, Left: x, Right: y, Reason: reason}
+	}
+	return res, nil
+}
+
+// CheckedFloat applies a math operator on two floats like the rules do, for code generated from the rules.
+// A division by zero or a result that is not finite returns an *ArithmeticError.
+func CheckedFloat(operator string, x, y float64) (float64, error) {
+	res, reason := floatMath(operator, x, y)
+	if reason != "" {
+		return 0, &ArithmeticError{Operator: operator, Left: x, Right: y, Reason: reason}
+	}
+	return res, nil
+}
+
+// CheckedIntUint applies a math operator on a signed and an unsigned integer like the rules do, yielding a signed integer.
+// An unsigned integer too big for a signed one, an overflow or a division by zero returns an *ArithmeticError.
+func CheckedIntUint(operator string, x int64, y uint64) (int64, error) {
+	if y > math.MaxInt64 {
+		return 0, &ArithmeticError{Operator: operator, Left: x, Right: y, Reason: ReasonOverflow}
+	}
+	res, reason := intMath(operator, x, int64(y))
+	if reason != "" {
+		return 0, &ArithmeticError{Operator: operator, Left: x, Right: y, Reason: reason}
+	}
+	return res, nil
+}
+
+// CheckedUintInt applies a math operator on an unsigned and a signed integer like the rules do, yielding a signed integer.
+// An unsigned integer too big for a signed one, an overflow or a division by zero returns an *ArithmeticError.
+func CheckedUintInt(operator string, x uint64, y int64) (int64, error) {
+	if x > math.MaxInt64 {
+		return 0, &ArithmeticError{Operator: operator, Left: x, Right: y, Reason: ReasonOverflow}
+	}
+	res, reason := intMath(operator, int64(x), y)
+	if reason != "" {
+		return 0, &ArithmeticError{Operator: operator, Left: x, Right: y, Reason: reason}
+	}
+	return res, nil
+}
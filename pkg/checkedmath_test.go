@@ -0,0 +1,81 @@
+package pkg
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestCheckedMath(t *testing.T) {
+	testData := []struct {
+		fn     func(a, b reflect.Value) (reflect.Value, error)
+		a      interface{}
+		b      interface{}
+		reason string
+	}{
+		{ValueAdd, int64(math.MaxInt64), 1, ReasonOverflow},
+		{ValueSub, int64(math.MinInt64), 1, ReasonOverflow},
+		{ValueMul, int64(math.MaxInt64 / 2), 3, ReasonOverflow},
+		{ValueDiv, int64(math.MinInt64), -1, ReasonOverflow},
+		{ValueDiv, 10, 0, ReasonDivisionByZero},
+		{ValueDiv, 10.5, 0, ReasonDivisionByZero},
+		{ValueSub, uint(1), uint(2), ReasonOverflow},
+		{ValueAdd, uint64(math.MaxUint64), 1, ReasonOverflow},
+		{ValueMul, math.MaxFloat64, 2, ReasonInfinite},
+		{ValueSub, math.Inf(1), math.Inf(1), ReasonNaN},
+		{ValueAdd, int64(math.MaxInt64 - 1), 1, ""},
+		{ValueSub, uint(2), uint(1), ""},
+		{ValueDiv, 10, 4, ""},
+	}
+	for i, td := range testData {
+		_, err := td.fn(reflect.ValueOf(td.a), reflect.ValueOf(td.b))
+		if td.reason == "" {
+			if err != nil {
+				t.Errorf("#%d should not fail but %v", i, err)
+			}
+			continue
+		}
+		if aerr, ok := err.(*ArithmeticError); !ok || aerr.Reason != td.reason {
+			t.Errorf("#%d should fail with %s but %v", i, td.reason, err)
+		}
+	}
+}
+
+func TestCheckSignedness(t *testing.T) {
+	if err := CheckSignedness("+", reflect.ValueOf(uint(1)), reflect.ValueOf(1)); err == nil {
+		t.Errorf("unsigned and signed mixing should fail")
+	}
+	if err := CheckSignedness("+", reflect.ValueOf(uint(1)), reflect.ValueOf(uint8(1))); err != nil {
+		t.Errorf("unsigned and unsigned should not fail but %v", err)
+	}
+}
+
+func TestCheckedTyped(t *testing.T) {
+	testData := []struct {
+		fn     func() (interface{}, error)
+		result interface{}
+		reason string
+	}{
+		{func() (interface{}, error) { return CheckedInt("+", math.MaxInt64, 1) }, nil, ReasonOverflow},
+		{func() (interface{}, error) { return CheckedInt("/", 10, 4) }, int64(2), ""},
+		{func() (interface{}, error) { return CheckedUint("-", 1, 2) }, nil, ReasonOverflow},
+		{func() (interface{}, error) { return CheckedFloat("/", 1, 0) }, nil, ReasonDivisionByZero},
+		{func() (interface{}, error) { return CheckedFloat("*", 1.5, 2) }, 3.0, ""},
+		{func() (interface{}, error) { return CheckedIntUint("*", 2, math.MaxUint64) }, nil, ReasonOverflow},
+		{func() (interface{}, error) { return CheckedIntUint("-", 2, 5) }, int64(-3), ""},
+		{func() (interface{}, error) { return CheckedUintInt("+", math.MaxUint64, 1) }, nil, ReasonOverflow},
+		{func() (interface{}, error) { return CheckedUintInt("-", 2, 5) }, int64(-3), ""},
+	}
+	for i, td := range testData {
+		res, err := td.fn()
+		if td.reason == "" {
+			if err != nil || res != td.result {
+				t.Errorf("#%d should be %v but %v, %v", i, td.result, res, err)
+			}
+			continue
+		}
+		if aerr, ok := err.(*ArithmeticError); !ok || aerr.Reason != td.reason {
+			t.Errorf("#%d should fail with %s but %v", i, td.reason, err)
+		}
+	}
+}
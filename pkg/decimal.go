@@ -0,0 +1,164 @@
+package pkg
+
+import (
+	"github.com/juju/errors"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode specifies how a decimal is rounded into the specified number of decimal places.
+type RoundingMode string
+
+var (
+	// RoundHalfUp rounds towards the nearest neighbour, ties are rounded away from zero.
+	RoundHalfUp = RoundingMode("HALF_UP")
+	// RoundHalfDown rounds towards the nearest neighbour, ties are rounded towards zero.
+	RoundHalfDown = RoundingMode("HALF_DOWN")
+	// RoundHalfEven rounds towards the nearest neighbour, ties are rounded towards the even neighbour.
+	RoundHalfEven = RoundingMode("HALF_EVEN")
+	// RoundUp rounds away from zero.
+	RoundUp = RoundingMode("UP")
+	// RoundDown rounds towards zero.
+	RoundDown = RoundingMode("DOWN")
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling = RoundingMode("CEILING")
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor = RoundingMode("FLOOR")
+
+	ratType = reflect.TypeOf(&big.Rat{})
+)
+
+// IsDecimal checks if a value is an exact decimal, which is represented as *big.Rat.
+func IsDecimal(val reflect.Value) bool {
+	return val.IsValid() && val.Type() == ratType && !val.IsNil()
+}
+
+// ToDecimal converts a number value into an exact decimal. A float is converted using its shortest
+// decimal representation, so 0.15 converts into exactly 15/100 instead of its binary approximation.
+func ToDecimal(val reflect.Value) (*big.Rat, error) {
+	if IsDecimal(val) {
+		return val.Interface().(*big.Rat), nil
+	}
+	switch GetBaseKind(val) {
+	case reflect.Int64:
+		return new(big.Rat).SetInt64(val.Int()), nil
+	case reflect.Uint64:
+		return new(big.Rat).SetFrac(new(big.Int).SetUint64(val.Uint()), big.NewInt(1)), nil
+	case reflect.Float64:
+		r, ok := new(big.Rat).SetString(strconv.FormatFloat(val.Float(), 'g', -1, 64))
+		if !ok {
+			return nil, errors.Errorf("can not convert %v into decimal", val.Float())
+		}
+		return r, nil
+	}
+	if val.IsValid() {
+		return nil, errors.Errorf("can not convert %s into decimal", val.Type().String())
+	}
+	return nil, errors.Errorf("can not convert nil into decimal")
+}
+
+// decimalMath applies a math operation on two values when any of them is a decimal, the other one is converted into decimal.
+// It returns false if none of them is a decimal, or the other one is a string.
+func decimalMath(a, b reflect.Value, op func(x, y *big.Rat) (*big.Rat, error)) (reflect.Value, bool, error) {
+	if !IsDecimal(a) && !IsDecimal(b) {
+		return reflect.ValueOf(nil), false, nil
+	}
+	if GetBaseKind(a) == reflect.String || GetBaseKind(b) == reflect.String {
+		return reflect.ValueOf(nil), false, nil
+	}
+	x, err := ToDecimal(a)
+	if err != nil {
+		return reflect.ValueOf(nil), true, errors.Trace(err)
+	}
+	y, err := ToDecimal(b)
+	if err != nil {
+		return reflect.ValueOf(nil), true, errors.Trace(err)
+	}
+	r, err := op(x, y)
+	if err != nil {
+		return reflect.ValueOf(nil), true, errors.Trace(err)
+	}
+	return reflect.ValueOf(r), true, nil
+}
+
+// RoundDecimal rounds a decimal into the number of decimal places using the rounding mode.
+// Negative places round to the left of the decimal point, -2 rounds into hundreds.
+func RoundDecimal(r *big.Rat, places int, mode RoundingMode) (*big.Rat, error) {
+	exp := int64(places)
+	if exp < 0 {
+		exp = -exp
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(exp), nil)
+	scaled := new(big.Rat)
+	if places < 0 {
+		scaled.Quo(r, new(big.Rat).SetInt(scale))
+	} else {
+		scaled.Mul(r, new(big.Rat).SetInt(scale))
+	}
+
+	// quotient truncated towards zero and the remainder
+	quo, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	if rem.Sign() != 0 {
+		sign := scaled.Sign()
+		// compare twice the remainder against the denominator to detect ties.
+		half := new(big.Int).Abs(rem)
+		half.Mul(half, big.NewInt(2))
+		cmpHalf := half.Cmp(scaled.Denom())
+		awayFromZero := false
+		switch RoundingMode(strings.ToUpper(string(mode))) {
+		case RoundHalfUp:
+			awayFromZero = cmpHalf >= 0
+		case RoundHalfDown:
+			awayFromZero = cmpHalf > 0
+		case RoundHalfEven:
+			awayFromZero = cmpHalf > 0 || (cmpHalf == 0 && quo.Bit(0) == 1)
+		case RoundUp:
+			awayFromZero = true
+		case RoundDown:
+			awayFromZero = false
+		case RoundCeiling:
+			awayFromZero = sign > 0
+		case RoundFloor:
+			awayFromZero = sign < 0
+		default:
+			return nil, errors.Errorf("unknown rounding mode %s", mode)
+		}
+		if awayFromZero {
+			quo.Add(quo, big.NewInt(int64(sign)))
+		}
+	}
+	if places < 0 {
+		return new(big.Rat).SetInt(quo.Mul(quo, scale)), nil
+	}
+	return new(big.Rat).SetFrac(quo, scale), nil
+}
+
+// ConvertDecimal converts a decimal into a number of the specified type. Converting into an integer type
+// requires the decimal to be a whole number, use rounding function to make it so.
+func ConvertDecimal(r *big.Rat, typ reflect.Type) (reflect.Value, error) {
+	if typ == ratType {
+		return reflect.ValueOf(new(big.Rat).Set(r)), nil
+	}
+	switch typ.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f, _ := r.Float64()
+		return convertNumber(reflect.ValueOf(f), typ)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !r.IsInt() || !r.Num().IsInt64() {
+			return reflect.ValueOf(nil), errors.Errorf("can not assign decimal %s to %s, it must be rounded first", r.RatString(), typ.String())
+		}
+		return convertNumber(reflect.ValueOf(r.Num().Int64()), typ)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if !r.IsInt() || !r.Num().IsUint64() {
+			return reflect.ValueOf(nil), errors.Errorf("can not assign decimal %s to %s, it must be rounded first", r.RatString(), typ.String())
+		}
+		return convertNumber(reflect.ValueOf(r.Num().Uint64()), typ)
+	case reflect.Interface:
+		if ratType.Implements(typ) {
+			return reflect.ValueOf(r), nil
+		}
+	}
+	return reflect.ValueOf(nil), errors.Errorf("can not assign decimal to %s", typ.String())
+}
@@ -0,0 +1,76 @@
+package pkg
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestRoundDecimal(t *testing.T) {
+	testData := []struct {
+		value    string
+		mode     RoundingMode
+		expected string
+	}{
+		{"2.345", RoundHalfUp, "2.35"},
+		{"-2.345", RoundHalfUp, "-2.35"},
+		{"2.345", RoundHalfDown, "2.34"},
+		{"2.345", RoundHalfEven, "2.34"},
+		{"2.355", RoundHalfEven, "2.36"},
+		{"2.341", RoundUp, "2.35"},
+		{"2.349", RoundDown, "2.34"},
+		{"-2.341", RoundCeiling, "-2.34"},
+		{"-2.341", RoundFloor, "-2.35"},
+	}
+	for _, td := range testData {
+		r, _ := new(big.Rat).SetString(td.value)
+		rounded, err := RoundDecimal(r, 2, td.mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rounded.FloatString(2) != td.expected {
+			t.Errorf("%s rounded %s should be %s but %s", td.value, td.mode, td.expected, rounded.FloatString(2))
+		}
+	}
+	negative := []struct {
+		value    string
+		places   int
+		mode     RoundingMode
+		expected string
+	}{
+		{"1250", -2, RoundHalfUp, "1300"},
+		{"1250", -2, RoundHalfEven, "1200"},
+		{"-1249.99", -2, RoundHalfUp, "-1200"},
+		{"1201", -2, RoundCeiling, "1300"},
+		{"1299", -3, RoundDown, "1000"},
+		{"49", -2, RoundHalfUp, "0"},
+	}
+	for _, td := range negative {
+		r, _ := new(big.Rat).SetString(td.value)
+		rounded, err := RoundDecimal(r, td.places, td.mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rounded.RatString() != td.expected {
+			t.Errorf("%s rounded %s to %d places should be %s but %s", td.value, td.mode, td.places, td.expected, rounded.RatString())
+		}
+	}
+	if _, err := RoundDecimal(big.NewRat(1, 3), 2, RoundingMode("NEAREST")); err == nil {
+		t.Errorf("unknown rounding mode should fail")
+	}
+}
+
+func TestValueMul_Decimal(t *testing.T) {
+	price := reflect.ValueOf(19.99)
+	rate, _ := new(big.Rat).SetString("0.15")
+	res, err := ValueMul(price, reflect.ValueOf(rate))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsDecimal(res) || res.Interface().(*big.Rat).FloatString(4) != "2.9985" {
+		t.Errorf("19.99 * 0.15 should be exactly 2.9985 but %v", res.Interface())
+	}
+	if _, err := ValueDiv(res, reflect.ValueOf(0)); err == nil {
+		t.Errorf("division by zero should fail")
+	}
+}
@@ -0,0 +1,147 @@
+package pkg
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// FieldNaming tells how the rules name the struct fields. A field is named by its grool struct tag,
+// like `grool:"price_after_tax"`, by its json tag if JSONTags is set and it has no grool tag, or by its Go field name.
+// The package functions resolving the fields by name use the zero FieldNaming, without the json tags.
+type FieldNaming struct {
+	// JSONTags makes the json struct tag name a field, when the field has no grool tag.
+	JSONTags bool
+}
+
+var (
+	// fieldCache holds the resolved fields by fieldKey.
+	fieldCache sync.Map
+	// methodCache holds the resolved methods by methodKey.
+	methodCache sync.Map
+)
+
+type methodKey struct {
+	typ  reflect.Type
+	name string
+}
+
+type methodEntry struct {
+	method reflect.Method
+	ok     bool
+}
+
+// MethodByName finds the method of a type by its name, resolving it once per type and name.
+func MethodByName(typ reflect.Type, name string) (reflect.Method, bool) {
+	key := methodKey{typ: typ, name: name}
+	if entry, ok := methodCache.Load(key); ok {
+		return entry.(*methodEntry).method, entry.(*methodEntry).ok
+	}
+	method, ok := typ.MethodByName(name)
+	methodCache.Store(key, &methodEntry{method: method, ok: ok})
+	return method, ok
+}
+
+type fieldKey struct {
+	typ      reflect.Type
+	name     string
+	jsonTags bool
+}
+
+type fieldEntry struct {
+	field reflect.StructField
+	ok    bool
+}
+
+// FieldByName finds the struct field the rules refer to by the name, using the zero FieldNaming.
+func FieldByName(typ reflect.Type, name string) (reflect.StructField, bool) {
+	return FieldNaming{}.FieldByName(typ, name)
+}
+
+// FieldByName finds the struct field the rules refer to by the name.
+// A field tagged `grool:"-"` is hidden from the rules, and one tagged with the readonly option,
+// like `grool:",readonly"`, can not be assigned by the rules.
+// The field is resolved once per type, name and naming.
+func (naming FieldNaming) FieldByName(typ reflect.Type, name string) (reflect.StructField, bool) {
+	key := fieldKey{typ: typ, name: name, jsonTags: naming.JSONTags}
+	if entry, ok := fieldCache.Load(key); ok {
+		return entry.(*fieldEntry).field, entry.(*fieldEntry).ok
+	}
+	field, ok := naming.resolveField(typ, name)
+	fieldCache.Store(key, &fieldEntry{field: field, ok: ok})
+	return field, ok
+}
+
+func (naming FieldNaming) resolveField(typ reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if alias, ok := naming.fieldAlias(field); ok && alias == name {
+			return field, true
+		}
+	}
+	field, ok := typ.FieldByName(name)
+	if !ok || isHiddenField(field) {
+		return reflect.StructField{}, false
+	}
+	return field, true
+}
+
+// FieldName returns the name the rules refer to the struct field with using the zero FieldNaming.
+func FieldName(field reflect.StructField) string {
+	return FieldNaming{}.FieldName(field)
+}
+
+// FieldName returns the name the rules refer to the struct field with, it is empty if the field is hidden.
+func (naming FieldNaming) FieldName(field reflect.StructField) string {
+	if isHiddenField(field) {
+		return ""
+	}
+	if alias, ok := naming.fieldAlias(field); ok {
+		return alias
+	}
+	return field.Name
+}
+
+// fieldValue returns the field value of a struct value by the name the rules refer to it, it is invalid if there is none.
+func (naming FieldNaming) fieldValue(structVal reflect.Value, name string) reflect.Value {
+	field, ok := naming.FieldByName(structVal.Type(), name)
+	if !ok {
+		return reflect.Value{}
+	}
+	return structVal.FieldByIndex(field.Index)
+}
+
+func (naming FieldNaming) fieldAlias(field reflect.StructField) (string, bool) {
+	if alias := tagName(field.Tag.Get("grool")); len(alias) > 0 && alias != "-" {
+		return alias, true
+	}
+	if naming.JSONTags && tagName(field.Tag.Get("grool")) == "" {
+		if alias := tagName(field.Tag.Get("json")); len(alias) > 0 && alias != "-" {
+			return alias, true
+		}
+	}
+	return "", false
+}
+
+// IsReadOnlyField checks if the struct field is tagged with the readonly option.
+func IsReadOnlyField(field reflect.StructField) bool {
+	options := strings.Split(field.Tag.Get("grool"), ",")
+	for _, option := range options[1:] {
+		if option == "readonly" {
+			return true
+		}
+	}
+	return false
+}
+
+func isHiddenField(field reflect.StructField) bool {
+	return tagName(field.Tag.Get("grool")) == "-"
+}
+
+// tagName returns the name part of a struct tag value, the part before the options.
+func tagName(tag string) string {
+	if idx := strings.Index(tag, ","); idx >= 0 {
+		return tag[:idx]
+	}
+	return tag
+}
@@ -0,0 +1,57 @@
+package pkg
+
+import (
+	"reflect"
+	"testing"
+)
+
+type taggedObject struct {
+	PriceAfterTax float64 `grool:"price_after_tax"`
+	Name          string  `json:"name,omitempty"`
+	Secret        string  `grool:"-" json:"secret"`
+	Total         float64 `json:"total" grool:",readonly"`
+	Plain         int
+}
+
+func TestFieldByName(t *testing.T) {
+	typ := reflect.TypeOf(taggedObject{})
+	testData := []struct {
+		name     string
+		jsonTags bool
+		field    string
+	}{
+		{name: "price_after_tax", field: "PriceAfterTax"},
+		{name: "PriceAfterTax", field: "PriceAfterTax"},
+		{name: "Plain", field: "Plain"},
+		{name: "name", field: ""},
+		{name: "name", jsonTags: true, field: "Name"},
+		{name: "Secret", field: ""},
+		{name: "secret", jsonTags: true, field: ""},
+		{name: "total", jsonTags: true, field: "Total"},
+		{name: "Total", field: "Total"},
+	}
+	for _, td := range testData {
+		field, ok := FieldNaming{JSONTags: td.jsonTags}.FieldByName(typ, td.name)
+		if ok != (len(td.field) > 0) || field.Name != td.field {
+			t.Errorf("expect %s resolved to %q but %q", td.name, td.field, field.Name)
+		}
+	}
+
+	obj := &taggedObject{Secret: "hidden"}
+	if err := SetAttributeInterface(obj, "price_after_tax", 12.5); err != nil {
+		t.Fatal(err)
+	}
+	if obj.PriceAfterTax != 12.5 {
+		t.Errorf("expect 12.5 but %v", obj.PriceAfterTax)
+	}
+	if _, err := GetAttributeValue(obj, "Secret"); err == nil {
+		t.Errorf("expect hidden field not found")
+	}
+	names, err := GetAttributeList(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(names, []string{"price_after_tax", "Name", "Total", "Plain"}) {
+		t.Errorf("unexpected attribute names %v", names)
+	}
+}
@@ -3,55 +3,61 @@ package pkg
 import (
 	"fmt"
 	"github.com/juju/errors"
+	"math"
+	"math/big"
 	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
 )
 
 // ValueAdd will try to do a mathematical addition between two values.
+// A time.Duration can be added to a time.Time or another time.Duration.
 // It will return another value as the result and an error if between the two values are not compatible for Addition
+// If any of the two values is a decimal (*big.Rat), the other is converted into decimal and the result is exact.
+// Addition of numbers is checked, an overflow or a result that is not finite returns an *ArithmeticError.
 func ValueAdd(a, b reflect.Value) (reflect.Value, error) {
+	if a.IsValid() && b.IsValid() {
+		switch {
+		case a.Type() == timeType && b.Type() == durationType:
+			return reflect.ValueOf(a.Interface().(time.Time).Add(time.Duration(b.Int()))), nil
+		case a.Type() == durationType && b.Type() == timeType:
+			return reflect.ValueOf(b.Interface().(time.Time).Add(time.Duration(a.Int()))), nil
+		case a.Type() == durationType && b.Type() == durationType:
+			return reflect.ValueOf(time.Duration(a.Int() + b.Int())), nil
+		}
+	}
+	if res, ok, err := decimalMath(a, b, func(x, y *big.Rat) (*big.Rat, error) {
+		return new(big.Rat).Add(x, y), nil
+	}); ok {
+		return res, err
+	}
+	if res, ok, err := checkedMath(OperatorAdd, a, b); ok {
+		return res, err
+	}
 	aBkind := GetBaseKind(a)
 	bBkind := GetBaseKind(b)
 
 	switch aBkind {
 	case reflect.Int64:
-		switch bBkind {
-		case reflect.Int64:
-			return reflect.ValueOf(a.Int() + b.Int()), nil
-		case reflect.Uint64:
-			return reflect.ValueOf(a.Int() + int64(b.Uint())), nil
-		case reflect.Float64:
-			return reflect.ValueOf(float64(a.Int()) + b.Float()), nil
-		case reflect.String:
+		if bBkind == reflect.String {
 			return reflect.ValueOf(fmt.Sprintf("%d%s", a.Int(), b.String())), nil
-		default:
-			return reflect.ValueOf(nil), errors.Errorf("Can not do addition math operator between %s and %s", a.Kind().String(), b.Kind().String())
 		}
+		return reflect.ValueOf(nil), errors.Errorf("Can not do addition math operator between %s and %s", a.Kind().String(), b.Kind().String())
 	case reflect.Uint64:
-		switch bBkind {
-		case reflect.Int64:
-			return reflect.ValueOf(int64(a.Uint()) + b.Int()), nil
-		case reflect.Uint64:
-			return reflect.ValueOf(a.Uint() + b.Uint()), nil
-		case reflect.Float64:
-			return reflect.ValueOf(float64(a.Uint()) + b.Float()), nil
-		case reflect.String:
+		if bBkind == reflect.String {
 			return reflect.ValueOf(fmt.Sprintf("%d%s", a.Uint(), b.String())), nil
-		default:
-			return reflect.ValueOf(nil), errors.Errorf("Can not do addition math operator between %s and %s", a.Kind().String(), b.Kind().String())
 		}
+		return reflect.ValueOf(nil), errors.Errorf("Can not do addition math operator between %s and %s", a.Kind().String(), b.Kind().String())
 	case reflect.Float64:
-		switch bBkind {
-		case reflect.Int64:
-			return reflect.ValueOf(a.Float() + float64(b.Int())), nil
-		case reflect.Uint64:
-			return reflect.ValueOf(a.Float() + float64(b.Uint())), nil
-		case reflect.Float64:
-			return reflect.ValueOf(a.Float() + b.Float()), nil
-		case reflect.String:
+		if bBkind == reflect.String {
 			return reflect.ValueOf(fmt.Sprintf("%f%s", a.Float(), b.String())), nil
-		default:
-			return reflect.ValueOf(nil), errors.Errorf("Can not do addition math operator between %s and %s", a.Kind().String(), b.Kind().String())
 		}
+		return reflect.ValueOf(nil), errors.Errorf("Can not do addition math operator between %s and %s", a.Kind().String(), b.Kind().String())
 	case reflect.String:
 		switch bBkind {
 		case reflect.Int64:
@@ -84,136 +90,167 @@ func ValueAdd(a, b reflect.Value) (reflect.Value, error) {
 }
 
 // ValueSub will try to do a mathematical substraction between two values.
+// A time.Duration can be substracted from a time.Time or another time.Duration, and substracting two time.Time yields a time.Duration.
 // It will return another value as the result and an error if between the two values are not compatible for substraction
+// If any of the two values is a decimal (*big.Rat), the other is converted into decimal and the result is exact.
+// Substraction of numbers is checked, an overflow or a result that is not finite returns an *ArithmeticError.
 func ValueSub(a, b reflect.Value) (reflect.Value, error) {
-	aBkind := GetBaseKind(a)
-	bBkind := GetBaseKind(b)
-
-	switch aBkind {
-	case reflect.Int64:
-		switch bBkind {
-		case reflect.Int64:
-			return reflect.ValueOf(a.Int() - b.Int()), nil
-		case reflect.Uint64:
-			return reflect.ValueOf(a.Int() - int64(b.Uint())), nil
-		case reflect.Float64:
-			return reflect.ValueOf(float64(a.Int()) - b.Float()), nil
-		default:
-			return reflect.ValueOf(nil), errors.Errorf("Can not do subtraction math operator between %s and %s", a.Kind().String(), b.Kind().String())
-		}
-	case reflect.Uint64:
-		switch bBkind {
-		case reflect.Int64:
-			return reflect.ValueOf(int64(a.Uint()) - b.Int()), nil
-		case reflect.Uint64:
-			return reflect.ValueOf(a.Uint() - b.Uint()), nil
-		case reflect.Float64:
-			return reflect.ValueOf(float64(a.Uint()) - b.Float()), nil
-		default:
-			return reflect.ValueOf(nil), errors.Errorf("Can not do subtraction math operator between %s and %s", a.Kind().String(), b.Kind().String())
+	if a.IsValid() && b.IsValid() {
+		switch {
+		case a.Type() == timeType && b.Type() == durationType:
+			return reflect.ValueOf(a.Interface().(time.Time).Add(-time.Duration(b.Int()))), nil
+		case a.Type() == timeType && b.Type() == timeType:
+			return reflect.ValueOf(a.Interface().(time.Time).Sub(b.Interface().(time.Time))), nil
+		case a.Type() == durationType && b.Type() == durationType:
+			return reflect.ValueOf(time.Duration(a.Int() - b.Int())), nil
 		}
-	case reflect.Float64:
-		switch bBkind {
-		case reflect.Int64:
-			return reflect.ValueOf(a.Float() - float64(b.Int())), nil
-		case reflect.Uint64:
-			return reflect.ValueOf(a.Float() - float64(b.Uint())), nil
-		case reflect.Float64:
-			return reflect.ValueOf(a.Float() - b.Float()), nil
-		default:
-			return reflect.ValueOf(nil), errors.Errorf("Can not do subtraction math operator between %s and %s", a.Kind().String(), b.Kind().String())
-		}
-	default:
-		return reflect.ValueOf(nil), errors.Errorf("Can not do subtraction math operator between %s and %s", a.Kind().String(), b.Kind().String())
 	}
+	if res, ok, err := decimalMath(a, b, func(x, y *big.Rat) (*big.Rat, error) {
+		return new(big.Rat).Sub(x, y), nil
+	}); ok {
+		return res, err
+	}
+	if res, ok, err := checkedMath(OperatorSub, a, b); ok {
+		return res, err
+	}
+	return reflect.ValueOf(nil), errors.Errorf("Can not do subtraction math operator between %s and %s", a.Kind().String(), b.Kind().String())
 }
 
 // ValueMul will try to do a mathematical multiplication between two values.
 // It will return another value as the result and an error if between the two values are not compatible for multiplication
+// If any of the two values is a decimal (*big.Rat), the other is converted into decimal and the result is exact.
+// Multiplication of numbers is checked, an overflow or a result that is not finite returns an *ArithmeticError.
+// Multiplying a string with an integer repeats the string.
 func ValueMul(a, b reflect.Value) (reflect.Value, error) {
-	aBkind := GetBaseKind(a)
-	bBkind := GetBaseKind(b)
-
-	switch aBkind {
-	case reflect.Int64:
-		switch bBkind {
-		case reflect.Int64:
-			return reflect.ValueOf(a.Int() * b.Int()), nil
-		case reflect.Uint64:
-			return reflect.ValueOf(a.Int() * int64(b.Uint())), nil
-		case reflect.Float64:
-			return reflect.ValueOf(float64(a.Int()) * b.Float()), nil
-		default:
-			return reflect.ValueOf(nil), errors.Errorf("Can not do multiplication math operator between %s and %s", a.Kind().String(), b.Kind().String())
-		}
-	case reflect.Uint64:
-		switch bBkind {
-		case reflect.Int64:
-			return reflect.ValueOf(int64(a.Uint()) * b.Int()), nil
-		case reflect.Uint64:
-			return reflect.ValueOf(a.Uint() * b.Uint()), nil
-		case reflect.Float64:
-			return reflect.ValueOf(float64(a.Uint()) * b.Float()), nil
-		default:
-			return reflect.ValueOf(nil), errors.Errorf("Can not do multiplication math operator between %s and %s", a.Kind().String(), b.Kind().String())
-		}
-	case reflect.Float64:
-		switch bBkind {
-		case reflect.Int64:
-			return reflect.ValueOf(a.Float() * float64(b.Int())), nil
-		case reflect.Uint64:
-			return reflect.ValueOf(a.Float() * float64(b.Uint())), nil
-		case reflect.Float64:
-			return reflect.ValueOf(a.Float() * b.Float()), nil
-		default:
-			return reflect.ValueOf(nil), errors.Errorf("Can not do multiplication math operator between %s and %s", a.Kind().String(), b.Kind().String())
+	if res, ok, err := decimalMath(a, b, func(x, y *big.Rat) (*big.Rat, error) {
+		return new(big.Rat).Mul(x, y), nil
+	}); ok {
+		return res, err
+	}
+	if res, ok, err := checkedMath(OperatorMul, a, b); ok {
+		return res, err
+	}
+	if GetBaseKind(a) == reflect.String && (GetBaseKind(b) == reflect.Int64 || GetBaseKind(b) == reflect.Uint64) {
+		count, ok := toInt64(b)
+		if !ok || count < 0 || (count > 0 && len(a.String()) > math.MaxInt32/int(count)) {
+			return reflect.ValueOf(nil), newArithmeticError(OperatorMul, a, b, ReasonRepeatCount)
 		}
-	default:
-		return reflect.ValueOf(nil), errors.Errorf("Can not do multiplication math operator between %s and %s", a.Kind().String(), b.Kind().String())
+		return reflect.ValueOf(strings.Repeat(a.String(), int(count))), nil
 	}
+	return reflect.ValueOf(nil), errors.Errorf("Can not do multiplication math operator between %s and %s", a.Kind().String(), b.Kind().String())
 }
 
 // ValueDiv will try to do a mathematical division between two values.
 // It will return another value as the result and an error if between the two values are not compatible for division
+// If any of the two values is a decimal (*big.Rat), the other is converted into decimal and the result is exact.
+// Division of numbers is checked, a division by zero, an overflow or a result that is not finite returns an *ArithmeticError.
 func ValueDiv(a, b reflect.Value) (reflect.Value, error) {
+	if res, ok, err := decimalMath(a, b, func(x, y *big.Rat) (*big.Rat, error) {
+		if y.Sign() == 0 {
+			return nil, &ArithmeticError{Operator: OperatorDiv, Left: x, Right: y, Reason: ReasonDivisionByZero}
+		}
+		return new(big.Rat).Quo(x, y), nil
+	}); ok {
+		return res, err
+	}
+	if res, ok, err := checkedMath(OperatorDiv, a, b); ok {
+		return res, err
+	}
+	return reflect.ValueOf(nil), errors.Errorf("Can not do division math operator between %s and %s", a.Kind().String(), b.Kind().String())
+}
+
+// ValueBitAnd will do a bitwise and between two integers.
+// If any of them is unsigned the result is unsigned, and the other one may not be negative.
+func ValueBitAnd(a, b reflect.Value) (reflect.Value, error) {
+	return bitwise(OperatorBitAnd, a, b, func(x, y uint64) uint64 { return x & y })
+}
+
+// ValueBitOr will do a bitwise or between two integers.
+// If any of them is unsigned the result is unsigned, and the other one may not be negative.
+func ValueBitOr(a, b reflect.Value) (reflect.Value, error) {
+	return bitwise(OperatorBitOr, a, b, func(x, y uint64) uint64 { return x | y })
+}
+
+// ValueBitXor will do a bitwise exclusive or between two integers.
+// If any of them is unsigned the result is unsigned, and the other one may not be negative.
+func ValueBitXor(a, b reflect.Value) (reflect.Value, error) {
+	return bitwise(OperatorBitXor, a, b, func(x, y uint64) uint64 { return x ^ y })
+}
+
+// ValueShiftLeft will shift the bits of an integer to the left. The result has the same signedness as the shifted integer.
+// Shifting out a bit, or the sign of a signed integer, is an overflow.
+func ValueShiftLeft(a, b reflect.Value) (reflect.Value, error) {
+	count, err := shiftCount(OperatorShiftLeft, a, b)
+	if err != nil {
+		return reflect.ValueOf(nil), err
+	}
+	if GetBaseKind(a) == reflect.Uint64 {
+		res := a.Uint() << count
+		if res>>count != a.Uint() {
+			return reflect.ValueOf(nil), newArithmeticError(OperatorShiftLeft, a, b, ReasonOverflow)
+		}
+		return reflect.ValueOf(res), nil
+	}
+	res := a.Int() << count
+	if res>>count != a.Int() {
+		return reflect.ValueOf(nil), newArithmeticError(OperatorShiftLeft, a, b, ReasonOverflow)
+	}
+	return reflect.ValueOf(res), nil
+}
+
+// ValueShiftRight will shift the bits of an integer to the right. The result has the same signedness as the shifted integer.
+func ValueShiftRight(a, b reflect.Value) (reflect.Value, error) {
+	count, err := shiftCount(OperatorShiftRight, a, b)
+	if err != nil {
+		return reflect.ValueOf(nil), err
+	}
+	if GetBaseKind(a) == reflect.Uint64 {
+		return reflect.ValueOf(a.Uint() >> count), nil
+	}
+	return reflect.ValueOf(a.Int() >> count), nil
+}
+
+// ValueCompareString will compare two strings lexicographically byte-wise. The result will be 0 if a==b, -1 if a < b, and +1 if a > b.
+func ValueCompareString(a, b reflect.Value) (int, error) {
+	if GetBaseKind(a) != reflect.String || GetBaseKind(b) != reflect.String {
+		return 0, errors.Errorf("Can not compare %s and %s as string", a.Kind().String(), b.Kind().String())
+	}
+	return strings.Compare(a.String(), b.String()), nil
+}
+
+func bitwise(operator string, a, b reflect.Value, op func(x, y uint64) uint64) (reflect.Value, error) {
 	aBkind := GetBaseKind(a)
 	bBkind := GetBaseKind(b)
+	if (aBkind != reflect.Int64 && aBkind != reflect.Uint64) || (bBkind != reflect.Int64 && bBkind != reflect.Uint64) {
+		return reflect.ValueOf(nil), errors.Errorf("Can not do bitwise operator %s between %s and %s", operator, a.Kind().String(), b.Kind().String())
+	}
+	if aBkind == reflect.Int64 && bBkind == reflect.Int64 {
+		return reflect.ValueOf(int64(op(uint64(a.Int()), uint64(b.Int())))), nil
+	}
+	if (aBkind == reflect.Int64 && a.Int() < 0) || (bBkind == reflect.Int64 && b.Int() < 0) {
+		return reflect.ValueOf(nil), newArithmeticError(operator, a, b, ReasonSignedness)
+	}
+	return reflect.ValueOf(op(toUint64(a), toUint64(b))), nil
+}
 
-	switch aBkind {
-	case reflect.Int64:
-		switch bBkind {
-		case reflect.Int64:
-			return reflect.ValueOf(a.Int() / b.Int()), nil
-		case reflect.Uint64:
-			return reflect.ValueOf(a.Int() / int64(b.Uint())), nil
-		case reflect.Float64:
-			return reflect.ValueOf(float64(a.Int()) / b.Float()), nil
-		default:
-			return reflect.ValueOf(nil), errors.Errorf("Can not do division math operator between %s and %s", a.Kind().String(), b.Kind().String())
-		}
-	case reflect.Uint64:
-		switch bBkind {
-		case reflect.Int64:
-			return reflect.ValueOf(int64(a.Uint()) / b.Int()), nil
-		case reflect.Uint64:
-			return reflect.ValueOf(a.Uint() / b.Uint()), nil
-		case reflect.Float64:
-			return reflect.ValueOf(float64(a.Uint()) / b.Float()), nil
-		default:
-			return reflect.ValueOf(nil), errors.Errorf("Can not do division math operator between %s and %s", a.Kind().String(), b.Kind().String())
-		}
-	case reflect.Float64:
-		switch bBkind {
-		case reflect.Int64:
-			return reflect.ValueOf(a.Float() / float64(b.Int())), nil
-		case reflect.Uint64:
-			return reflect.ValueOf(a.Float() / float64(b.Uint())), nil
-		case reflect.Float64:
-			return reflect.ValueOf(a.Float() / b.Float()), nil
-		default:
-			return reflect.ValueOf(nil), errors.Errorf("Can not do division math operator between %s and %s", a.Kind().String(), b.Kind().String())
-		}
-	default:
-		return reflect.ValueOf(nil), errors.Errorf("Can not do division math operator between %s and %s", a.Kind().String(), b.Kind().String())
+func shiftCount(operator string, a, b reflect.Value) (uint64, error) {
+	aBkind := GetBaseKind(a)
+	bBkind := GetBaseKind(b)
+	if (aBkind != reflect.Int64 && aBkind != reflect.Uint64) || (bBkind != reflect.Int64 && bBkind != reflect.Uint64) {
+		return 0, errors.Errorf("Can not do shift operator %s between %s and %s", operator, a.Kind().String(), b.Kind().String())
+	}
+	if bBkind == reflect.Int64 && b.Int() < 0 {
+		return 0, newArithmeticError(operator, a, b, ReasonNegativeShift)
+	}
+	if toUint64(b) >= 64 {
+		return 0, newArithmeticError(operator, a, b, ReasonShiftCount)
+	}
+	return toUint64(b), nil
+}
+
+func toUint64(val reflect.Value) uint64 {
+	if GetBaseKind(val) == reflect.Int64 {
+		return uint64(val.Int())
 	}
+	return val.Uint()
 }
@@ -1,8 +1,10 @@
 package pkg
 
 import (
+	"math"
 	"reflect"
 	"testing"
+	"time"
 )
 
 var (
@@ -238,3 +240,110 @@ func TestValueDiv(t *testing.T) {
 		}
 	}
 }
+
+func TestValueAdd_Time(t *testing.T) {
+	start := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	res, err := ValueAdd(reflect.ValueOf(start), reflect.ValueOf(48*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Interface().(time.Time).Equal(start.AddDate(0, 0, 2)) {
+		t.Errorf("time plus duration should be 2 days later but %v", res.Interface())
+	}
+	res, err = ValueAdd(reflect.ValueOf(time.Hour), reflect.ValueOf(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Interface().(time.Duration) != time.Hour+time.Minute {
+		t.Errorf("duration plus duration should be 1h1m but %v", res.Interface())
+	}
+}
+
+func TestValueSub_Time(t *testing.T) {
+	start := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, 1, 31, 0, 0, 0, 0, time.UTC)
+	res, err := ValueSub(reflect.ValueOf(end), reflect.ValueOf(start))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Interface().(time.Duration) != 30*24*time.Hour {
+		t.Errorf("time minus time should be 30 days but %v", res.Interface())
+	}
+	res, err = ValueSub(reflect.ValueOf(end), reflect.ValueOf(24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Interface().(time.Time).Equal(end.AddDate(0, 0, -1)) {
+		t.Errorf("time minus duration should be a day earlier but %v", res.Interface())
+	}
+}
+
+func TestValueBitwise(t *testing.T) {
+	res, err := ValueBitAnd(reflect.ValueOf(uint64(6)), reflect.ValueOf(3))
+	if err != nil || res.Kind() != reflect.Uint64 || res.Uint() != 2 {
+		t.Errorf("6 & 3 should be unsigned 2 but %v, %v", res, err)
+	}
+	res, err = ValueBitOr(reflect.ValueOf(4), reflect.ValueOf(1))
+	if err != nil || res.Kind() != reflect.Int64 || res.Int() != 5 {
+		t.Errorf("4 | 1 should be signed 5 but %v, %v", res, err)
+	}
+	res, err = ValueBitXor(reflect.ValueOf(uint8(5)), reflect.ValueOf(uint8(1)))
+	if err != nil || res.Uint() != 4 {
+		t.Errorf("5 ^ 1 should be 4 but %v, %v", res, err)
+	}
+	if _, err = ValueBitAnd(reflect.ValueOf(uint64(6)), reflect.ValueOf(-1)); err == nil {
+		t.Errorf("negative signed and unsigned should fail")
+	}
+	if _, err = ValueBitAnd(reflect.ValueOf(1.5), reflect.ValueOf(1)); err == nil {
+		t.Errorf("bitwise on float should fail")
+	}
+	res, err = ValueShiftLeft(reflect.ValueOf(uint32(1)), reflect.ValueOf(4))
+	if err != nil || res.Uint() != 16 {
+		t.Errorf("1 << 4 should be 16 but %v, %v", res, err)
+	}
+	res, err = ValueShiftRight(reflect.ValueOf(-16), reflect.ValueOf(2))
+	if err != nil || res.Int() != -4 {
+		t.Errorf("-16 >> 2 should be -4 but %v, %v", res, err)
+	}
+	if _, err = ValueShiftLeft(reflect.ValueOf(1), reflect.ValueOf(-1)); err == nil {
+		t.Errorf("negative shift count should fail")
+	}
+	res, err = ValueShiftLeft(reflect.ValueOf(-1), reflect.ValueOf(63))
+	if err != nil || res.Int() != math.MinInt64 {
+		t.Errorf("-1 << 63 should be %d but %v, %v", int64(math.MinInt64), res, err)
+	}
+	overflows := []struct {
+		a, b   interface{}
+		reason string
+	}{
+		{a: 1, b: 70, reason: ReasonShiftCount},
+		{a: uint64(1), b: 64, reason: ReasonShiftCount},
+		{a: 1, b: 63, reason: ReasonOverflow},
+		{a: 3, b: 62, reason: ReasonOverflow},
+		{a: -3, b: 62, reason: ReasonOverflow},
+		{a: uint64(3), b: 63, reason: ReasonOverflow},
+	}
+	for _, td := range overflows {
+		_, err = ValueShiftLeft(reflect.ValueOf(td.a), reflect.ValueOf(td.b))
+		if arithErr, ok := err.(*ArithmeticError); !ok || arithErr.Reason != td.reason {
+			t.Errorf("%v << %v should fail with %s but %v", td.a, td.b, td.reason, err)
+		}
+	}
+	if _, err = ValueShiftRight(reflect.ValueOf(-1), reflect.ValueOf(64)); err == nil {
+		t.Errorf("-1 >> 64 should fail")
+	}
+}
+
+func TestValueStringOperation(t *testing.T) {
+	res, err := ValueMul(reflect.ValueOf("ab"), reflect.ValueOf(3))
+	if err != nil || res.String() != "ababab" {
+		t.Errorf("ab * 3 should be ababab but %v, %v", res, err)
+	}
+	if _, err = ValueMul(reflect.ValueOf("ab"), reflect.ValueOf(-1)); err == nil {
+		t.Errorf("negative repeat count should fail")
+	}
+	cmp, err := ValueCompareString(reflect.ValueOf("apple"), reflect.ValueOf("banana"))
+	if err != nil || cmp >= 0 {
+		t.Errorf("apple should be less than banana but %d, %v", cmp, err)
+	}
+}
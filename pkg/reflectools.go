@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"github.com/juju/errors"
 	"github.com/sirupsen/logrus"
+	"math"
+	"math/big"
 	"reflect"
 	"time"
 )
@@ -35,9 +37,9 @@ func GetFunctionParameterTypes(obj interface{}, methodName string) ([]reflect.Ty
 
 	if objType.String() == "reflect.Value" {
 		val := obj.(reflect.Value)
-		meth, found = val.Type().MethodByName(methodName)
+		meth, found = MethodByName(val.Type(), methodName)
 	} else {
-		meth, found = objType.MethodByName(methodName)
+		meth, found = MethodByName(objType, methodName)
 	}
 	if found {
 		x := meth.Type
@@ -57,7 +59,7 @@ func GetFunctionReturnTypes(obj interface{}, methodName string) ([]reflect.Type,
 	ret := make([]reflect.Type, 0)
 	objType := reflect.TypeOf(obj)
 
-	meth, found := objType.MethodByName(methodName)
+	meth, found := MethodByName(objType, methodName)
 	if found {
 		x := meth.Type
 		for i := 0; i < x.NumOut(); i++ {
@@ -80,11 +82,11 @@ func InvokeFunction(obj interface{}, methodName string, param []interface{}) ([]
 	} else {
 		objVal = reflect.ValueOf(obj)
 	}
-	funcVal := objVal.MethodByName(methodName)
-
-	if !funcVal.IsValid() {
+	meth, found := MethodByName(objVal.Type(), methodName)
+	if !found {
 		return nil, errors.New(fmt.Sprintf("invalid function %s", methodName))
 	}
+	funcVal := objVal.Method(meth.Index)
 
 	argVals := make([]reflect.Value, len(param))
 	for idx, val := range param {
@@ -94,27 +96,31 @@ func InvokeFunction(obj interface{}, methodName string, param []interface{}) ([]
 	retVals := funcVal.Call(argVals)
 	ret := make([]interface{}, len(retVals))
 	for idx, r := range retVals {
+		if r.Kind() == reflect.Ptr {
+			// keep the returned pointer, so a chained call works on the same instance.
+			ret[idx] = r.Interface()
+			continue
+		}
 		ret[idx] = ValueToInterface(r)
 	}
 	return ret, nil
 }
 
-// IsValidField validates if an instance struct have a field with such name
+// IsValidField validates if an instance struct have a field with such name, or a map have such key
 func IsValidField(obj interface{}, fieldName string) bool {
+	if IsMap(obj) {
+		mapVal := mapValue(obj)
+		return mapVal.MapIndex(mapKey(mapVal, fieldName)).IsValid()
+	}
 	if !IsStruct(obj) {
 		return false
 	}
-	objType := reflect.TypeOf(obj)
-	objVal := reflect.ValueOf(obj)
-	if objType.Kind() == reflect.Struct {
-		fieldVal := objVal.FieldByName(fieldName)
-		return fieldVal.IsValid()
-	} else if objType.Kind() == reflect.Ptr {
-		fieldVal := objVal.Elem().FieldByName(fieldName)
-		return fieldVal.IsValid()
-	} else {
-		return false
+	structType := reflect.TypeOf(obj)
+	if structType.Kind() == reflect.Ptr {
+		structType = structType.Elem()
 	}
+	_, ok := FieldByName(structType, fieldName)
+	return ok
 }
 
 // IsStruct validates if an instance is struct or pointer to struct
@@ -129,6 +135,45 @@ func IsStruct(obj interface{}) bool {
 	return objType.Elem().Kind() == reflect.Struct
 }
 
+// IsMap validates if an instance is a map or pointer to map with string keys, which keys are used like struct fields.
+func IsMap(obj interface{}) bool {
+	if !reflect.ValueOf(obj).IsValid() {
+		return false
+	}
+	objType := reflect.TypeOf(obj)
+	if objType.Kind() == reflect.Ptr {
+		objType = objType.Elem()
+	}
+	return objType.Kind() == reflect.Map && objType.Key().Kind() == reflect.String
+}
+
+// mapValue returns the map value of a map or pointer to map.
+func mapValue(obj interface{}) reflect.Value {
+	val := reflect.ValueOf(obj)
+	if val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	return val
+}
+
+// mapKey returns the key value of a map for a field name, the map key type may be a named string type.
+func mapKey(mapVal reflect.Value, fieldName string) reflect.Value {
+	return reflect.ValueOf(fieldName).Convert(mapVal.Type().Key())
+}
+
+// IsNilValue validates if a value is nil, either its an invalid value or a nil pointer, interface, map, slice, func or channel.
+func IsNilValue(val reflect.Value) bool {
+	if !val.IsValid() {
+		return true
+	}
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return val.IsNil()
+	default:
+		return false
+	}
+}
+
 // ValueToInterface will try to obtain an interface to a speciffic value.
 // it will detect the value's kind.
 func ValueToInterface(v reflect.Value) interface{} {
@@ -172,12 +217,17 @@ func ValueToInterface(v reflect.Value) interface{} {
 		}
 		logrus.Errorf("Can't interface value of struct %v", v)
 		return nil
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Interface:
+		if v.CanInterface() {
+			return v.Interface()
+		}
+		return nil
 	default:
 		return nil
 	}
 }
 
-// GetAttributeList will populate list of struct's public member variable.
+// GetAttributeList will populate list of struct's member variable names as the rules refer to them, without the hidden ones.
 func GetAttributeList(obj interface{}) ([]string, error) {
 	if !IsStruct(obj) {
 		return nil, errors.Errorf("param is not a struct")
@@ -186,29 +236,61 @@ func GetAttributeList(obj interface{}) ([]string, error) {
 	v := reflect.ValueOf(obj)
 	e := v.Elem()
 	for i := 0; i < e.Type().NumField(); i++ {
-		strRet = append(strRet, e.Type().Field(i).Name)
+		if name := FieldName(e.Type().Field(i)); len(name) > 0 {
+			strRet = append(strRet, name)
+		}
 	}
 	return strRet, nil
 }
 
-// GetAttributeValue will retrieve a members variable value.
+// GetAttributeValue will retrieve a members variable value, using the zero FieldNaming.
 func GetAttributeValue(obj interface{}, fieldName string) (reflect.Value, error) {
-	if !IsStruct(obj) {
-		return reflect.ValueOf(nil), errors.Errorf("param is not a struct")
+	return FieldNaming{}.GetAttributeValue(obj, fieldName)
+}
+
+// GetAttributeValue will retrieve a members variable value.
+// For a map it retrieves the value of the key, taken out of the interface if the map holds interfaces.
+func (naming FieldNaming) GetAttributeValue(obj interface{}, fieldName string) (reflect.Value, error) {
+	if IsMap(obj) {
+		mapVal := mapValue(obj)
+		if mapVal.IsNil() {
+			return reflect.ValueOf(nil), errors.Errorf("attribute named %s not exist in nil map", fieldName)
+		}
+		attrVal := mapVal.MapIndex(mapKey(mapVal, fieldName))
+		if !attrVal.IsValid() {
+			return reflect.ValueOf(nil), errors.Errorf("attribute named %s not exist in map", fieldName)
+		}
+		if attrVal.Kind() == reflect.Interface && !attrVal.IsNil() {
+			attrVal = attrVal.Elem()
+		}
+		return attrVal, nil
 	}
-	if !IsValidField(obj, fieldName) {
-		return reflect.ValueOf(nil), errors.Errorf("attribute named %s not exist in struct", fieldName)
+	structVal, err := structValue(obj)
+	if err != nil {
+		return reflect.ValueOf(nil), err
 	}
-	structval := reflect.ValueOf(obj)
-	var attrVal reflect.Value
-	if structval.Kind() == reflect.Ptr {
-		attrVal = structval.Elem().FieldByName(fieldName)
-	} else {
-		attrVal = structval.FieldByName(fieldName)
+	attrVal := naming.fieldValue(structVal, fieldName)
+	if !attrVal.IsValid() {
+		return reflect.ValueOf(nil), errors.Errorf("attribute named %s not exist in struct", fieldName)
 	}
 	return attrVal, nil
 }
 
+// structValue returns the struct value of a struct or pointer to struct.
+func structValue(obj interface{}) (reflect.Value, error) {
+	structVal := reflect.ValueOf(obj)
+	if structVal.Kind() == reflect.Ptr {
+		if structVal.IsNil() {
+			return reflect.ValueOf(nil), errors.Errorf("param is a nil pointer")
+		}
+		structVal = structVal.Elem()
+	}
+	if structVal.Kind() != reflect.Struct {
+		return reflect.ValueOf(nil), errors.Errorf("param is not a struct")
+	}
+	return structVal, nil
+}
+
 // GetAttributeInterface will retrieve a members variable value as usable interface.
 func GetAttributeInterface(obj interface{}, fieldName string) (interface{}, error) {
 	val, err := GetAttributeValue(obj, fieldName)
@@ -218,53 +300,83 @@ func GetAttributeInterface(obj interface{}, fieldName string) (interface{}, erro
 	return ValueToInterface(val), nil
 }
 
-// GetAttributeType will return the type of a specific member variable
+// GetAttributeType will return the type of a specific member variable, using the zero FieldNaming.
 func GetAttributeType(obj interface{}, fieldName string) (reflect.Type, error) {
+	return FieldNaming{}.GetAttributeType(obj, fieldName)
+}
+
+// GetAttributeType will return the type of a specific member variable.
+// For a map holding interfaces it is the type of the value currently held by the key.
+func (naming FieldNaming) GetAttributeType(obj interface{}, fieldName string) (reflect.Type, error) {
+	if IsMap(obj) {
+		attrVal, err := naming.GetAttributeValue(obj, fieldName)
+		if err != nil {
+			return nil, err
+		}
+		return attrVal.Type(), nil
+	}
 	if !IsStruct(obj) {
 		return nil, errors.Errorf("param is not a struct")
 	}
-	if !IsValidField(obj, fieldName) {
-		return nil, errors.Errorf("attribute named %s not exist in struct", fieldName)
+	structType := reflect.TypeOf(obj)
+	if structType.Kind() == reflect.Ptr {
+		structType = structType.Elem()
 	}
-	structval := reflect.ValueOf(obj)
-	var attrVal reflect.Value
-	if structval.Kind() == reflect.Ptr {
-		attrVal = structval.Elem().FieldByName(fieldName)
-	} else {
-		attrVal = structval.FieldByName(fieldName)
+	field, ok := naming.FieldByName(structType, fieldName)
+	if !ok {
+		return nil, errors.Errorf("attribute named %s not exist in struct", fieldName)
 	}
-	return attrVal.Type(), nil
+	return field.Type, nil
 }
 
-// SetAttributeValue will try to set a member variable value with a new one.
+// SetAttributeValue will try to set a member variable value with a new one, using the zero FieldNaming.
 func SetAttributeValue(obj interface{}, fieldName string, value reflect.Value) error {
-	if !IsStruct(obj) {
-		return errors.Errorf("param is not a struct")
+	return FieldNaming{}.SetAttributeValue(obj, fieldName, value)
+}
+
+// SetAttributeValue will try to set a member variable value with a new one.
+// For a map it sets the key, adding it if the map does not have it yet. A read-only field can not be set.
+func (naming FieldNaming) SetAttributeValue(obj interface{}, fieldName string, value reflect.Value) error {
+	if IsMap(obj) {
+		return naming.setMapValue(mapValue(obj), fieldName, value)
 	}
-	if !IsValidField(obj, fieldName) {
+	structVal, err := structValue(obj)
+	if err != nil {
+		return err
+	}
+	field, ok := naming.FieldByName(structVal.Type(), fieldName)
+	if !ok {
 		return errors.Errorf("attribute named %s not exist in struct", fieldName)
 	}
-	var fieldVal reflect.Value
-	objType := reflect.TypeOf(obj)
-	objVal := reflect.ValueOf(obj)
-	// If Obj param is a pointer
-	if objType.Kind() == reflect.Ptr {
-		// And it points to a struct
-		if objType.Elem().Kind() == reflect.Struct {
-			fieldVal = objVal.Elem().FieldByName(fieldName)
-		} else {
-			// If its not point to struct ... return error
-			return errors.Errorf("object is pointing a non struct. %s", objType.Elem().Kind().String())
+	if IsReadOnlyField(field) {
+		return errors.Errorf("attribute named %s is read only", fieldName)
+	}
+	fieldVal := structVal.FieldByIndex(field.Index)
+
+	// Decimals are converted into the field number type
+	if IsDecimal(value) {
+		if !fieldVal.CanSet() {
+			return errors.Errorf("can not set field")
 		}
-	} else {
-		// If Obj param is not a pointer.
-		// And its a struct
-		if objType.Kind() == reflect.Struct {
-			fieldVal = objVal.FieldByName(fieldName)
-		} else {
-			// If its not a struct ... return error
-			return errors.Errorf("object is not a struct. %s", objType.Kind().String())
+		converted, err := ConvertDecimal(value.Interface().(*big.Rat), fieldVal.Type())
+		if err != nil {
+			return errors.Trace(err)
 		}
+		fieldVal.Set(converted)
+		return nil
+	}
+
+	// Slices, arrays, maps and structs built from literals are converted into the field type
+	if IsConvertibleKind(fieldVal.Type(), value) {
+		if !fieldVal.CanSet() {
+			return errors.Errorf("can not set field")
+		}
+		converted, err := naming.ConvertValue(value, fieldVal.Type())
+		if err != nil {
+			return errors.Trace(err)
+		}
+		fieldVal.Set(converted)
+		return nil
 	}
 
 	// Check source data type compatibility with the field type
@@ -276,14 +388,14 @@ func SetAttributeValue(obj interface{}, fieldName string, value reflect.Value) e
 		case reflect.String:
 			fieldVal.SetString(value.String())
 			break
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			fieldVal.SetInt(value.Int())
-			break
-		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-			fieldVal.SetUint(value.Uint())
-			break
-		case reflect.Float32, reflect.Float64:
-			fieldVal.SetFloat(value.Float())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			converted, err := convertNumber(value, fieldVal.Type())
+			if err != nil {
+				return errors.Trace(err)
+			}
+			fieldVal.Set(converted)
 			break
 		case reflect.Bool:
 			fieldVal.SetBool(value.Bool())
@@ -324,8 +436,60 @@ func SetAttributeValue(obj interface{}, fieldName string, value reflect.Value) e
 	return nil
 }
 
+// setMapValue sets a map key, converting the value into the map element type.
+func (naming FieldNaming) setMapValue(mapVal reflect.Value, fieldName string, value reflect.Value) error {
+	if mapVal.IsNil() {
+		return errors.Errorf("can not set %s of nil map", fieldName)
+	}
+	elemType := mapVal.Type().Elem()
+	for value.IsValid() && value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	switch {
+	case !value.IsValid():
+		if !IsNilValue(reflect.Zero(elemType)) {
+			return errors.Errorf("can not assign nil to %s", elemType.String())
+		}
+		value = reflect.Zero(elemType)
+	case IsDecimal(value):
+		// decimals are kept as float64 in maps holding interfaces
+		target := elemType
+		if target.Kind() == reflect.Interface {
+			target = reflect.TypeOf(float64(0))
+		}
+		converted, err := ConvertDecimal(value.Interface().(*big.Rat), target)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		value = converted
+	case elemType.Kind() == reflect.Interface:
+		if !value.Type().Implements(elemType) {
+			return errors.Errorf("can not assign type %s to %s", value.Type().String(), elemType.String())
+		}
+	case IsConvertibleKind(elemType, value):
+		converted, err := naming.ConvertValue(value, elemType)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		value = converted
+	case GetBaseKind(value) == GetBaseKind(reflect.Zero(elemType)) && value.Type().ConvertibleTo(elemType):
+		converted, err := convertNumber(value, elemType)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		value = converted
+	default:
+		return errors.Errorf("can not assign type %s to %s", value.Type().String(), elemType.String())
+	}
+	mapVal.SetMapIndex(mapKey(mapVal, fieldName), value)
+	return nil
+}
+
 // SetAttributeInterface will try to set a member variable value with a value from an interface
 func SetAttributeInterface(obj interface{}, fieldName string, value interface{}) error {
+	if IsMap(obj) {
+		return SetAttributeValue(obj, fieldName, reflect.ValueOf(value))
+	}
 	if !IsStruct(obj) {
 		return errors.Errorf("param is not a struct")
 	}
@@ -345,7 +509,7 @@ func IsAttributeArray(obj interface{}, fieldName string) (bool, error) {
 		return false, errors.Errorf("attribute named %s not exist in struct", fieldName)
 	}
 	objVal := reflect.ValueOf(obj)
-	fieldVal := objVal.Elem().FieldByName(fieldName)
+	fieldVal := FieldNaming{}.fieldValue(objVal.Elem(), fieldName)
 	return fieldVal.Type().Kind() == reflect.Array || fieldVal.Type().Kind() == reflect.Slice, nil
 }
 
@@ -358,7 +522,7 @@ func IsAttributeMap(obj interface{}, fieldName string) (bool, error) {
 		return false, errors.Errorf("attribute named %s not exist in struct", fieldName)
 	}
 	objVal := reflect.ValueOf(obj)
-	fieldVal := objVal.Elem().FieldByName(fieldName)
+	fieldVal := FieldNaming{}.fieldValue(objVal.Elem(), fieldName)
 	return fieldVal.Type().Kind() == reflect.Map, nil
 }
 
@@ -371,7 +535,7 @@ func IsAttributeNilOrZero(obj interface{}, fieldName string) (bool, error) {
 		return false, errors.Errorf("attribute named %s not exist in struct", fieldName)
 	}
 	objVal := reflect.ValueOf(obj)
-	fieldVal := objVal.Elem().FieldByName(fieldName)
+	fieldVal := FieldNaming{}.fieldValue(objVal.Elem(), fieldName)
 	if fieldVal.Kind() == reflect.Ptr {
 		return fieldVal.IsNil(), nil
 	} else if fieldVal.Kind() == reflect.Struct {
@@ -616,3 +780,217 @@ func GetBaseKind(val reflect.Value) reflect.Kind {
 		return val.Kind()
 	}
 }
+
+// IsConvertibleKind checks whether a value should be converted by ConvertValue before being used as the type,
+// that is a slice, array or map target, or a struct target (or pointer to one) from a map of field values.
+func IsConvertibleKind(typ reflect.Type, value reflect.Value) bool {
+	if !value.IsValid() {
+		return false
+	}
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return true
+	case reflect.Struct:
+		return value.Kind() == reflect.Map
+	case reflect.Ptr:
+		return typ.Elem().Kind() == reflect.Struct && value.Kind() == reflect.Map
+	default:
+		return false
+	}
+}
+
+// ConvertValue will try to convert a value into the specified type, using the zero FieldNaming.
+func ConvertValue(value reflect.Value, typ reflect.Type) (reflect.Value, error) {
+	return FieldNaming{}.ConvertValue(value, typ)
+}
+
+// ConvertValue will try to convert a value into the specified type.
+// Slices, arrays and maps are copied element by element, a map with string keys converts into a struct
+// (or pointer to struct) by its field names, and numbers convert among numeric types.
+func (naming FieldNaming) ConvertValue(value reflect.Value, typ reflect.Type) (reflect.Value, error) {
+	if !value.IsValid() {
+		switch typ.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+			return reflect.Zero(typ), nil
+		default:
+			return reflect.ValueOf(nil), errors.Errorf("can not convert null to %s", typ.String())
+		}
+	}
+	if value.Kind() == reflect.Interface {
+		return naming.ConvertValue(value.Elem(), typ)
+	}
+	if IsDecimal(value) {
+		return ConvertDecimal(value.Interface().(*big.Rat), typ)
+	}
+	switch typ.Kind() {
+	case reflect.Slice:
+		if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+			break
+		}
+		ret := reflect.MakeSlice(typ, value.Len(), value.Len())
+		for i := 0; i < value.Len(); i++ {
+			elem, err := naming.ConvertValue(value.Index(i), typ.Elem())
+			if err != nil {
+				return reflect.ValueOf(nil), errors.Trace(err)
+			}
+			ret.Index(i).Set(elem)
+		}
+		return ret, nil
+	case reflect.Array:
+		if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+			break
+		}
+		if value.Len() > typ.Len() {
+			return reflect.ValueOf(nil), errors.Errorf("can not convert %d elements into %s", value.Len(), typ.String())
+		}
+		ret := reflect.New(typ).Elem()
+		for i := 0; i < value.Len(); i++ {
+			elem, err := naming.ConvertValue(value.Index(i), typ.Elem())
+			if err != nil {
+				return reflect.ValueOf(nil), errors.Trace(err)
+			}
+			ret.Index(i).Set(elem)
+		}
+		return ret, nil
+	case reflect.Map:
+		if value.Kind() != reflect.Map {
+			break
+		}
+		ret := reflect.MakeMapWithSize(typ, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			key, err := naming.ConvertValue(iter.Key(), typ.Key())
+			if err != nil {
+				return reflect.ValueOf(nil), errors.Trace(err)
+			}
+			elem, err := naming.ConvertValue(iter.Value(), typ.Elem())
+			if err != nil {
+				return reflect.ValueOf(nil), errors.Trace(err)
+			}
+			ret.SetMapIndex(key, elem)
+		}
+		return ret, nil
+	case reflect.Struct:
+		if value.Kind() != reflect.Map || value.Type().Key().Kind() != reflect.String {
+			break
+		}
+		ret := reflect.New(typ).Elem()
+		iter := value.MapRange()
+		for iter.Next() {
+			field := naming.fieldValue(ret, iter.Key().String())
+			if !field.IsValid() || !field.CanSet() {
+				return reflect.ValueOf(nil), errors.Errorf("attribute named %s not exist in struct %s", iter.Key().String(), typ.String())
+			}
+			elem, err := naming.ConvertValue(iter.Value(), field.Type())
+			if err != nil {
+				return reflect.ValueOf(nil), errors.Trace(err)
+			}
+			field.Set(elem)
+		}
+		return ret, nil
+	case reflect.Ptr:
+		if value.Kind() == reflect.Map && typ.Elem().Kind() == reflect.Struct {
+			elem, err := naming.ConvertValue(value, typ.Elem())
+			if err != nil {
+				return reflect.ValueOf(nil), errors.Trace(err)
+			}
+			ret := reflect.New(typ.Elem())
+			ret.Elem().Set(elem)
+			return ret, nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		switch GetBaseKind(value) {
+		case reflect.Int64, reflect.Uint64, reflect.Float64:
+			return convertNumber(value, typ)
+		}
+	}
+	if value.Type().AssignableTo(typ) {
+		return value, nil
+	}
+	return reflect.ValueOf(nil), errors.Errorf("can not convert %s to %s", value.Type().String(), typ.String())
+}
+
+// convertNumber converts a number into a numeric type. It fails rather than losing the sign, the fraction or
+// the magnitude of the number. Other values are converted as they are.
+func convertNumber(value reflect.Value, typ reflect.Type) (reflect.Value, error) {
+	ret := reflect.New(typ).Elem()
+	kind := GetBaseKind(value)
+	switch GetBaseKind(ret) {
+	case reflect.Int64:
+		var i int64
+		switch kind {
+		case reflect.Int64:
+			i = value.Int()
+		case reflect.Uint64:
+			if value.Uint() > math.MaxInt64 {
+				return reflect.ValueOf(nil), errors.Errorf("%d is out of the range of %s", value.Uint(), typ.String())
+			}
+			i = int64(value.Uint())
+		case reflect.Float64:
+			f := value.Float()
+			if f != math.Trunc(f) {
+				return reflect.ValueOf(nil), errors.Errorf("can not convert %v to %s, it must be rounded first", f, typ.String())
+			}
+			if f < math.MinInt64 || f >= math.MaxInt64 {
+				return reflect.ValueOf(nil), errors.Errorf("%v is out of the range of %s", f, typ.String())
+			}
+			i = int64(f)
+		default:
+			return value.Convert(typ), nil
+		}
+		if ret.OverflowInt(i) {
+			return reflect.ValueOf(nil), errors.Errorf("%d is out of the range of %s", i, typ.String())
+		}
+		ret.SetInt(i)
+	case reflect.Uint64:
+		var u uint64
+		switch kind {
+		case reflect.Int64:
+			if value.Int() < 0 {
+				return reflect.ValueOf(nil), errors.Errorf("can not convert negative %d to %s", value.Int(), typ.String())
+			}
+			u = uint64(value.Int())
+		case reflect.Uint64:
+			u = value.Uint()
+		case reflect.Float64:
+			f := value.Float()
+			if f != math.Trunc(f) {
+				return reflect.ValueOf(nil), errors.Errorf("can not convert %v to %s, it must be rounded first", f, typ.String())
+			}
+			if f < 0 {
+				return reflect.ValueOf(nil), errors.Errorf("can not convert negative %v to %s", f, typ.String())
+			}
+			if f >= math.MaxUint64 {
+				return reflect.ValueOf(nil), errors.Errorf("%v is out of the range of %s", f, typ.String())
+			}
+			u = uint64(f)
+		default:
+			return value.Convert(typ), nil
+		}
+		if ret.OverflowUint(u) {
+			return reflect.ValueOf(nil), errors.Errorf("%d is out of the range of %s", u, typ.String())
+		}
+		ret.SetUint(u)
+	case reflect.Float64:
+		var f float64
+		switch kind {
+		case reflect.Int64:
+			f = float64(value.Int())
+		case reflect.Uint64:
+			f = float64(value.Uint())
+		case reflect.Float64:
+			f = value.Float()
+		default:
+			return value.Convert(typ), nil
+		}
+		if ret.OverflowFloat(f) {
+			return reflect.ValueOf(nil), errors.Errorf("%v is out of the range of %s", f, typ.String())
+		}
+		ret.SetFloat(f)
+	default:
+		return value.Convert(typ), nil
+	}
+	return ret, nil
+}
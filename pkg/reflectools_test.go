@@ -2,6 +2,7 @@ package pkg
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
@@ -289,6 +290,16 @@ func TestSetAttributeValue(t *testing.T) {
 		t.Errorf("Should not be able to set with different type")
 		t.FailNow()
 	}
+	err = SetAttributeInterface(testObject, "G", 200)
+	if err == nil {
+		t.Errorf("Should not be able to set 200 into int8")
+		t.FailNow()
+	}
+	err = SetAttributeValue(map[string]uint8{}, "small", reflect.ValueOf(uint64(300)))
+	if err == nil {
+		t.Errorf("Should not be able to set 300 into uint8")
+		t.FailNow()
+	}
 	if testObject.A != "strong data" && testObject.B != 456 {
 		t.Errorf("Setting string fail")
 		t.FailNow()
@@ -309,3 +320,153 @@ func TestSetAttributeValue(t *testing.T) {
 		t.FailNow()
 	}
 }
+
+func TestMapAttribute(t *testing.T) {
+	obj := map[string]interface{}{
+		"A": "string data",
+		"B": float64(123),
+		"C": nil,
+	}
+	if !IsMap(obj) || !IsMap(&obj) || IsMap(map[int]string{}) {
+		t.Errorf("map with string keys not detected")
+	}
+	val, err := GetAttributeValue(obj, "A")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if val.Kind() != reflect.String || val.String() != "string data" {
+		t.Errorf("expect string data but %v", val)
+	}
+	typ, err := GetAttributeType(&obj, "B")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if typ.Kind() != reflect.Float64 {
+		t.Errorf("expect float64 but %s", typ)
+	}
+	if _, err := GetAttributeValue(obj, "D"); err == nil {
+		t.Errorf("expect error getting missing key")
+	}
+	if err := SetAttributeInterface(obj, "D", int64(7)); err != nil {
+		t.Fatal(err)
+	}
+	if obj["D"] != int64(7) {
+		t.Errorf("expect new key set but %v", obj["D"])
+	}
+	if err := SetAttributeValue(obj, "B", reflect.ValueOf(big.NewRat(5, 2))); err != nil {
+		t.Fatal(err)
+	}
+	if obj["B"] != 2.5 {
+		t.Errorf("expect decimal set as float64 but %v", obj["B"])
+	}
+
+	counts := map[string]int{"A": 1}
+	if err := SetAttributeInterface(counts, "A", int64(2)); err != nil {
+		t.Fatal(err)
+	}
+	if counts["A"] != 2 {
+		t.Errorf("expect 2 but %d", counts["A"])
+	}
+	if err := SetAttributeInterface(counts, "A", "two"); err == nil {
+		t.Errorf("expect error setting string into int map")
+	}
+}
+
+func TestConvertValue(t *testing.T) {
+	val, err := ConvertValue(reflect.ValueOf([]int64{1, 2, 3}), reflect.TypeOf([]int{}))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+		t.FailNow()
+	}
+	if ints := val.Interface().([]int); len(ints) != 3 || ints[2] != 3 {
+		t.Errorf("Slice conversion fail %v", ints)
+	}
+
+	val, err = ConvertValue(reflect.ValueOf(map[string]int64{"a": 1}), reflect.TypeOf(map[string]float64{}))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+		t.FailNow()
+	}
+	if floats := val.Interface().(map[string]float64); floats["a"] != 1.0 {
+		t.Errorf("Map conversion fail %v", floats)
+	}
+
+	val, err = ConvertValue(reflect.ValueOf(map[string]interface{}{"A": "TSO", "B": int64(2019)}), reflect.TypeOf(&TestSubObject{}))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+		t.FailNow()
+	}
+	if tso := val.Interface().(*TestSubObject); tso.A != "TSO" || tso.B != 2019 {
+		t.Errorf("Struct conversion fail %v", tso)
+	}
+
+	_, err = ConvertValue(reflect.ValueOf([]string{"a"}), reflect.TypeOf([]int{}))
+	if err == nil {
+		t.Errorf("Should not be able to convert string into int")
+	}
+
+	val, err = ConvertValue(reflect.ValueOf([]interface{}{int64(255), 2.0}), reflect.TypeOf([]uint8{}))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+		t.FailNow()
+	}
+	if bytes := val.Interface().([]uint8); bytes[0] != 255 || bytes[1] != 2 {
+		t.Errorf("Slice conversion fail %v", bytes)
+	}
+	lossy := []struct {
+		value interface{}
+		typ   reflect.Type
+	}{
+		{value: []int64{300}, typ: reflect.TypeOf([]uint8{})},
+		{value: []int64{-129}, typ: reflect.TypeOf([]int8{})},
+		{value: []int64{-1}, typ: reflect.TypeOf([]uint{})},
+		{value: []uint64{1 << 63}, typ: reflect.TypeOf([]int64{})},
+		{value: []float64{1.5}, typ: reflect.TypeOf([]int{})},
+		{value: []float64{-2}, typ: reflect.TypeOf([]uint{})},
+		{value: []float64{1e20}, typ: reflect.TypeOf([]int64{})},
+		{value: []float64{1e40}, typ: reflect.TypeOf([]float32{})},
+	}
+	for _, td := range lossy {
+		if val, err = ConvertValue(reflect.ValueOf(td.value), td.typ); err == nil {
+			t.Errorf("Should not be able to convert %v into %s but got %v", td.value, td.typ, val)
+		}
+	}
+}
+
+func BenchmarkGetAttributeValue(b *testing.B) {
+	to := &TestObject{O: 10}
+	for i := 0; i < b.N; i++ {
+		if _, err := GetAttributeValue(to, "O"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSetAttributeValue(b *testing.B) {
+	to := &TestObject{}
+	val := reflect.ValueOf(uint64(10))
+	for i := 0; i < b.N; i++ {
+		if err := SetAttributeValue(to, "O", val); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkInvokeFunction(b *testing.B) {
+	to := &TestObject{}
+	param := []interface{}{10, "Ten"}
+	for i := 0; i < b.N; i++ {
+		if _, err := InvokeFunction(to, "FunctionC", param); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetFunctionParameterTypes(b *testing.B) {
+	to := &TestObject{}
+	for i := 0; i < b.N; i++ {
+		if _, err := GetFunctionParameterTypes(to, "FunctionC"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
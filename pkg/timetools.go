@@ -0,0 +1,74 @@
+package pkg
+
+import (
+	"github.com/juju/errors"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeLiteralLayouts = []string{
+		"2006-01-02T15:04:05Z07:00",
+		"2006-01-02T15:04:05",
+		"2006-01-02T15:04Z07:00",
+		"2006-01-02T15:04",
+		"2006-01-02",
+	}
+	durationLiteralPart = regexp.MustCompile(`([0-9]+)(ms|d|h|m|s)`)
+	durationLiteralUnit = map[string]time.Duration{
+		"d":  24 * time.Hour,
+		"h":  time.Hour,
+		"m":  time.Minute,
+		"s":  time.Second,
+		"ms": time.Millisecond,
+	}
+)
+
+// ParseTimeLiteral parses an ISO-8601 date or timestamp such as 2019-01-31 or 2019-01-31T10:30:00+07:00.
+// A timestamp without time zone is in the local time zone, just like the MakeTime function.
+func ParseTimeLiteral(literal string) (time.Time, error) {
+	for _, layout := range timeLiteralLayouts {
+		t, err := time.ParseInLocation(layout, literal, time.Local)
+		if err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.Errorf("invalid date time literal %s", literal)
+}
+
+// ParseDurationLiteral parses a duration made of numbers followed by unit d (day), h, m, s or ms, such as 30d or 2h15m.
+// The duration may be preceded with negative symbol -.
+func ParseDurationLiteral(literal string) (time.Duration, error) {
+	text := strings.TrimPrefix(literal, "-")
+	parts := durationLiteralPart.FindAllStringSubmatch(text, -1)
+	if len(parts) == 0 || strings.Join(flatten(parts), "") != text {
+		return 0, errors.Errorf("invalid duration literal %s", literal)
+	}
+	var dur time.Duration
+	for _, part := range parts {
+		n, err := strconv.ParseInt(part[1], 10, 64)
+		if err != nil {
+			return 0, errors.Errorf("invalid duration literal %s", literal)
+		}
+		unit := durationLiteralUnit[part[2]]
+		if n > math.MaxInt64/int64(unit) || dur > math.MaxInt64-time.Duration(n)*unit {
+			return 0, errors.Errorf("invalid duration literal %s", literal)
+		}
+		dur += time.Duration(n) * unit
+	}
+	if strings.HasPrefix(literal, "-") {
+		return -dur, nil
+	}
+	return dur, nil
+}
+
+func flatten(parts [][]string) []string {
+	ret := make([]string, len(parts))
+	for i, part := range parts {
+		ret[i] = part[0]
+	}
+	return ret
+}
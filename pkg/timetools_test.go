@@ -0,0 +1,48 @@
+package pkg
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDurationLiteral(t *testing.T) {
+	testData := []struct {
+		literal  string
+		duration time.Duration
+		valid    bool
+	}{
+		{"30d", 30 * 24 * time.Hour, true},
+		{"2h15m", 2*time.Hour + 15*time.Minute, true},
+		{"-500ms", -500 * time.Millisecond, true},
+		{"10x", 0, false},
+		{"106751d23h", 106751*24*time.Hour + 23*time.Hour, true},
+		{"999999d", 0, false},
+		{"106751d24h", 0, false},
+	}
+	for _, td := range testData {
+		dur, err := ParseDurationLiteral(td.literal)
+		if td.valid && (err != nil || dur != td.duration) {
+			t.Errorf("%s should be %v but %v, %v", td.literal, td.duration, dur, err)
+		}
+		if !td.valid && err == nil {
+			t.Errorf("%s should be invalid", td.literal)
+		}
+	}
+}
+
+func TestParseTimeLiteral(t *testing.T) {
+	tm, err := ParseTimeLiteral("2019-01-31T10:30:00+07:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !tm.Equal(time.Date(2019, 1, 31, 3, 30, 0, 0, time.UTC)) {
+		t.Errorf("time is not correct %v", tm)
+	}
+	tm, err = ParseTimeLiteral("2019-01-31")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !tm.Equal(time.Date(2019, 1, 31, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("date is not correct %v", tm)
+	}
+}
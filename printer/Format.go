@@ -0,0 +1,194 @@
+package printer
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"strings"
+)
+
+// Format parses the GRL source and renders it in the canonical GRL style, keeping its comments.
+// A comment stays in front of, or at the end of the line of, the rule, when expression or statement it was written with.
+func Format(source []byte) ([]byte, error) {
+	knowledgeBase := model.NewKnowledgeBase()
+	err := builder.NewRuleBuilder(knowledgeBase).BuildRuleFromResource(pkg.NewBytesResource(source))
+	if err != nil {
+		return nil, err
+	}
+	entries := sortedEntries(knowledgeBase)
+	p := newPrinter()
+	remaining := p.attachComments(entries, scanComments(string(source)))
+
+	for i, entry := range entries {
+		if i > 0 {
+			p.buf.WriteString("\n")
+		}
+		p.printRuleEntry(entry)
+	}
+	if len(remaining) > 0 {
+		if len(entries) > 0 {
+			p.buf.WriteString("\n")
+		}
+		for _, comment := range remaining {
+			p.line(nil, comment.text)
+		}
+	}
+	return p.buf.Bytes(), nil
+}
+
+// anchor is a node which comments can be attached to.
+type anchor struct {
+	line   int
+	column int
+	node   interface{}
+	entry  *model.RuleEntry
+}
+
+// attachComments attaches each comment to an anchor, and returns the comments written after the last rule.
+func (p *printer) attachComments(entries []*model.RuleEntry, comments []*comment) []*comment {
+	anchors := make([]*anchor, 0)
+	for _, entry := range entries {
+		anchors = append(anchors, &anchor{line: entry.Line, column: entry.Column, node: entry, entry: entry})
+		if entry.WhenScope != nil && entry.WhenScope.Expression != nil {
+			expr := entry.WhenScope.Expression
+			anchors = append(anchors, &anchor{line: expr.Line, column: expr.Column, node: expr, entry: entry})
+		}
+		if entry.ThenScope != nil {
+			anchors = appendStatementAnchors(anchors, entry, entry.ThenScope.AssignExpressions)
+		}
+	}
+
+	remaining := make([]*comment, 0)
+	for _, comment := range comments {
+		if !comment.ownLine {
+			// the comment ends a line, attach it to the last node starting on that line.
+			var last *anchor
+			for _, a := range anchors {
+				if a.line == comment.line && a.column < comment.column {
+					last = a
+				}
+			}
+			if last != nil {
+				if trailing, ok := p.trailing[last.node]; ok {
+					p.trailing[last.node] = trailing + " " + comment.text
+				} else {
+					p.trailing[last.node] = comment.text
+				}
+				continue
+			}
+		}
+		var next *anchor
+		for _, a := range anchors {
+			if a.line > comment.line || (a.line == comment.line && a.column > comment.column) {
+				next = a
+				break
+			}
+		}
+		// a comment at the end of a rule body stays inside the rule.
+		var enclosing *model.RuleEntry
+		for _, entry := range entries {
+			if entry.Line <= comment.line && comment.line <= entry.EndLine {
+				enclosing = entry
+			}
+		}
+		switch {
+		case enclosing != nil && (next == nil || next.entry != enclosing):
+			p.closing[enclosing] = append(p.closing[enclosing], comment.text)
+		case next != nil:
+			p.leading[next.node] = append(p.leading[next.node], comment.text)
+		default:
+			remaining = append(remaining, comment)
+		}
+	}
+	return remaining
+}
+
+func appendStatementAnchors(anchors []*anchor, entry *model.RuleEntry, assigns *model.AssignExpressions) []*anchor {
+	if assigns == nil {
+		return anchors
+	}
+	for _, ae := range assigns.ExpressionList {
+		anchors = append(anchors, &anchor{line: ae.Line, column: ae.Column, node: ae, entry: entry})
+		for ifStmt := ae.IfStatement; ifStmt != nil; ifStmt = ifStmt.ElseIfStatement {
+			anchors = appendStatementAnchors(anchors, entry, ifStmt.AssignExpressions)
+			anchors = appendStatementAnchors(anchors, entry, ifStmt.ElseAssignExpressions)
+		}
+		if ae.ForStatement != nil {
+			anchors = appendStatementAnchors(anchors, entry, ae.ForStatement.AssignExpressions)
+		}
+	}
+	return anchors
+}
+
+// comment is a comment found in the source. Column starts from 1.
+type comment struct {
+	line    int
+	column  int
+	text    string
+	ownLine bool
+}
+
+// scanComments finds the line and block comments of a GRL source, skipping the string literals.
+func scanComments(source string) []*comment {
+	comments := make([]*comment, 0)
+	runes := []rune(source)
+	line, column := 1, 1
+	ownLine := true
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r == '\n':
+			line, column, ownLine = line+1, 1, true
+			i++
+			continue
+		case r == '"' || r == '\'':
+			// skip the string literal, it can not span lines.
+			j := i + 1
+			for j < len(runes) && runes[j] != r && runes[j] != '\n' {
+				if runes[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j < len(runes) && runes[j] == r {
+				j++
+			}
+			column += j - i
+			i = j
+			ownLine = false
+			continue
+		case r == '/' && i+1 < len(runes) && (runes[i+1] == '/' || runes[i+1] == '*'):
+			j := i + 2
+			if runes[i+1] == '/' {
+				for j < len(runes) && runes[j] != '\n' {
+					j++
+				}
+			} else {
+				for j+1 < len(runes) && !(runes[j] == '*' && runes[j+1] == '/') {
+					j++
+				}
+				j = j + 2
+				if j > len(runes) {
+					j = len(runes)
+				}
+			}
+			text := strings.TrimRight(string(runes[i:j]), " \t\r")
+			comments = append(comments, &comment{line: line, column: column, text: text, ownLine: ownLine})
+			for _, c := range runes[i:j] {
+				if c == '\n' {
+					line, column = line+1, 1
+				} else {
+					column++
+				}
+			}
+			i = j
+			ownLine = false
+			continue
+		case r != ' ' && r != '\t' && r != '\r':
+			ownLine = false
+		}
+		column++
+		i++
+	}
+	return comments
+}
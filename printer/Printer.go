@@ -0,0 +1,270 @@
+package printer
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/newm4n/grool/model"
+	"sort"
+	"strings"
+)
+
+// Print renders the rule entry in the canonical GRL style.
+func Print(entry *model.RuleEntry) string {
+	p := newPrinter()
+	p.printRuleEntry(entry)
+	return p.buf.String()
+}
+
+// PrintKnowledgeBase renders all rule entries of the knowledge base in the canonical GRL style,
+// ordered by their position in the rule script, separated by an empty line.
+func PrintKnowledgeBase(knowledgeBase *model.KnowledgeBase) string {
+	p := newPrinter()
+	for i, entry := range sortedEntries(knowledgeBase) {
+		if i > 0 {
+			p.buf.WriteString("\n")
+		}
+		p.printRuleEntry(entry)
+	}
+	return p.buf.String()
+}
+
+func sortedEntries(knowledgeBase *model.KnowledgeBase) []*model.RuleEntry {
+	entries := make([]*model.RuleEntry, 0, len(knowledgeBase.RuleEntries))
+	for _, entry := range knowledgeBase.RuleEntries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Line != entries[j].Line {
+			return entries[i].Line < entries[j].Line
+		}
+		return entries[i].RuleName < entries[j].RuleName
+	})
+	return entries
+}
+
+// printer renders the graph, along with the comments attached to the rule entries, when expressions and statements.
+type printer struct {
+	buf      bytes.Buffer
+	indent   int
+	leading  map[interface{}][]string
+	trailing map[interface{}]string
+	closing  map[*model.RuleEntry][]string
+}
+
+func newPrinter() *printer {
+	return &printer{
+		leading:  make(map[interface{}][]string),
+		trailing: make(map[interface{}]string),
+		closing:  make(map[*model.RuleEntry][]string),
+	}
+}
+
+// line writes an indented line, followed by the trailing comment of the node.
+func (p *printer) line(node interface{}, text string) {
+	for _, comment := range p.leading[node] {
+		p.buf.WriteString(strings.Repeat("\t", p.indent) + comment + "\n")
+	}
+	p.buf.WriteString(strings.Repeat("\t", p.indent) + text)
+	if comment, ok := p.trailing[node]; ok {
+		p.buf.WriteString(" " + comment)
+	}
+	p.buf.WriteString("\n")
+}
+
+func (p *printer) printRuleEntry(entry *model.RuleEntry) {
+	header := "rule " + entry.RuleName
+	if len(entry.RuleDescription) > 0 {
+		header += " " + entry.RuleDescription
+	}
+	if entry.Salience != 0 {
+		header += fmt.Sprintf(" salience %d", entry.Salience)
+	}
+	p.line(entry, header+" {")
+	p.indent++
+	p.line(nil, "when")
+	p.indent++
+	if entry.WhenScope != nil && entry.WhenScope.Expression != nil {
+		p.line(entry.WhenScope.Expression, expressionString(entry.WhenScope.Expression, false))
+	}
+	p.indent--
+	p.line(nil, "then")
+	p.indent++
+	if entry.ThenScope != nil {
+		p.printAssignExpressions(entry.ThenScope.AssignExpressions)
+	}
+	for _, comment := range p.closing[entry] {
+		p.line(nil, comment)
+	}
+	p.indent -= 2
+	p.line(nil, "}")
+}
+
+func (p *printer) printAssignExpressions(assigns *model.AssignExpressions) {
+	if assigns == nil {
+		return
+	}
+	for _, ae := range assigns.ExpressionList {
+		switch {
+		case ae.Assignment != nil:
+			p.line(ae, ae.Assignment.Variable+" = "+expressionString(ae.Assignment.Expression, false)+";")
+		case ae.FunctionCall != nil:
+			p.line(ae, functionCallString(ae.FunctionCall)+";")
+		case ae.MethodCall != nil:
+			p.line(ae, methodCallString(ae.MethodCall)+";")
+		case ae.Selector != nil:
+			p.line(ae, atomString(ae.ExpressionAtom)+selectorString(ae.Selector)+";")
+		case ae.IfStatement != nil:
+			p.printIfStatement(ae, ae.IfStatement)
+		case ae.ForStatement != nil:
+			p.line(ae, fmt.Sprintf("for %s in %s {", ae.ForStatement.LoopVariable, ae.ForStatement.Variable))
+			p.indent++
+			p.printAssignExpressions(ae.ForStatement.AssignExpressions)
+			p.indent--
+			p.line(nil, "}")
+		}
+	}
+}
+
+func (p *printer) printIfStatement(ae *model.AssignExpression, ifStmt *model.IfStatement) {
+	p.line(ae, "if ("+expressionString(ifStmt.Expression, false)+") {")
+	for {
+		p.indent++
+		p.printAssignExpressions(ifStmt.AssignExpressions)
+		p.indent--
+		if ifStmt.ElseIfStatement != nil {
+			ifStmt = ifStmt.ElseIfStatement
+			p.line(nil, "} else if ("+expressionString(ifStmt.Expression, false)+") {")
+			continue
+		}
+		if ifStmt.ElseAssignExpressions != nil {
+			p.line(nil, "} else {")
+			p.indent++
+			p.printAssignExpressions(ifStmt.ElseAssignExpressions)
+			p.indent--
+		}
+		p.line(nil, "}")
+		return
+	}
+}
+
+// expressionString renders an expression, a nested logical expression is parenthesized.
+func expressionString(expr *model.Expression, nested bool) string {
+	if expr.Predicate != nil {
+		return predicateString(expr.Predicate)
+	}
+	if expr.Conditional {
+		return fmt.Sprintf("%s ? %s : %s", expressionString(expr.ConditionExpression, true),
+			expressionString(expr.LeftExpression, true), expressionString(expr.RightExpression, true))
+	}
+	op := "&&"
+	if expr.LogicalOperator == model.LogicalOperatorOr {
+		op = "||"
+	}
+	text := fmt.Sprintf("%s %s %s", expressionString(expr.LeftExpression, true), op, expressionString(expr.RightExpression, true))
+	if nested {
+		return "(" + text + ")"
+	}
+	return text
+}
+
+func predicateString(prdct *model.Predicate) string {
+	switch {
+	case prdct.ComparisonOperator == model.ComparisonOperatorIn || prdct.ComparisonOperator == model.ComparisonOperatorNotIn:
+		items := make([]string, len(prdct.ExpressionAtomList))
+		for i, atom := range prdct.ExpressionAtomList {
+			items[i] = atomString(atom)
+		}
+		return fmt.Sprintf("%s %s (%s)", atomString(prdct.ExpressionAtomLeft), prdct.ComparisonOperator, strings.Join(items, ", "))
+	case prdct.ExpressionAtomRight == nil:
+		return atomString(prdct.ExpressionAtomLeft)
+	default:
+		return fmt.Sprintf("%s %s %s", atomString(prdct.ExpressionAtomLeft), prdct.ComparisonOperator, atomString(prdct.ExpressionAtomRight))
+	}
+}
+
+// atomString renders an expression atom, every math operation is parenthesized as a chain of them is evaluated
+// from left to right, regardless of the operators.
+func atomString(atom *model.ExpressionAtom) string {
+	switch {
+	case len(atom.Variable) > 0:
+		return atom.Variable
+	case atom.Constant != nil:
+		return constantString(atom.Constant)
+	case atom.FunctionCall != nil:
+		return functionCallString(atom.FunctionCall)
+	case atom.MethodCall != nil:
+		return methodCallString(atom.MethodCall)
+	case atom.Selector != nil:
+		return atomString(atom.ExpressionAtomLeft) + selectorString(atom.Selector)
+	case atom.NullCoalescing:
+		return atomString(atom.ExpressionAtomLeft) + " ?? " + atomString(atom.ExpressionAtomRight)
+	default:
+		return fmt.Sprintf("(%s %s %s)", atomString(atom.ExpressionAtomLeft), atom.MathOperator, atomString(atom.ExpressionAtomRight))
+	}
+}
+
+func selectorString(sel *model.Selector) string {
+	text := "." + sel.Name
+	if sel.NullSafe {
+		text = "?" + text
+	}
+	if sel.MethodCall {
+		text += "(" + argumentsString(sel.MethodArguments) + ")"
+	}
+	return text
+}
+
+func functionCallString(funcCall *model.FunctionCall) string {
+	return funcCall.FunctionName + "(" + argumentsString(funcCall.FunctionArguments) + ")"
+}
+
+func methodCallString(methCall *model.MethodCall) string {
+	return methCall.MethodName + "(" + argumentsString(methCall.MethodArguments) + ")"
+}
+
+func argumentsString(funcArg *model.FunctionArgument) string {
+	if funcArg == nil {
+		return ""
+	}
+	args := make([]string, len(funcArg.Arguments))
+	for i, arg := range funcArg.Arguments {
+		switch {
+		case len(arg.Variable) > 0:
+			args[i] = arg.Variable
+		case arg.Constant != nil:
+			args[i] = constantString(arg.Constant)
+		case arg.FunctionCall != nil:
+			args[i] = functionCallString(arg.FunctionCall)
+		case arg.MethodCall != nil:
+			args[i] = methodCallString(arg.MethodCall)
+		case arg.Expression != nil:
+			args[i] = expressionString(arg.Expression, false)
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+func constantString(cons *model.Constant) string {
+	switch cons.ConstantKind {
+	case model.ConstantKindList:
+		items := make([]string, len(cons.Values))
+		for i, val := range cons.Values {
+			items[i] = constantString(val)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case model.ConstantKindMap:
+		items := make([]string, len(cons.Values))
+		for i, val := range cons.Values {
+			items[i] = constantString(cons.Keys[i]) + ": " + constantString(val)
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	case model.ConstantKindStruct:
+		items := make([]string, len(cons.Values))
+		for i, val := range cons.Values {
+			items[i] = cons.FieldNames[i] + ": " + constantString(val)
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	default:
+		return cons.Text
+	}
+}
@@ -0,0 +1,119 @@
+package printer
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"testing"
+)
+
+const (
+	messyRule = `// pricing rules
+rule  Discount   "apply discount" salience 10 {
+  when
+     Cart.Total > 100 &&   (Cart.Member == true || Cart.Total>1000) // big carts
+  then
+    // members get 10%
+    Cart.Discount = Cart.Total * 0.1 - 1;
+    if (Cart.Discount > 50) { Cart.Discount = 50; } else { Cart.Note = "ok // not a comment"; }
+    for item in Cart.Items { item.Tax = item.Price * (0.2 + 0.1); }
+    Retract("Discount");
+    /* end of then */
+}
+rule Other "" { when Cart.Code in ("A","B") then Cart.M = {a: 1, b: [1,2]}; Cart.X = Cart.A ?? Cart.B + 2; }
+// the end
+`
+	canonicalRule = `// pricing rules
+rule Discount "apply discount" salience 10 {
+	when
+		Cart.Total > 100 && (Cart.Member == true || Cart.Total > 1000) // big carts
+	then
+		// members get 10%
+		Cart.Discount = ((Cart.Total * 0.1) - 1);
+		if (Cart.Discount > 50) {
+			Cart.Discount = 50;
+		} else {
+			Cart.Note = "ok // not a comment";
+		}
+		for item in Cart.Items {
+			item.Tax = (item.Price * (0.2 + 0.1));
+		}
+		Retract("Discount");
+		/* end of then */
+}
+
+rule Other "" {
+	when
+		Cart.Code in ("A", "B")
+	then
+		Cart.M = {a: 1, b: [1, 2]};
+		Cart.X = Cart.A ?? (Cart.B + 2);
+}
+
+// the end
+`
+)
+
+func TestFormat(t *testing.T) {
+	formatted, err := Format([]byte(messyRule))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(formatted) != canonicalRule {
+		t.Errorf("expect\n%s\nbut\n%s", canonicalRule, formatted)
+	}
+	again, err := Format(formatted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(again) != string(formatted) {
+		t.Errorf("expect formatting to be idempotent but\n%s", again)
+	}
+}
+
+func TestFormat_SyntaxError(t *testing.T) {
+	if _, err := Format([]byte(`rule A "" { when Cart.Total > then Cart.Total = 1; }`)); err == nil {
+		t.Error("expect syntax error")
+	}
+}
+
+func TestPrintKnowledgeBase(t *testing.T) {
+	knowledgeBase := model.NewKnowledgeBase()
+	err := builder.NewRuleBuilder(knowledgeBase).BuildRuleFromResource(pkg.NewBytesResource([]byte(messyRule)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	printed := PrintKnowledgeBase(knowledgeBase)
+
+	// the printed rules build into an equal knowledge base.
+	reloaded := model.NewKnowledgeBase()
+	err = builder.NewRuleBuilder(reloaded).BuildRuleFromResource(pkg.NewBytesResource([]byte(printed)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if PrintKnowledgeBase(reloaded) != printed {
+		t.Errorf("expect the printed rules to be stable but\n%s", PrintKnowledgeBase(reloaded))
+	}
+	if Print(reloaded.RuleEntries["Other"]) != Print(knowledgeBase.RuleEntries["Other"]) {
+		t.Error("expect the same rule printed")
+	}
+}
+
+func TestPrint_MathChain(t *testing.T) {
+	rule := `rule Chain "" { when Cart.A + Cart.B * Cart.C > Cart.D - (Cart.E / 2) then Cart.X = Cart.A * 2 + Cart.B - 1; }`
+	knowledgeBase := model.NewKnowledgeBase()
+	err := builder.NewRuleBuilder(knowledgeBase).BuildRuleFromResource(pkg.NewBytesResource([]byte(rule)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := `rule Chain "" {
+	when
+		((Cart.A + Cart.B) * Cart.C) > (Cart.D - (Cart.E / 2))
+	then
+		Cart.X = (((Cart.A * 2) + Cart.B) - 1);
+}
+`
+	if printed := Print(knowledgeBase.RuleEntries["Chain"]); printed != expect {
+		t.Errorf("expect every math operation parenthesized\n%s\nbut\n%s", expect, printed)
+	}
+}
@@ -0,0 +1,499 @@
+package vm
+
+import (
+	"github.com/juju/errors"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/model"
+	"math/big"
+	"reflect"
+)
+
+// Program is a knowledge base compiled into bytecode.
+type Program struct {
+	Rules map[string]*Rule
+}
+
+// Rule is the compiled "when" expression and "then" statements of a rule entry.
+type Rule struct {
+	Entry *model.RuleEntry
+	When  *Code
+	Then  *Code
+}
+
+// ProgramOf returns the knowledge base compiled into bytecode, cached using its SetCompiled. It is compiled on first
+// use, and compiled again once the cache is cleared or the rule entries are not those it was compiled from.
+func ProgramOf(knowledge *model.KnowledgeBase) (*Program, error) {
+	if program, ok := knowledge.Compiled().(*Program); ok && program.compiledFrom(knowledge) {
+		return program, nil
+	}
+	program, err := Compile(knowledge)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	knowledge.SetCompiled(program)
+	return program, nil
+}
+
+// compiledFrom checks if the program holds a rule for each of the rule entries of the knowledge base, and no other.
+func (program *Program) compiledFrom(knowledge *model.KnowledgeBase) bool {
+	if len(program.Rules) != len(knowledge.RuleEntries) {
+		return false
+	}
+	for name, entry := range knowledge.RuleEntries {
+		if rule, ok := program.Rules[name]; !ok || rule.Entry != entry {
+			return false
+		}
+	}
+	return true
+}
+
+// Compile lowers every rule entry of the knowledge base into bytecode.
+// The compiled rules refer to the rule graph nodes, which must be initialized before the rules are run.
+func Compile(knowledge *model.KnowledgeBase) (*Program, error) {
+	program := &Program{
+		Rules: make(map[string]*Rule, len(knowledge.RuleEntries)),
+	}
+	for name, entry := range knowledge.RuleEntries {
+		rule, err := CompileRule(knowledge, entry)
+		if err != nil {
+			return nil, errors.Annotatef(err, "rule %s", name)
+		}
+		program.Rules[name] = rule
+	}
+	return program, nil
+}
+
+// CompileRule lowers a single rule entry of the knowledge base into bytecode. The math operations and comparisons
+// which operand types are known from the declared facts of the knowledge base use the typed opcodes.
+func CompileRule(knowledge *model.KnowledgeBase, entry *model.RuleEntry) (*Rule, error) {
+	if entry.WhenScope == nil || entry.WhenScope.Expression == nil {
+		return nil, errors.Errorf("rule has no when scope")
+	}
+	when := newCompiler(knowledge)
+	if err := when.expression(entry.WhenScope.Expression); err != nil {
+		return nil, errors.Trace(err)
+	}
+	then := newCompiler(knowledge)
+	if entry.ThenScope != nil {
+		if err := then.assignExpressions(entry.ThenScope.AssignExpressions); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return &Rule{
+		Entry: entry,
+		When:  when.code,
+		Then:  then.code,
+	}, nil
+}
+
+type compiler struct {
+	knowledge     *model.KnowledgeBase
+	loopVariables []string
+	code          *Code
+	paths         map[string]int
+}
+
+func newCompiler(knowledge *model.KnowledgeBase) *compiler {
+	return &compiler{
+		knowledge: knowledge,
+		code:      &Code{},
+		paths:     make(map[string]int),
+	}
+}
+
+// emit appends an instruction and returns its position.
+func (c *compiler) emit(op Opcode, a, b int) int {
+	c.code.Instructions = append(c.code.Instructions, Instruction{Op: op, A: a, B: b})
+	return len(c.code.Instructions) - 1
+}
+
+// patch sets the jump target of the instruction at pos to the next instruction.
+func (c *compiler) patch(pos int) {
+	c.code.Instructions[pos].A = len(c.code.Instructions)
+}
+
+func (c *compiler) path(variable string) int {
+	if idx, ok := c.paths[variable]; ok {
+		return idx
+	}
+	c.code.Paths = append(c.code.Paths, context.NewPath(variable))
+	c.paths[variable] = len(c.code.Paths) - 1
+	return c.paths[variable]
+}
+
+func (c *compiler) name(name string) int {
+	c.code.Names = append(c.code.Names, name)
+	return len(c.code.Names) - 1
+}
+
+func (c *compiler) message(msg string) int {
+	c.code.Messages = append(c.code.Messages, msg)
+	return len(c.code.Messages) - 1
+}
+
+func (c *compiler) expression(expr *model.Expression) error {
+	if expr.Predicate != nil {
+		return c.predicate(expr.Predicate)
+	}
+	if expr.Conditional {
+		if err := c.expression(expr.ConditionExpression); err != nil {
+			return errors.Trace(err)
+		}
+		jumpElse := c.emit(OpJumpIfFalse, 0, c.message("condition of conditional expression must be a boolean expression"))
+		if err := c.expression(expr.LeftExpression); err != nil {
+			return errors.Trace(err)
+		}
+		jumpEnd := c.emit(OpJump, 0, 0)
+		c.patch(jumpElse)
+		if err := c.expression(expr.RightExpression); err != nil {
+			return errors.Trace(err)
+		}
+		c.patch(jumpEnd)
+		return nil
+	}
+	if expr.LeftExpression == nil || expr.RightExpression == nil {
+		return errors.Errorf("expression %s has no predicate nor operands", expr.Text)
+	}
+	if err := c.expression(expr.LeftExpression); err != nil {
+		return errors.Trace(err)
+	}
+	if err := c.expression(expr.RightExpression); err != nil {
+		return errors.Trace(err)
+	}
+	if expr.LogicalOperator == model.LogicalOperatorAnd {
+		c.emit(OpAnd, 0, 0)
+	} else {
+		c.emit(OpOr, 0, 0)
+	}
+	return nil
+}
+
+func (c *compiler) predicate(prdct *model.Predicate) error {
+	if err := c.expressionAtom(prdct.ExpressionAtomLeft); err != nil {
+		return errors.Trace(err)
+	}
+	if prdct.ComparisonOperator == model.ComparisonOperatorIn || prdct.ComparisonOperator == model.ComparisonOperatorNotIn {
+		negate := 0
+		if prdct.ComparisonOperator == model.ComparisonOperatorNotIn {
+			negate = 1
+		}
+		found := make([]int, 0, len(prdct.ExpressionAtomList))
+		for _, exprAtom := range prdct.ExpressionAtomList {
+			if err := c.expressionAtom(exprAtom); err != nil {
+				return errors.Trace(err)
+			}
+			found = append(found, c.emit(OpIn, 0, negate))
+		}
+		c.emit(OpNotFound, 0, negate)
+		for _, pos := range found {
+			c.patch(pos)
+		}
+		return nil
+	}
+	if prdct.ExpressionAtomRight == nil {
+		return nil
+	}
+	if err := c.expressionAtom(prdct.ExpressionAtomRight); err != nil {
+		return errors.Trace(err)
+	}
+	c.code.Predicates = append(c.code.Predicates, prdct)
+	op := compareOpcode(prdct.ComparisonOperator, c.kind(prdct.ExpressionAtomLeft), c.kind(prdct.ExpressionAtomRight))
+	c.emit(op, len(c.code.Predicates)-1, 0)
+	return nil
+}
+
+func (c *compiler) expressionAtom(exprAtm *model.ExpressionAtom) error {
+	if exprAtm == nil {
+		return errors.Errorf("missing expression atom")
+	}
+	switch {
+	case len(exprAtm.Variable) > 0:
+		c.emit(OpLoad, c.path(exprAtm.Variable), 0)
+	case exprAtm.Constant != nil:
+		return c.constant(exprAtm.Constant)
+	case exprAtm.FunctionCall != nil:
+		return c.functionCall(exprAtm.FunctionCall)
+	case exprAtm.MethodCall != nil:
+		return c.methodCall(exprAtm.MethodCall)
+	case exprAtm.Selector != nil:
+		if err := c.expressionAtom(exprAtm.ExpressionAtomLeft); err != nil {
+			return errors.Trace(err)
+		}
+		return c.selector(exprAtm.Selector)
+	case exprAtm.NullCoalescing:
+		if err := c.expressionAtom(exprAtm.ExpressionAtomLeft); err != nil {
+			return errors.Trace(err)
+		}
+		jumpEnd := c.emit(OpJumpIfNotNil, 0, 0)
+		if err := c.expressionAtom(exprAtm.ExpressionAtomRight); err != nil {
+			return errors.Trace(err)
+		}
+		c.patch(jumpEnd)
+	default:
+		if err := c.expressionAtom(exprAtm.ExpressionAtomLeft); err != nil {
+			return errors.Trace(err)
+		}
+		if err := c.expressionAtom(exprAtm.ExpressionAtomRight); err != nil {
+			return errors.Trace(err)
+		}
+		op := mathOpcode(exprAtm.MathOperator, c.kind(exprAtm.ExpressionAtomLeft), c.kind(exprAtm.ExpressionAtomRight))
+		c.emit(op, int(exprAtm.MathOperator), 0)
+	}
+	return nil
+}
+
+// kind returns the kind of the expression atom type known from the declared facts, reflect.Int64 for any signed
+// integer but time.Duration, reflect.Float64 for any float, reflect.String or reflect.Bool, or reflect.Invalid.
+func (c *compiler) kind(exprAtm *model.ExpressionAtom) reflect.Kind {
+	typ := c.knowledge.ExpressionAtomType(exprAtm, c.loopVariables...)
+	if typ == nil || typ == durationType {
+		return reflect.Invalid
+	}
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.Int64
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	case reflect.String, reflect.Bool:
+		return typ.Kind()
+	}
+	return reflect.Invalid
+}
+
+// mathOpcode selects the typed opcode of an arithmetic operation between operands of the same kind.
+func mathOpcode(op model.MathOperator, left, right reflect.Kind) Opcode {
+	if left != right {
+		return OpMath
+	}
+	switch op {
+	case model.MathOperatorPlus, model.MathOperatorMinus, model.MathOperatorMul, model.MathOperatorDiv:
+	default:
+		return OpMath
+	}
+	switch left {
+	case reflect.Int64:
+		return OpMathInt
+	case reflect.Float64:
+		return OpMathFloat
+	case reflect.String:
+		if op == model.MathOperatorPlus {
+			return OpConcat
+		}
+	}
+	return OpMath
+}
+
+// compareOpcode selects the typed opcode of a comparison between operands of the same kind.
+func compareOpcode(op model.ComparisonOperator, left, right reflect.Kind) Opcode {
+	if left != right {
+		return OpCompare
+	}
+	switch op {
+	case model.ComparisonOperatorEQ, model.ComparisonOperatorNEQ:
+	case model.ComparisonOperatorGT, model.ComparisonOperatorGTE, model.ComparisonOperatorLT, model.ComparisonOperatorLTE:
+		// booleans are not ordered.
+		if left == reflect.Bool {
+			return OpCompare
+		}
+	default:
+		return OpCompare
+	}
+	switch left {
+	case reflect.Int64:
+		return OpCompareInt
+	case reflect.Float64:
+		return OpCompareFloat
+	case reflect.String:
+		return OpCompareString
+	case reflect.Bool:
+		return OpCompareBool
+	}
+	return OpCompare
+}
+
+func (c *compiler) constant(cons *model.Constant) error {
+	switch cons.ConstantKind {
+	case model.ConstantKindList, model.ConstantKindMap, model.ConstantKindStruct:
+		for _, key := range cons.Keys {
+			if err := c.constant(key); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		for _, val := range cons.Values {
+			if err := c.constant(val); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		c.code.Constants = append(c.code.Constants, cons)
+		c.emit(OpCompose, len(c.code.Constants)-1, len(cons.Keys))
+		return nil
+	}
+	c.code.Consts = append(c.code.Consts, cons.ConstantValue)
+	c.code.values = append(c.code.values, newValue(cons.ConstantValue))
+	c.code.decimals = append(c.code.decimals, nil)
+	idx := len(c.code.Consts) - 1
+	if cons.ConstantValue.Kind() == reflect.Float64 {
+		// a folded constant knows its exact decimal, which may differ from the decimal of its float value.
+		c.code.decimals[idx] = cons.Decimal()
+		c.emit(OpReal, idx, 0)
+		return nil
+	}
+	c.emit(OpConst, idx, 0)
+	return nil
+}
+
+func (c *compiler) arguments(funcArg *model.FunctionArgument) (int, error) {
+	if funcArg == nil {
+		return 0, nil
+	}
+	for _, arg := range funcArg.Arguments {
+		var err error
+		switch {
+		case len(arg.Variable) > 0:
+			c.emit(OpLoad, c.path(arg.Variable), 0)
+		case arg.Constant != nil:
+			err = c.constant(arg.Constant)
+		case arg.FunctionCall != nil:
+			err = c.functionCall(arg.FunctionCall)
+		case arg.MethodCall != nil:
+			err = c.methodCall(arg.MethodCall)
+		case arg.Expression != nil:
+			err = c.expression(arg.Expression)
+		default:
+			err = errors.Errorf("argument holder stores no value")
+		}
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+	}
+	return len(funcArg.Arguments), nil
+}
+
+func (c *compiler) functionCall(funcCall *model.FunctionCall) error {
+	argc, err := c.arguments(funcCall.FunctionArguments)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	c.emit(OpCall, c.path("DEFUNC."+funcCall.FunctionName), argc)
+	return nil
+}
+
+func (c *compiler) methodCall(methCall *model.MethodCall) error {
+	argc, err := c.arguments(methCall.MethodArguments)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	c.emit(OpCall, c.path(methCall.MethodName), argc)
+	return nil
+}
+
+// selector compiles the member access or method call on the receiver already on the stack.
+func (c *compiler) selector(sel *model.Selector) error {
+	jumpEnd := -1
+	if sel.NullSafe {
+		jumpEnd = c.emit(OpJumpIfNil, 0, 0)
+	}
+	if sel.MethodCall {
+		argc, err := c.arguments(sel.MethodArguments)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		c.emit(OpCallMember, c.name(sel.Name), argc)
+	} else {
+		c.emit(OpMember, c.name(sel.Name), 0)
+	}
+	if jumpEnd >= 0 {
+		c.patch(jumpEnd)
+	}
+	return nil
+}
+
+func (c *compiler) assignExpressions(assigns *model.AssignExpressions) error {
+	if assigns == nil {
+		return nil
+	}
+	for _, ae := range assigns.ExpressionList {
+		if err := c.assignExpression(ae); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func (c *compiler) assignExpression(ae *model.AssignExpression) error {
+	var err error
+	switch {
+	case ae.Assignment != nil:
+		if err = c.expression(ae.Assignment.Expression); err == nil {
+			c.emit(OpStore, c.path(ae.Assignment.Variable), 0)
+		}
+	case ae.FunctionCall != nil:
+		if err = c.functionCall(ae.FunctionCall); err == nil {
+			c.emit(OpPop, 0, 0)
+		}
+	case ae.MethodCall != nil:
+		if err = c.methodCall(ae.MethodCall); err == nil {
+			c.emit(OpPop, 0, 0)
+		}
+	case ae.IfStatement != nil:
+		err = c.ifStatement(ae.IfStatement)
+	case ae.ForStatement != nil:
+		err = c.forStatement(ae.ForStatement)
+	case ae.Selector != nil:
+		if err = c.expressionAtom(ae.ExpressionAtom); err == nil {
+			if err = c.selector(ae.Selector); err == nil {
+				c.emit(OpPop, 0, 0)
+			}
+		}
+	default:
+		err = errors.Errorf("no assignment, function, method call, if or for statement to evaluate")
+	}
+	return errors.Trace(err)
+}
+
+func (c *compiler) ifStatement(ifStmt *model.IfStatement) error {
+	if err := c.expression(ifStmt.Expression); err != nil {
+		return errors.Trace(err)
+	}
+	jumpElse := c.emit(OpJumpIfFalse, 0, c.message("if statement condition must be a boolean expression"))
+	if err := c.assignExpressions(ifStmt.AssignExpressions); err != nil {
+		return errors.Trace(err)
+	}
+	if ifStmt.ElseIfStatement == nil && ifStmt.ElseAssignExpressions == nil {
+		c.patch(jumpElse)
+		return nil
+	}
+	jumpEnd := c.emit(OpJump, 0, 0)
+	c.patch(jumpElse)
+	var err error
+	if ifStmt.ElseIfStatement != nil {
+		err = c.ifStatement(ifStmt.ElseIfStatement)
+	} else {
+		err = c.assignExpressions(ifStmt.ElseAssignExpressions)
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	c.patch(jumpEnd)
+	return nil
+}
+
+func (c *compiler) forStatement(forStmt *model.ForStatement) error {
+	c.emit(OpLoad, c.path(forStmt.Variable), 0)
+	c.code.Loops = append(c.code.Loops, forStmt)
+	c.emit(OpLoopStart, len(c.code.Loops)-1, 0)
+	next := c.emit(OpLoopNext, 0, 0)
+	c.loopVariables = append(c.loopVariables, forStmt.LoopVariable)
+	if err := c.assignExpressions(forStmt.AssignExpressions); err != nil {
+		return errors.Trace(err)
+	}
+	c.loopVariables = c.loopVariables[:len(c.loopVariables)-1]
+	c.emit(OpJump, next, 0)
+	c.patch(next)
+	return nil
+}
+
+// decimal returns a copy of the exact decimal of the real constant, so arithmetic can never alter the constant.
+func (code *Code) decimal(idx int) *big.Rat {
+	return new(big.Rat).Set(code.decimals[idx])
+}
@@ -0,0 +1,162 @@
+package vm
+
+import (
+	"github.com/newm4n/grool/builder"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"testing"
+)
+
+const (
+	testRule = `
+rule Discount "Discount the big orders" {
+	when
+		Order.Total > 100 && Order.Discount == 0
+	then
+		Order.Discount = Order.Member ? 10 : 5;
+}
+`
+)
+
+type TestOrder struct {
+	Total    int
+	Member   bool
+	Discount int
+}
+
+func TestCompile(t *testing.T) {
+	knowledgeBase := model.NewKnowledgeBase()
+	if err := builder.NewRuleBuilder(knowledgeBase).BuildRuleFromResource(pkg.NewBytesResource([]byte(testRule))); err != nil {
+		t.Fatal(err)
+	}
+	program, err := Compile(knowledgeBase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rule := program.Rules["Discount"]
+	when := `0000 LOAD         Order.Total
+0001 CONST        100
+0002 COMPARE      >
+0003 LOAD         Order.Discount
+0004 CONST        0
+0005 COMPARE      ==
+0006 AND
+`
+	if rule.When.String() != when {
+		t.Errorf("expect when\n%s\nbut\n%s", when, rule.When.String())
+	}
+	then := `0000 LOAD         Order.Member
+0001 JUMPIFFALSE  0004
+0002 CONST        10
+0003 JUMP         0005
+0004 CONST        5
+0005 STORE        Order.Discount
+`
+	if rule.Then.String() != then {
+		t.Errorf("expect then\n%s\nbut\n%s", then, rule.Then.String())
+	}
+
+	order := &TestOrder{Total: 150, Member: true}
+	dataContext := context.NewDataContext()
+	if err := dataContext.Add("Order", order); err != nil {
+		t.Fatal(err)
+	}
+	kctx := &context.KnowledgeContext{}
+	rule.Entry.Initialize(kctx, &context.RuleContext{}, dataContext)
+	machine := NewMachine(kctx, dataContext)
+	can, err := machine.CanExecute(rule)
+	if err != nil || !can {
+		t.Fatalf("rule should be executable, got %v %v", can, err)
+	}
+	if err := machine.Execute(rule); err != nil {
+		t.Fatal(err)
+	}
+	if order.Discount != 10 {
+		t.Errorf("discount should be 10 but %d", order.Discount)
+	}
+	if can, err := machine.CanExecute(rule); err != nil || can {
+		t.Errorf("rule should no longer be executable, got %v %v", can, err)
+	}
+}
+
+func TestCompile_Typed(t *testing.T) {
+	knowledgeBase := model.NewKnowledgeBase()
+	if err := knowledgeBase.DeclareFact("Order", &TestOrder{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := builder.NewRuleBuilder(knowledgeBase).BuildRuleFromResource(pkg.NewBytesResource([]byte(testRule))); err != nil {
+		t.Fatal(err)
+	}
+	program, err := Compile(knowledgeBase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	when := `0000 LOAD         Order.Total
+0001 CONST        100
+0002 COMPAREINT   >
+0003 LOAD         Order.Discount
+0004 CONST        0
+0005 COMPAREINT   ==
+0006 AND
+`
+	if program.Rules["Discount"].When.String() != when {
+		t.Errorf("expect when\n%s\nbut\n%s", when, program.Rules["Discount"].When.String())
+	}
+}
+
+func TestProgramOf(t *testing.T) {
+	knowledgeBase := model.NewKnowledgeBase()
+	ruleBuilder := builder.NewRuleBuilder(knowledgeBase)
+	if err := ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(testRule))); err != nil {
+		t.Fatal(err)
+	}
+	program, err := ProgramOf(knowledgeBase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cached, _ := ProgramOf(knowledgeBase); cached != program {
+		t.Errorf("program should be compiled once")
+	}
+
+	otherRule := `
+rule Member "Flag the members" {
+	when
+		Order.Member == false
+	then
+		Order.Member = true;
+}
+`
+	if err := ruleBuilder.BuildRuleFromResource(pkg.NewBytesResource([]byte(otherRule))); err != nil {
+		t.Fatal(err)
+	}
+	compiled, err := ProgramOf(knowledgeBase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if compiled == program || len(compiled.Rules) != 2 {
+		t.Errorf("program should be compiled again with the added rule")
+	}
+
+	if err := knowledgeBase.DeclareFact("Order", &TestOrder{}); err != nil {
+		t.Fatal(err)
+	}
+	if knowledgeBase.Compiled() != nil {
+		t.Errorf("declaring a fact should clear the compiled program")
+	}
+	typed, err := ProgramOf(knowledgeBase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if typed.Rules["Member"].When.Instructions[2].Op != OpCompareBool {
+		t.Errorf("program should be compiled again using the declared facts\n%s", typed.Rules["Member"].When.String())
+	}
+
+	knowledgeBase.JSONTags = true
+	if knowledgeBase.Compiled() != nil {
+		t.Errorf("changing JSONTags should clear the compiled program")
+	}
+	if tagged, _ := ProgramOf(knowledgeBase); tagged == typed {
+		t.Errorf("program should be compiled again using the json tags")
+	}
+}
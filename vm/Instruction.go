@@ -0,0 +1,171 @@
+package vm
+
+import (
+	"fmt"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/model"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+// Opcode is the operation of an instruction.
+type Opcode byte
+
+const (
+	// OpConst pushes Consts[A].
+	OpConst Opcode = iota
+	// OpReal pushes the real Consts[A], or a copy of its exact decimal in decimal mode.
+	OpReal
+	// OpCompose pops the elements of Constants[A], its B keys followed by its values, and pushes the literal built from them.
+	OpCompose
+	// OpLoad pushes the value of the variable Paths[A].
+	OpLoad
+	// OpStore pops a value and assigns it to the variable Paths[A].
+	OpStore
+	// OpCall pops B arguments and pushes the result of calling the method Paths[A].
+	OpCall
+	// OpMember pops a receiver and pushes its member Names[A].
+	OpMember
+	// OpCallMember pops B arguments and a receiver, and pushes the result of calling the receiver method Names[A].
+	OpCallMember
+	// OpMath pops the right and left value and pushes the result of the model.MathOperator A.
+	OpMath
+	// OpCompare pops the right and left value and pushes the result of the comparison of Predicates[A].
+	OpCompare
+	// OpIn pops a value, and if it equals the value on top of the stack, replaces that value with true, or false if B is set,
+	// and jumps to A.
+	OpIn
+	// OpNotFound replaces the value on top of the stack with false, or true if B is set.
+	OpNotFound
+	// OpAnd pops two booleans and pushes their conjunction.
+	OpAnd
+	// OpOr pops two booleans and pushes their disjunction.
+	OpOr
+	// OpJump jumps to A.
+	OpJump
+	// OpJumpIfFalse pops a boolean and jumps to A if it is false, failing with Messages[B] if it is not a boolean.
+	OpJumpIfFalse
+	// OpJumpIfNil jumps to A if the value on top of the stack is nil, replacing it with an invalid value.
+	OpJumpIfNil
+	// OpJumpIfNotNil jumps to A if the value on top of the stack is not nil, otherwise pops it.
+	OpJumpIfNotNil
+	// OpPop pops a value.
+	OpPop
+	// OpLoopStart pops a collection and starts iterating it using the for statement Loops[A].
+	OpLoopStart
+	// OpLoopNext binds the next item of the current loop, or ends the loop and jumps to A when there is no more item.
+	OpLoopNext
+	// OpMathInt is OpMath on two signed integers, computed without boxing them. The typed opcodes are emitted when
+	// the operand types are known from the declared facts, operands of other types at runtime, such as decimals
+	// in decimal mode, are computed like by the untyped opcode.
+	OpMathInt
+	// OpMathFloat is OpMath on two floats, computed without boxing them.
+	OpMathFloat
+	// OpConcat is OpMath adding two strings, concatenated without boxing them.
+	OpConcat
+	// OpCompareInt is OpCompare on two signed integers, compared without boxing them.
+	OpCompareInt
+	// OpCompareFloat is OpCompare on two floats, compared without boxing them.
+	OpCompareFloat
+	// OpCompareString is OpCompare on two strings, compared without boxing them.
+	OpCompareString
+	// OpCompareBool is OpCompare on two booleans, compared without boxing them.
+	OpCompareBool
+)
+
+var opcodeNames = map[Opcode]string{
+	OpConst:        "CONST",
+	OpReal:         "REAL",
+	OpCompose:      "COMPOSE",
+	OpLoad:         "LOAD",
+	OpStore:        "STORE",
+	OpCall:         "CALL",
+	OpMember:       "MEMBER",
+	OpCallMember:   "CALLMEMBER",
+	OpMath:         "MATH",
+	OpCompare:      "COMPARE",
+	OpIn:           "IN",
+	OpNotFound:     "NOTFOUND",
+	OpAnd:          "AND",
+	OpOr:           "OR",
+	OpJump:         "JUMP",
+	OpJumpIfFalse:  "JUMPIFFALSE",
+	OpJumpIfNil:    "JUMPIFNIL",
+	OpJumpIfNotNil: "JUMPIFNOTNIL",
+	OpPop:          "POP",
+	OpLoopStart:    "LOOPSTART",
+	OpLoopNext:     "LOOPNEXT",
+
+	OpMathInt:       "MATHINT",
+	OpMathFloat:     "MATHFLOAT",
+	OpConcat:        "CONCAT",
+	OpCompareInt:    "COMPAREINT",
+	OpCompareFloat:  "COMPAREFLOAT",
+	OpCompareString: "COMPARESTRING",
+	OpCompareBool:   "COMPAREBOOL",
+}
+
+// String returns the mnemonic of the opcode.
+func (op Opcode) String() string {
+	if name, ok := opcodeNames[op]; ok {
+		return name
+	}
+	return fmt.Sprintf("OP(%d)", op)
+}
+
+// Instruction is a single operation of the machine, A and B are its operands.
+type Instruction struct {
+	Op Opcode
+	A  int
+	B  int
+}
+
+// Code is the compiled form of an expression or a list of statements, the instructions and the operands they refer to.
+type Code struct {
+	Instructions []Instruction
+	Consts       []reflect.Value
+	Paths        []*context.Path
+	Names        []string
+	Predicates   []*model.Predicate
+	Constants    []*model.Constant
+	Loops        []*model.ForStatement
+	Messages     []string
+
+	values   []value
+	decimals []*big.Rat
+}
+
+// String disassembles the code, one instruction per line.
+func (code *Code) String() string {
+	var buf strings.Builder
+	for pc, ins := range code.Instructions {
+		var line strings.Builder
+		fmt.Fprintf(&line, "%04d %-12s", pc, ins.Op.String())
+		switch ins.Op {
+		case OpConst, OpReal:
+			fmt.Fprintf(&line, " %v", code.Consts[ins.A])
+		case OpCompose:
+			fmt.Fprintf(&line, " %s", code.Constants[ins.A].Text)
+		case OpLoad, OpStore:
+			fmt.Fprintf(&line, " %s", code.Paths[ins.A].Variable)
+		case OpCall:
+			fmt.Fprintf(&line, " %s %d", code.Paths[ins.A].Variable, ins.B)
+		case OpMember:
+			fmt.Fprintf(&line, " %s", code.Names[ins.A])
+		case OpCallMember:
+			fmt.Fprintf(&line, " %s %d", code.Names[ins.A], ins.B)
+		case OpMath, OpMathInt, OpMathFloat:
+			fmt.Fprintf(&line, " %s", model.MathOperator(ins.A).String())
+		case OpCompare, OpCompareInt, OpCompareFloat, OpCompareString, OpCompareBool:
+			fmt.Fprintf(&line, " %s", code.Predicates[ins.A].ComparisonOperator)
+		case OpIn, OpJump, OpJumpIfFalse, OpJumpIfNil, OpJumpIfNotNil, OpLoopNext:
+			fmt.Fprintf(&line, " %04d", ins.A)
+		case OpLoopStart:
+			fmt.Fprintf(&line, " %s", code.Loops[ins.A].LoopVariable)
+		}
+		buf.WriteString(strings.TrimRight(line.String(), " "))
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
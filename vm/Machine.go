@@ -0,0 +1,381 @@
+package vm
+
+import (
+	"github.com/juju/errors"
+	"github.com/newm4n/grool/context"
+	"github.com/newm4n/grool/model"
+	"github.com/newm4n/grool/pkg"
+	"reflect"
+	"strings"
+)
+
+// Machine is a stack machine running compiled code against the facts of a data context.
+// It gives the same results and errors as evaluating the rule graph the code was compiled from.
+type Machine struct {
+	knowledgeContext *context.KnowledgeContext
+	dataCtx          *context.DataContext
+	stack            []value
+	loops            []*loop
+}
+
+// loop is the state of a for statement being executed.
+type loop struct {
+	stmt     *model.ForStatement
+	items    []interface{}
+	indexes  []string
+	collPath []string
+	next     int
+	bound    bool
+}
+
+// NewMachine creates a machine working on the facts of the data context.
+func NewMachine(knowledgeContext *context.KnowledgeContext, dataCtx *context.DataContext) *Machine {
+	return &Machine{
+		knowledgeContext: knowledgeContext,
+		dataCtx:          dataCtx,
+		stack:            make([]value, 0, 16),
+		loops:            make([]*loop, 0),
+	}
+}
+
+// CanExecute tells whether the rule is eligible for execution, like model.RuleEntry CanExecute.
+func (m *Machine) CanExecute(rule *Rule) (bool, error) {
+	if rule.Entry.Retracted {
+		return false, nil
+	}
+	val, err := m.Run(rule.When)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	if pkg.GetBaseKind(val) != reflect.Bool {
+		return false, errors.Errorf("unexpected when result... its not boolean")
+	}
+	return val.Bool(), nil
+}
+
+// Execute executes the "then" scope of the rule, like model.RuleEntry Execute.
+func (m *Machine) Execute(rule *Rule) error {
+	_, err := m.Run(rule.Then)
+	return errors.Trace(err)
+}
+
+// Run executes the code and returns the value left on top of the stack, if any.
+func (m *Machine) Run(code *Code) (reflect.Value, error) {
+	m.stack = m.stack[:0]
+	if err := m.run(code); err != nil {
+		for i := len(m.loops) - 1; i >= 0; i-- {
+			if m.loops[i].bound {
+				m.loops[i].stmt.Unbind()
+			}
+		}
+		m.loops = m.loops[:0]
+		return reflect.ValueOf(nil), err
+	}
+	if len(m.stack) == 0 {
+		return reflect.ValueOf(nil), nil
+	}
+	return m.stack[len(m.stack)-1].reflect(), nil
+}
+
+func (m *Machine) push(val value) {
+	m.stack = append(m.stack, val)
+}
+
+func (m *Machine) pushValue(val reflect.Value) {
+	m.stack = append(m.stack, value{ref: val})
+}
+
+func (m *Machine) pop() value {
+	val := m.stack[len(m.stack)-1]
+	m.stack = m.stack[:len(m.stack)-1]
+	return val
+}
+
+// popN pops n values, in the order they were pushed.
+func (m *Machine) popN(n int) []reflect.Value {
+	vals := make([]reflect.Value, n)
+	for i, val := range m.stack[len(m.stack)-n:] {
+		vals[i] = val.reflect()
+	}
+	m.stack = m.stack[:len(m.stack)-n]
+	return vals
+}
+
+func (m *Machine) run(code *Code) error {
+	ins := code.Instructions
+	for pc := 0; pc < len(ins); pc++ {
+		in := ins[pc]
+		switch in.Op {
+		case OpConst:
+			m.push(code.values[in.A])
+		case OpReal:
+			if m.knowledgeContext != nil && m.knowledgeContext.DecimalMode {
+				m.pushValue(reflect.ValueOf(code.decimal(in.A)))
+			} else {
+				m.push(code.values[in.A])
+			}
+		case OpCompose:
+			cons := code.Constants[in.A]
+			values := m.popN(len(cons.Values))
+			keys := m.popN(in.B)
+			val, err := cons.Compose(keys, values)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			m.pushValue(val)
+		case OpLoad:
+			val, err := m.dataCtx.GetPathValue(code.Paths[in.A])
+			if err != nil {
+				return errors.Trace(err)
+			}
+			m.pushValue(val)
+		case OpStore:
+			if err := m.dataCtx.SetPathValue(code.Paths[in.A], m.pop().reflect()); err != nil {
+				return errors.Trace(err)
+			}
+		case OpCall:
+			val, err := m.dataCtx.ExecPathMethod(code.Paths[in.A], m.popN(in.B))
+			if err != nil {
+				return errors.Trace(err)
+			}
+			m.pushValue(val)
+		case OpMember:
+			val, err := m.dataCtx.GetValueFrom(m.pop().reflect(), code.Names[in.A])
+			if err != nil {
+				return errors.Trace(err)
+			}
+			m.pushValue(val)
+		case OpCallMember:
+			args := m.popN(in.B)
+			val, err := m.dataCtx.ExecMethodOn(m.pop().reflect(), code.Names[in.A], args)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			m.pushValue(val)
+		case OpMath, OpMathInt, OpMathFloat, OpConcat:
+			rv := m.pop()
+			lv := m.pop()
+			val, err := m.math(in.Op, model.MathOperator(in.A), lv, rv)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			m.push(val)
+		case OpCompare, OpCompareInt, OpCompareFloat, OpCompareString, OpCompareBool:
+			rv := m.pop()
+			lv := m.pop()
+			val, err := compare(in.Op, code.Predicates[in.A], lv, rv)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			m.push(val)
+		case OpIn:
+			rv := m.pop()
+			eq, err := equals(m.stack[len(m.stack)-1], rv)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if eq {
+				m.stack[len(m.stack)-1] = boolValue(in.B == 0)
+				pc = in.A - 1
+			}
+		case OpNotFound:
+			m.stack[len(m.stack)-1] = boolValue(in.B != 0)
+		case OpAnd, OpOr:
+			rb, rok := m.pop().bool()
+			lb, lok := m.pop().bool()
+			if !rok || !lok {
+				return errors.Errorf("cannot apply logical for non boolean expression")
+			}
+			if in.Op == OpAnd {
+				m.push(boolValue(lb && rb))
+			} else {
+				m.push(boolValue(lb || rb))
+			}
+		case OpJump:
+			pc = in.A - 1
+		case OpJumpIfFalse:
+			b, ok := m.pop().bool()
+			if !ok {
+				return errors.New(code.Messages[in.B])
+			}
+			if !b {
+				pc = in.A - 1
+			}
+		case OpJumpIfNil:
+			if top := m.stack[len(m.stack)-1]; top.kind == reflect.Invalid && pkg.IsNilValue(top.ref) {
+				m.stack[len(m.stack)-1] = value{}
+				pc = in.A - 1
+			}
+		case OpJumpIfNotNil:
+			if top := m.stack[len(m.stack)-1]; top.kind != reflect.Invalid || !pkg.IsNilValue(top.ref) {
+				pc = in.A - 1
+			} else {
+				m.pop()
+			}
+		case OpPop:
+			m.pop()
+		case OpLoopStart:
+			stmt := code.Loops[in.A]
+			items, indexes, err := stmt.Items(m.pop().reflect())
+			if err != nil {
+				return errors.Trace(err)
+			}
+			m.loops = append(m.loops, &loop{
+				stmt:     stmt,
+				items:    items,
+				indexes:  indexes,
+				collPath: m.dataCtx.FactPath(stmt.Variable),
+			})
+		case OpLoopNext:
+			l := m.loops[len(m.loops)-1]
+			if l.bound {
+				l.stmt.Unbind()
+				l.bound = false
+			}
+			if l.next == len(l.items) {
+				m.loops = m.loops[:len(m.loops)-1]
+				pc = in.A - 1
+				continue
+			}
+			if err := l.stmt.Bind(l.items[l.next], l.collPath, l.indexes[l.next]); err != nil {
+				return errors.Trace(err)
+			}
+			l.bound = true
+			l.next++
+		default:
+			return errors.Errorf("unknown opcode %s", in.Op.String())
+		}
+	}
+	return nil
+}
+
+// math applies the math operator. The typed opcodes compute operands of their type without boxing them, any other
+// operands are applied the operator like model.MathOperator does, checking their signedness in strict math mode.
+func (m *Machine) math(opcode Opcode, op model.MathOperator, lv, rv value) (value, error) {
+	switch opcode {
+	case OpMathInt:
+		if x, ok := lv.int(); ok {
+			if y, ok := rv.int(); ok {
+				res, err := pkg.CheckedInt(op.String(), x, y)
+				if err != nil {
+					return value{}, withOperands(err, lv, rv)
+				}
+				return value{kind: reflect.Int64, num: res}, nil
+			}
+		}
+	case OpMathFloat:
+		if x, ok := lv.float(); ok {
+			if y, ok := rv.float(); ok {
+				res, err := pkg.CheckedFloat(op.String(), x, y)
+				if err != nil {
+					return value{}, withOperands(err, lv, rv)
+				}
+				return value{kind: reflect.Float64, flt: res}, nil
+			}
+		}
+	case OpConcat:
+		if x, ok := lv.string(); ok {
+			if y, ok := rv.string(); ok {
+				return value{kind: reflect.String, str: x + y}, nil
+			}
+		}
+	}
+	l, r := lv.reflect(), rv.reflect()
+	isShift := op == model.MathOperatorShiftLeft || op == model.MathOperatorShiftRight
+	if m.knowledgeContext != nil && m.knowledgeContext.StrictMath && !isShift {
+		if err := pkg.CheckSignedness(op.String(), l, r); err != nil {
+			return value{}, err
+		}
+	}
+	val, err := op.Apply(l, r)
+	return value{ref: val}, err
+}
+
+// withOperands makes the operands of an *pkg.ArithmeticError those the math operator was applied on.
+func withOperands(err error, lv, rv value) error {
+	if arithErr, ok := err.(*pkg.ArithmeticError); ok {
+		arithErr.Left = lv.interfaceOf()
+		arithErr.Right = rv.interfaceOf()
+	}
+	return err
+}
+
+// compare applies the comparison operator of the predicate. The typed opcodes compare operands of their type without
+// boxing them, any other operands are compared by the predicate.
+func compare(opcode Opcode, prdct *model.Predicate, lv, rv value) (value, error) {
+	op := prdct.ComparisonOperator
+	switch opcode {
+	case OpCompareInt:
+		if x, ok := lv.int(); ok {
+			if y, ok := rv.int(); ok {
+				return boolValue(compareInts(op, lv.valueKind(), rv.valueKind(), x, y)), nil
+			}
+		}
+	case OpCompareFloat:
+		if x, ok := lv.float(); ok {
+			if y, ok := rv.float(); ok {
+				return boolValue(compareFloats(op, x, y)), nil
+			}
+		}
+	case OpCompareString:
+		if x, ok := lv.string(); ok {
+			if y, ok := rv.string(); ok {
+				return boolValue(compareFloats(op, float64(strings.Compare(x, y)), 0)), nil
+			}
+		}
+	case OpCompareBool:
+		if x, ok := lv.bool(); ok {
+			if y, ok := rv.bool(); ok {
+				return boolValue((x == y) == (op == model.ComparisonOperatorEQ)), nil
+			}
+		}
+	}
+	val, err := prdct.Apply(lv.reflect(), rv.reflect())
+	return value{ref: val}, err
+}
+
+// compareInts compares signed integers like model.Predicate does, as integers for equality between the same kinds,
+// otherwise as floats.
+func compareInts(op model.ComparisonOperator, lk, rk reflect.Kind, x, y int64) bool {
+	if lk == rk {
+		switch op {
+		case model.ComparisonOperatorEQ:
+			return x == y
+		case model.ComparisonOperatorNEQ:
+			return x != y
+		}
+	}
+	return compareFloats(op, float64(x), float64(y))
+}
+
+func compareFloats(op model.ComparisonOperator, x, y float64) bool {
+	switch op {
+	case model.ComparisonOperatorEQ:
+		return x == y
+	case model.ComparisonOperatorNEQ:
+		return x != y
+	case model.ComparisonOperatorGT:
+		return x > y
+	case model.ComparisonOperatorGTE:
+		return x >= y
+	case model.ComparisonOperatorLT:
+		return x < y
+	default:
+		return x <= y
+	}
+}
+
+// equals checks the equality of two values like model.ValueEquals does, without boxing integers and strings.
+func equals(lv, rv value) (bool, error) {
+	if x, ok := lv.int(); ok {
+		if y, ok := rv.int(); ok {
+			return compareInts(model.ComparisonOperatorEQ, lv.valueKind(), rv.valueKind(), x, y), nil
+		}
+	}
+	if x, ok := lv.string(); ok {
+		if y, ok := rv.string(); ok {
+			return x == y, nil
+		}
+	}
+	return model.ValueEquals(lv.reflect(), rv.reflect())
+}
@@ -0,0 +1,136 @@
+package vm
+
+import (
+	"github.com/newm4n/grool/pkg"
+	"reflect"
+	"time"
+)
+
+var (
+	int64Type    = reflect.TypeOf(int64(0))
+	float64Type  = reflect.TypeOf(float64(0))
+	stringType   = reflect.TypeOf("")
+	boolType     = reflect.TypeOf(false)
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// value is a slot of the machine stack. An int64, float64, string or bool is held unboxed, its kind tells which,
+// any other value is held as a reflect.Value, with an invalid kind.
+type value struct {
+	kind reflect.Kind
+	num  int64
+	flt  float64
+	str  string
+	ref  reflect.Value
+}
+
+// newValue holds the constant unboxed if it is an int64, float64, string or bool.
+func newValue(val reflect.Value) value {
+	if !val.IsValid() {
+		return value{}
+	}
+	switch val.Type() {
+	case int64Type:
+		return value{kind: reflect.Int64, num: val.Int()}
+	case float64Type:
+		return value{kind: reflect.Float64, flt: val.Float()}
+	case stringType:
+		return value{kind: reflect.String, str: val.String()}
+	case boolType:
+		return boolValue(val.Bool())
+	}
+	return value{ref: val}
+}
+
+func boolValue(b bool) value {
+	if b {
+		return value{kind: reflect.Bool, num: 1}
+	}
+	return value{kind: reflect.Bool}
+}
+
+// reflect returns the value as a reflect.Value, boxing it if it is held unboxed.
+func (v value) reflect() reflect.Value {
+	switch v.kind {
+	case reflect.Int64:
+		return reflect.ValueOf(v.num)
+	case reflect.Float64:
+		return reflect.ValueOf(v.flt)
+	case reflect.String:
+		return reflect.ValueOf(v.str)
+	case reflect.Bool:
+		return reflect.ValueOf(v.num != 0)
+	}
+	return v.ref
+}
+
+// interfaceOf returns the value as an interface, like pkg.ValueToInterface does for its reflect.Value.
+func (v value) interfaceOf() interface{} {
+	switch v.kind {
+	case reflect.Int64:
+		return v.num
+	case reflect.Float64:
+		return v.flt
+	case reflect.String:
+		return v.str
+	case reflect.Bool:
+		return v.num != 0
+	}
+	return pkg.ValueToInterface(v.ref)
+}
+
+// valueKind returns the kind of the value, reflect.Invalid for nil.
+func (v value) valueKind() reflect.Kind {
+	if v.kind != reflect.Invalid {
+		return v.kind
+	}
+	return v.ref.Kind()
+}
+
+// int returns the signed integer, false if the value is not one or is a time.Duration, which math differs.
+func (v value) int() (int64, bool) {
+	if v.kind == reflect.Int64 {
+		return v.num, true
+	}
+	switch v.ref.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.ref.Type() != durationType {
+			return v.ref.Int(), true
+		}
+	}
+	return 0, false
+}
+
+// float returns the float, false if the value is not one.
+func (v value) float() (float64, bool) {
+	if v.kind == reflect.Float64 {
+		return v.flt, true
+	}
+	switch v.ref.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.ref.Float(), true
+	}
+	return 0, false
+}
+
+// string returns the string, false if the value is not one.
+func (v value) string() (string, bool) {
+	if v.kind == reflect.String {
+		return v.str, true
+	}
+	if v.ref.Kind() == reflect.String {
+		return v.ref.String(), true
+	}
+	return "", false
+}
+
+// bool returns the boolean, false if the value is not one.
+func (v value) bool() (bool, bool) {
+	if v.kind == reflect.Bool {
+		return v.num != 0, true
+	}
+	if v.ref.Kind() == reflect.Bool {
+		return v.ref.Bool(), true
+	}
+	return false, false
+}